@@ -33,24 +33,32 @@ function types {
 		CGO_ENABLED=0 go build -o ./build/gen-type-set-test codegen/v2/type-set-test.go
 	fi
 
-	./build/gen-type-set --types Namespace   --output compose/types/namespace.gen.go
-	./build/gen-type-set --types Attachment  --output compose/types/attachment.gen.go
-	./build/gen-type-set --types Module      --output compose/types/module.gen.go
-	./build/gen-type-set --types Page        --output compose/types/page.gen.go
-	./build/gen-type-set --types Chart       --output compose/types/chart.gen.go
-	./build/gen-type-set --types Record      --output compose/types/record.gen.go
-	./build/gen-type-set --types ModuleField --output compose/types/module_field.gen.go
-
-	./build/gen-type-set-test --types Namespace   --output compose/types/namespace.gen_test.go
-	./build/gen-type-set-test --types Attachment  --output compose/types/attachment.gen_test.go
-	./build/gen-type-set-test --types Module      --output compose/types/module.gen_test.go
-	./build/gen-type-set-test --types Page        --output compose/types/page.gen_test.go
-	./build/gen-type-set-test --types Chart       --output compose/types/chart.gen_test.go
-	./build/gen-type-set-test --types Record      --output compose/types/record.gen_test.go
-	./build/gen-type-set-test --types ModuleField --output compose/types/module_field.gen_test.go
+	./build/gen-type-set --types Namespace      --output compose/types/namespace.gen.go
+	./build/gen-type-set --types Attachment     --output compose/types/attachment.gen.go
+	./build/gen-type-set --types Module         --output compose/types/module.gen.go
+	./build/gen-type-set --types Page           --output compose/types/page.gen.go
+	./build/gen-type-set --types Chart          --output compose/types/chart.gen.go
+	./build/gen-type-set --types Record         --output compose/types/record.gen.go
+	./build/gen-type-set --types ModuleField    --output compose/types/module_field.gen.go
+	./build/gen-type-set --types RecordRevision --output compose/types/record_revision.gen.go
+	./build/gen-type-set --types RecordImportSession --output compose/types/record_import_session.gen.go
+	./build/gen-type-set --types RecordExportJob --output compose/types/record_export_job.gen.go
+
+	./build/gen-type-set-test --types Namespace      --output compose/types/namespace.gen_test.go
+	./build/gen-type-set-test --types Attachment     --output compose/types/attachment.gen_test.go
+	./build/gen-type-set-test --types Module         --output compose/types/module.gen_test.go
+	./build/gen-type-set-test --types Page           --output compose/types/page.gen_test.go
+	./build/gen-type-set-test --types Chart          --output compose/types/chart.gen_test.go
+	./build/gen-type-set-test --types Record         --output compose/types/record.gen_test.go
+	./build/gen-type-set-test --types ModuleField    --output compose/types/module_field.gen_test.go
+	./build/gen-type-set-test --types RecordRevision --output compose/types/record_revision.gen_test.go
+	./build/gen-type-set-test --types RecordImportSession --output compose/types/record_import_session.gen_test.go
+	./build/gen-type-set-test --types RecordExportJob --output compose/types/record_export_job.gen_test.go
 
 	./build/gen-type-set --with-primary-key=false --types RecordValue --output compose/types/record_value.gen.go
 	./build/gen-type-set-test --with-primary-key=false --types RecordValue --output compose/types/record_value.gen_test.go
+	./build/gen-type-set --with-primary-key=false --types RecordImportFailure --output compose/types/record_import_session_failure.gen.go
+	./build/gen-type-set-test --with-primary-key=false --types RecordImportFailure --output compose/types/record_import_session_failure.gen_test.go
 
 	./build/gen-type-set --types MessageAttachment --output messaging/types/attachment.gen.go
 	./build/gen-type-set --types Mention           --output messaging/types/mention.gen.go
@@ -103,6 +111,12 @@ function types {
 	./build/gen-type-set-test --types Script   --output pkg/automation/script.gen_test.go  --package automation
 	./build/gen-type-set      --types Trigger  --output pkg/automation/trigger.gen.go      --package automation
 	./build/gen-type-set-test --types Trigger  --output pkg/automation/trigger.gen_test.go --package automation
+	./build/gen-type-set      --types Run      --output pkg/automation/run.gen.go          --package automation
+	./build/gen-type-set-test --types Run      --output pkg/automation/run.gen_test.go     --package automation
+	./build/gen-type-set      --types Execution --output pkg/automation/queue.gen.go        --package automation
+	./build/gen-type-set-test --types Execution --output pkg/automation/queue.gen_test.go   --package automation
+	./build/gen-type-set      --types ScheduleClaim --output pkg/automation/schedule_claim.gen.go      --package automation
+	./build/gen-type-set-test --types ScheduleClaim --output pkg/automation/schedule_claim.gen_test.go --package automation
 
 
 	green "OK"
@@ -111,12 +125,14 @@ function types {
 
 function database {
 	yellow "> database"
-	FOLDERS=$(find . -type d -wholename '*/schema/mysql')
-	for FOLDER in $FOLDERS; do
-		FOLDER=$(dirname $(dirname $FOLDER))
-		FOLDER=${FOLDER:2}
-		echo $FOLDER
-		cd $FOLDER && $GOPATH/bin/statik -p mysql -m -Z -f -src=schema/mysql && cd $_PWD
+	for DIALECT in mysql postgres; do
+		FOLDERS=$(find . -type d -wholename "*/schema/$DIALECT")
+		for FOLDER in $FOLDERS; do
+			FOLDER=$(dirname $(dirname $FOLDER))
+			FOLDER=${FOLDER:2}
+			echo $FOLDER/$DIALECT
+			cd $FOLDER && $GOPATH/bin/statik -p $DIALECT -m -Z -f -src=schema/$DIALECT && cd $_PWD
+		done
 	done
 	green "OK"
 }
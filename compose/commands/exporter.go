@@ -672,6 +672,10 @@ func expAutomation(ss automation.ScriptSet, tt automation.TriggerSet, mm types.M
 
 				trigger["module"] = makeHandleFromName(module.Name, module.Handle, "module-%d", module.ID)
 
+				if t.Expression != "" {
+					trigger["expression"] = t.Expression
+				}
+
 			case "interval", "deferred":
 				trigger["condition"] = t.Condition
 
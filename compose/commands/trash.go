@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cortezaproject/corteza-server/compose/repository"
+	"github.com/cortezaproject/corteza-server/pkg/cli"
+	"github.com/cortezaproject/corteza-server/pkg/cli/options"
+)
+
+const (
+	// Default age of deleted records before they are purged
+	defaultRecordTrashMaxAge = 30 * 24 * time.Hour
+)
+
+func Trash(ctx context.Context, c *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "purge-trash",
+		Short: "Permanently remove deleted records",
+		Long:  `Removes records (with values, revisions, record permission rules and script run logs) that were deleted before the given age`,
+
+		Run: func(cmd *cobra.Command, args []string) {
+			c.InitServices(ctx, c)
+
+			age, err := cmd.Flags().GetDuration("older-than")
+			cli.HandleError(err)
+
+			var (
+				db      = repository.DB(ctx)
+				before  = time.Now().Add(-age)
+				removed uint64
+			)
+
+			cli.HandleError(db.Transaction(func() (err error) {
+				removed, err = repository.Record(ctx, db).Purge(before)
+				return
+			}))
+
+			cmd.Printf("Purged %d record(s) deleted before %s\n", removed, before.Format(time.RFC3339))
+		},
+	}
+
+	cmd.Flags().Duration(
+		"older-than",
+		options.EnvDuration("COMPOSE", "RECORD_TRASH_MAX_AGE", defaultRecordTrashMaxAge),
+		"Purge records deleted before this age (defaults to COMPOSE_RECORD_TRASH_MAX_AGE or 720h)",
+	)
+
+	return cmd
+}
@@ -5,13 +5,14 @@ import (
 
 	_ "github.com/joho/godotenv/autoload"
 	"github.com/spf13/cobra"
-	"github.com/titpetric/factory"
 
 	"github.com/cortezaproject/corteza-server/compose/commands"
 	migrate "github.com/cortezaproject/corteza-server/compose/db"
 	"github.com/cortezaproject/corteza-server/compose/rest"
 	"github.com/cortezaproject/corteza-server/compose/service"
 	"github.com/cortezaproject/corteza-server/pkg/cli"
+	"github.com/cortezaproject/corteza-server/pkg/cli/options"
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
 )
 
 const (
@@ -40,6 +41,8 @@ func Configure() *cli.Config {
 				Storage:          *c.StorageOpt,
 				Corredor:         *c.ScriptRunner,
 				GRPCClientSystem: *c.GRPCServerSystem,
+
+				RecordExportLifetime: options.EnvDuration(c.EnvPrefix, "RECORD_EXPORT_LIFETIME", service.DefaultExportLifetime),
 			}))
 		},
 
@@ -71,11 +74,14 @@ func Configure() *cli.Config {
 			func(ctx context.Context, c *cli.Config) *cobra.Command {
 				return commands.Exporter(ctx, c)
 			},
+			func(ctx context.Context, c *cli.Config) *cobra.Command {
+				return commands.Trash(ctx, c)
+			},
 		},
 
 		ProvisionMigrateDatabase: cli.Runners{
 			func(ctx context.Context, cmd *cobra.Command, c *cli.Config) error {
-				var db, err = factory.Database.Get(compose)
+				var db, err = dbx.Get(compose)
 				if err != nil {
 					return err
 				}
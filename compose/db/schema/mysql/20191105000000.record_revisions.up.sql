@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS `compose_record_revision` (
+  id               BIGINT UNSIGNED NOT NULL,
+  rel_namespace    BIGINT UNSIGNED NOT NULL,
+  rel_module       BIGINT UNSIGNED NOT NULL,
+  rel_record       BIGINT UNSIGNED NOT NULL,
+  revision         INT    UNSIGNED NOT NULL               COMMENT 'Sequential revision number (per record)',
+  operation        VARCHAR(16)     NOT NULL               COMMENT 'create, update, delete, restore',
+  changes          JSON            NOT NULL               COMMENT 'Changed fields with old & new values',
+  snapshot         JSON            NOT NULL               COMMENT 'All record values after the change',
+
+  created_at       DATETIME        NOT NULL DEFAULT NOW() COMMENT 'When was the revision created',
+  created_by       BIGINT UNSIGNED NOT NULL DEFAULT 0     COMMENT 'Who made the change',
+
+  PRIMARY KEY (id),
+  UNIQUE INDEX uid_compose_record_revision (rel_record, revision)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
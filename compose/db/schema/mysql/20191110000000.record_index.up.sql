@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS `compose_record_index` (
+  rel_module       BIGINT UNSIGNED NOT NULL               COMMENT 'Module with materialized (indexed) fields',
+  columns          JSON            NOT NULL               COMMENT 'Indexed fields and their columns in compose_record_idx_<module ID> table',
+
+  updated_at       DATETIME        NOT NULL DEFAULT NOW() COMMENT 'When was the index table (re)built',
+
+  PRIMARY KEY (rel_module)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
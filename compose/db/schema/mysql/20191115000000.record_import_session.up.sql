@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS `compose_record_import_session` (
+  id               BIGINT UNSIGNED NOT NULL,
+  rel_namespace    BIGINT UNSIGNED NOT NULL,
+  rel_module       BIGINT UNSIGNED NOT NULL,
+  rel_owner        BIGINT UNSIGNED NOT NULL               COMMENT 'Who uploaded the file (import runs as this user)',
+
+  name             TEXT            NOT NULL               COMMENT 'Name of the uploaded file',
+  format           VARCHAR(16)     NOT NULL               COMMENT 'csv, json',
+  url              VARCHAR(512)    NOT NULL               COMMENT 'Location of the uploaded file in the store',
+
+  fields           JSON            NOT NULL               COMMENT 'Mapping of source columns to module fields',
+  on_error         VARCHAR(16)     NOT NULL DEFAULT ''    COMMENT 'fail, skip',
+  upsert_keys      JSON            NOT NULL               COMMENT 'Fields that identify existing records',
+
+  entry_count      INT    UNSIGNED NOT NULL DEFAULT 0,
+  completed        INT    UNSIGNED NOT NULL DEFAULT 0,
+  failed           INT    UNSIGNED NOT NULL DEFAULT 0,
+  fail_reason      TEXT            NOT NULL,
+
+  created_at       DATETIME        NOT NULL DEFAULT NOW(),
+  updated_at       DATETIME            NULL DEFAULT NULL,
+  started_at       DATETIME            NULL DEFAULT NULL  COMMENT 'When was the import queued',
+  finished_at      DATETIME            NULL DEFAULT NULL,
+  canceled_at      DATETIME            NULL DEFAULT NULL,
+  heartbeat_at     DATETIME            NULL DEFAULT NULL  COMMENT 'Last sign of life from the worker running the import',
+
+  PRIMARY KEY (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+CREATE TABLE IF NOT EXISTS `compose_record_import_error` (
+  rel_session      BIGINT UNSIGNED NOT NULL,
+  entry            INT    UNSIGNED NOT NULL               COMMENT 'Entry number (1-based) in the imported file',
+  reason           TEXT            NOT NULL,
+  errors           JSON            NOT NULL               COMMENT 'Value errors',
+
+  PRIMARY KEY (rel_session, entry)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,3 @@
+ALTER TABLE `compose_record_import_session`
+  ADD `sheet`      VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'Imported sheet of the spreadsheet (xlsx, ods)' AFTER `url`,
+  ADD `header_row` INT UNSIGNED NOT NULL DEFAULT 0  COMMENT 'Header row of the spreadsheet, detected when 0'  AFTER `sheet`;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS `compose_record_export_job` (
+  id                     BIGINT UNSIGNED NOT NULL,
+  rel_namespace          BIGINT UNSIGNED NOT NULL,
+  rel_module             BIGINT UNSIGNED NOT NULL,
+  rel_owner              BIGINT UNSIGNED NOT NULL               COMMENT 'Who requested the export (export runs as this user)',
+
+  filename               TEXT            NOT NULL               COMMENT 'Name of the exported file (without extension)',
+  format                 VARCHAR(16)     NOT NULL               COMMENT 'csv, json, xlsx',
+  url                    VARCHAR(512)    NOT NULL DEFAULT ''    COMMENT 'Location of the exported file in the store',
+
+  filter                 TEXT            NOT NULL,
+  sort                   TEXT            NOT NULL,
+  deleted                TINYINT UNSIGNED NOT NULL DEFAULT 0,
+  fields                 JSON            NOT NULL               COMMENT 'Exported fields',
+  multi_value            VARCHAR(16)     NOT NULL DEFAULT ''    COMMENT 'join, columns, json',
+  multi_value_delimiter  VARCHAR(16)     NOT NULL DEFAULT '',
+  labels                 BOOLEAN         NOT NULL DEFAULT FALSE,
+
+  exported               INT    UNSIGNED NOT NULL DEFAULT 0,
+  fail_reason            TEXT            NOT NULL,
+
+  created_at             DATETIME        NOT NULL DEFAULT NOW(),
+  started_at             DATETIME            NULL DEFAULT NULL,
+  finished_at            DATETIME            NULL DEFAULT NULL,
+  expires_at             DATETIME            NULL DEFAULT NULL  COMMENT 'When is the exported file removed',
+  heartbeat_at           DATETIME            NULL DEFAULT NULL  COMMENT 'Last sign of life from the worker running the export',
+
+  PRIMARY KEY (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
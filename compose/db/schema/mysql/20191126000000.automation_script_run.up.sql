@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS `compose_automation_script_run` (
+  id                     BIGINT UNSIGNED NOT NULL,
+  rel_script             BIGINT UNSIGNED NOT NULL,
+  rel_trigger            BIGINT UNSIGNED NOT NULL DEFAULT 0    COMMENT 'Trigger that caused the run (0 for test runs)',
+  resource               VARCHAR(128)    NOT NULL DEFAULT '',
+  event                  VARCHAR(128)    NOT NULL DEFAULT '',
+  rel_record             BIGINT UNSIGNED NOT NULL DEFAULT 0    COMMENT 'Record that script was running on',
+  rel_user               BIGINT UNSIGNED NOT NULL DEFAULT 0    COMMENT 'User that invoked the script',
+
+  outcome                VARCHAR(16)     NOT NULL               COMMENT 'success, aborted, error',
+  error                  TEXT            NOT NULL,
+  output                 TEXT            NOT NULL               COMMENT 'Script output (truncated)',
+
+  started_at             DATETIME        NOT NULL,
+  duration               INT    UNSIGNED NOT NULL DEFAULT 0    COMMENT 'Duration of the run (ms)',
+
+  PRIMARY KEY (id),
+  INDEX compose_automation_script_run_script (rel_script, started_at),
+  INDEX compose_automation_script_run_started_at (started_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
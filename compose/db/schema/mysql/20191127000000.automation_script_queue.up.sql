@@ -0,0 +1,24 @@
+ALTER TABLE `compose_automation_script`
+  ADD `max_attempts`  INT UNSIGNED NOT NULL DEFAULT 0 COMMENT 'Max attempts of queued execution, 0 for default'      AFTER `critical`,
+  ADD `retry_backoff` INT UNSIGNED NOT NULL DEFAULT 0 COMMENT 'Delay before first retry (milliseconds), 0 for default' AFTER `max_attempts`;
+
+CREATE TABLE IF NOT EXISTS `compose_automation_script_queue` (
+  id                     BIGINT UNSIGNED NOT NULL,
+  rel_script             BIGINT UNSIGNED NOT NULL,
+  resource               VARCHAR(128)    NOT NULL DEFAULT '',
+  event                  VARCHAR(128)    NOT NULL DEFAULT '',
+  payload                JSON            NOT NULL               COMMENT 'Resource the script is executed with',
+  rel_user               BIGINT UNSIGNED NOT NULL DEFAULT 0    COMMENT 'User that invoked the script',
+  user_roles             TEXT            NOT NULL               COMMENT 'Roles of the invoker (space separated)',
+
+  attempts               INT    UNSIGNED NOT NULL DEFAULT 0,
+  last_error             TEXT            NOT NULL,
+
+  created_at             DATETIME        NOT NULL,
+  next_attempt_at        DATETIME        NOT NULL,
+  failed_at              DATETIME            NULL DEFAULT NULL COMMENT 'Moved to dead-letter list',
+
+  PRIMARY KEY (id),
+  INDEX compose_automation_script_queue_next_attempt_at (failed_at, next_attempt_at),
+  INDEX compose_automation_script_queue_script (rel_script)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
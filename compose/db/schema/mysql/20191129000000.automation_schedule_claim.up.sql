@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS `compose_automation_schedule_claim` (
+  id                     BIGINT UNSIGNED NOT NULL,
+  rel_script             BIGINT UNSIGNED NOT NULL,
+  slot                   DATETIME        NOT NULL               COMMENT 'Minute the run was scheduled for',
+  claim_key              VARCHAR(255)    NOT NULL DEFAULT ''    COMMENT 'Relative condition of the run',
+  claimed_by             VARCHAR(255)    NOT NULL DEFAULT ''    COMMENT 'Instance that claimed the run',
+  claimed_at             DATETIME        NOT NULL,
+
+  PRIMARY KEY (id),
+  UNIQUE INDEX compose_automation_schedule_claim_run (rel_script, slot, claim_key),
+  INDEX compose_automation_schedule_claim_claimed_at (claimed_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
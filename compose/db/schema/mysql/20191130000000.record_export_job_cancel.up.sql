@@ -0,0 +1,2 @@
+ALTER TABLE `compose_record_export_job`
+  ADD `canceled_at` DATETIME NULL DEFAULT NULL COMMENT 'Deleted while running, job and file are removed by the worker' AFTER `expires_at`;
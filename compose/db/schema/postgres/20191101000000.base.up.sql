@@ -0,0 +1,218 @@
+-- PostgreSQL schema, equivalent to all MySQL migrations up to 20191009172213
+
+CREATE TABLE compose_namespace (
+  id               BIGINT       NOT NULL,
+  name             VARCHAR(64)  NOT NULL, -- Name
+  slug             VARCHAR(64)  NOT NULL, -- URL slug
+  enabled          BOOLEAN      NOT NULL, -- Is namespace enabled?
+  meta             JSONB        NOT NULL, -- Meta data
+
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at       TIMESTAMPTZ      NULL,
+  deleted_at       TIMESTAMPTZ      NULL,
+
+  PRIMARY KEY (id)
+);
+
+CREATE TABLE compose_attachment (
+  id               BIGINT       NOT NULL,
+  rel_namespace    BIGINT       NOT NULL REFERENCES compose_namespace (id),
+  rel_owner        BIGINT       NOT NULL,
+
+  kind             VARCHAR(32)  NOT NULL,
+
+  url              VARCHAR(512),
+  preview_url      VARCHAR(512),
+
+  size             INTEGER,
+  mimetype         VARCHAR(255),
+  name             TEXT,
+
+  meta             JSONB,
+
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at       TIMESTAMPTZ      NULL,
+  deleted_at       TIMESTAMPTZ      NULL,
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX compose_attachment_rel_namespace ON compose_attachment (rel_namespace);
+
+CREATE TABLE compose_chart (
+  id               BIGINT       NOT NULL,
+  handle           VARCHAR(200) NOT NULL,
+  rel_namespace    BIGINT       NOT NULL REFERENCES compose_namespace (id),
+  name             VARCHAR(64)  NOT NULL, -- The name of the chart
+  config           JSONB        NOT NULL, -- Chart & reporting configuration
+
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at       TIMESTAMPTZ      NULL,
+  deleted_at       TIMESTAMPTZ      NULL,
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX compose_chart_rel_namespace ON compose_chart (rel_namespace);
+
+CREATE TABLE compose_module (
+  id               BIGINT       NOT NULL,
+  handle           VARCHAR(200) NOT NULL,
+  rel_namespace    BIGINT       NOT NULL REFERENCES compose_namespace (id),
+  name             VARCHAR(64)  NOT NULL, -- The name of the module
+  json             JSONB        NOT NULL, -- Module meta data
+
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at       TIMESTAMPTZ      NULL,
+  deleted_at       TIMESTAMPTZ      NULL,
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX compose_module_rel_namespace ON compose_module (rel_namespace);
+
+CREATE TABLE compose_module_field (
+  id               BIGINT       NOT NULL,
+  rel_module       BIGINT       NOT NULL REFERENCES compose_module (id),
+  place            SMALLINT     NOT NULL,
+  kind             VARCHAR(64)  NOT NULL, -- The type of the form input field
+  options          JSONB        NOT NULL, -- Options in JSON format
+  default_value    JSONB            NULL, -- Default value as a record value set
+  name             VARCHAR(64)  NOT NULL, -- The name of the field in the form
+  label            VARCHAR(255) NOT NULL, -- The label of the form input
+  is_private       BOOLEAN      NOT NULL, -- Contains personal/sensitive data?
+  is_required      BOOLEAN      NOT NULL,
+  is_visible       BOOLEAN      NOT NULL,
+  is_multi         BOOLEAN      NOT NULL,
+
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at       TIMESTAMPTZ      NULL,
+  deleted_at       TIMESTAMPTZ      NULL,
+
+  PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX uid_compose_module_field_place ON compose_module_field (rel_module, place);
+CREATE UNIQUE INDEX uid_compose_module_field_name  ON compose_module_field (rel_module, name);
+
+CREATE TABLE compose_page (
+  id               BIGINT       NOT NULL, -- Page ID
+  handle           VARCHAR(200) NOT NULL,
+  rel_namespace    BIGINT       NOT NULL REFERENCES compose_namespace (id),
+  self_id          BIGINT       NOT NULL, -- Parent Page ID
+  rel_module       BIGINT       NOT NULL DEFAULT 0, -- Module ID (optional)
+  title            VARCHAR(255) NOT NULL, -- Title (required)
+  description      TEXT         NOT NULL, -- Description
+  blocks           JSONB        NOT NULL, -- JSON array of blocks for the page
+  visible          BOOLEAN      NOT NULL, -- Is page visible in navigation?
+  weight           INTEGER      NOT NULL, -- Order for navigation
+
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at       TIMESTAMPTZ      NULL,
+  deleted_at       TIMESTAMPTZ      NULL,
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX compose_page_rel_namespace ON compose_page (rel_namespace);
+CREATE INDEX compose_page_rel_module    ON compose_page (rel_module);
+CREATE INDEX compose_page_self_id       ON compose_page (self_id);
+
+CREATE TABLE compose_record (
+  id               BIGINT       NOT NULL,
+  rel_namespace    BIGINT       NOT NULL REFERENCES compose_namespace (id),
+  module_id        BIGINT       NOT NULL,
+
+  owned_by         BIGINT       NOT NULL DEFAULT 0,
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  created_by       BIGINT       NOT NULL DEFAULT 0,
+  updated_at       TIMESTAMPTZ      NULL,
+  updated_by       BIGINT       NOT NULL DEFAULT 0,
+  deleted_at       TIMESTAMPTZ      NULL,
+  deleted_by       BIGINT       NOT NULL DEFAULT 0,
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX compose_record_rel_namespace ON compose_record (rel_namespace);
+CREATE INDEX compose_record_module_id     ON compose_record (module_id);
+CREATE INDEX compose_record_owned_by      ON compose_record (owned_by);
+
+CREATE TABLE compose_record_value (
+  record_id        BIGINT       NOT NULL,
+  name             VARCHAR(64)  NOT NULL,
+  value            TEXT,
+  ref              BIGINT       NOT NULL DEFAULT 0,
+  place            INTEGER      NOT NULL DEFAULT 0,
+  deleted_at       TIMESTAMPTZ      NULL,
+
+  PRIMARY KEY (record_id, name, place)
+);
+
+CREATE INDEX compose_record_value_ref ON compose_record_value (ref);
+
+CREATE TABLE compose_permission_rules (
+  rel_role         BIGINT       NOT NULL,
+  resource         VARCHAR(128) NOT NULL,
+  operation        VARCHAR(128) NOT NULL,
+  access           SMALLINT     NOT NULL,
+
+  PRIMARY KEY (rel_role, resource, operation)
+);
+
+CREATE TABLE compose_automation_script (
+  id               BIGINT       NOT NULL,
+  rel_namespace    BIGINT       NOT NULL REFERENCES compose_namespace (id),
+  name             VARCHAR(64)  NOT NULL DEFAULT 'unnamed', -- The name of the script
+  source           TEXT         NOT NULL,                   -- Source code for the script
+  source_ref       VARCHAR(200) NOT NULL,                   -- Where is the script located (if remote)
+  async            BOOLEAN      NOT NULL DEFAULT FALSE,     -- Do we run this script asynchronously?
+  rel_runner       BIGINT       NOT NULL DEFAULT 0,         -- Who is running the script? 0 for invoker
+  run_in_ua        BOOLEAN      NOT NULL DEFAULT FALSE,     -- Run this script inside user-agent environment
+  timeout          INTEGER      NOT NULL DEFAULT 0,         -- Any explicit timeout set for this script (milliseconds)?
+  critical         BOOLEAN      NOT NULL DEFAULT TRUE,      -- Is it critical that this script is executed successfully
+  enabled          BOOLEAN      NOT NULL DEFAULT TRUE,      -- Is this script enabled?
+
+  created_by       BIGINT       NOT NULL DEFAULT 0,
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_by       BIGINT       NOT NULL DEFAULT 0,
+  updated_at       TIMESTAMPTZ      NULL,
+  deleted_by       BIGINT       NOT NULL DEFAULT 0,
+  deleted_at       TIMESTAMPTZ      NULL,
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX compose_automation_script_rel_namespace ON compose_automation_script (rel_namespace);
+
+CREATE TABLE compose_automation_trigger (
+  id               BIGINT       NOT NULL,
+  rel_script       BIGINT       NOT NULL REFERENCES compose_automation_script (id), -- Script that is triggered
+
+  resource         VARCHAR(128) NOT NULL,              -- Resource triggering the event
+  event            VARCHAR(128) NOT NULL,              -- Event triggered
+  event_condition  TEXT         NOT NULL,              -- Trigger condition
+  enabled          BOOLEAN      NOT NULL DEFAULT TRUE, -- Trigger enabled?
+
+  weight           INTEGER      NOT NULL DEFAULT 0,
+
+  created_by       BIGINT       NOT NULL DEFAULT 0,
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_by       BIGINT       NOT NULL DEFAULT 0,
+  updated_at       TIMESTAMPTZ      NULL,
+  deleted_by       BIGINT       NOT NULL DEFAULT 0,
+  deleted_at       TIMESTAMPTZ      NULL,
+
+  PRIMARY KEY (id)
+);
+
+CREATE TABLE compose_settings (
+  rel_owner        BIGINT       NOT NULL DEFAULT 0, -- Value owner, 0 for global settings
+  name             VARCHAR(200) NOT NULL,           -- Unique set of setting keys
+  value            JSONB,                           -- Setting value
+
+  updated_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP, -- When was the value updated
+  updated_by       BIGINT       NOT NULL DEFAULT 0,                 -- Who created/updated the value
+
+  PRIMARY KEY (name, rel_owner)
+);
@@ -0,0 +1,17 @@
+CREATE TABLE compose_record_revision (
+  id               BIGINT       NOT NULL,
+  rel_namespace    BIGINT       NOT NULL,
+  rel_module       BIGINT       NOT NULL,
+  rel_record       BIGINT       NOT NULL,
+  revision         INTEGER      NOT NULL, -- Sequential revision number (per record)
+  operation        VARCHAR(16)  NOT NULL, -- create, update, delete, restore...
+  changes          JSONB        NOT NULL, -- List of changed fields with old & new values
+  snapshot         JSONB        NOT NULL, -- All record values after the change
+
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  created_by       BIGINT       NOT NULL DEFAULT 0,
+
+  PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX uid_compose_record_revision ON compose_record_revision (rel_record, revision);
@@ -0,0 +1,8 @@
+CREATE TABLE compose_record_index (
+  rel_module       BIGINT       NOT NULL,
+  columns          JSONB        NOT NULL, -- Indexed fields and their columns in compose_record_idx_<module ID> table
+
+  updated_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP, -- When was the index table (re)built
+
+  PRIMARY KEY (rel_module)
+);
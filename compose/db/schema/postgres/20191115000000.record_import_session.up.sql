@@ -0,0 +1,37 @@
+CREATE TABLE compose_record_import_session (
+  id               BIGINT       NOT NULL,
+  rel_namespace    BIGINT       NOT NULL,
+  rel_module       BIGINT       NOT NULL,
+  rel_owner        BIGINT       NOT NULL, -- Who uploaded the file (import runs as this user)
+
+  name             TEXT         NOT NULL, -- Name of the uploaded file
+  format           VARCHAR(16)  NOT NULL, -- csv, json
+  url              VARCHAR(512) NOT NULL, -- Location of the uploaded file in the store
+
+  fields           JSONB        NOT NULL, -- Mapping of source columns to module fields
+  on_error         VARCHAR(16)  NOT NULL DEFAULT '', -- fail, skip
+  upsert_keys      JSONB        NOT NULL, -- Fields that identify existing records
+
+  entry_count      INTEGER      NOT NULL DEFAULT 0,
+  completed        INTEGER      NOT NULL DEFAULT 0,
+  failed           INTEGER      NOT NULL DEFAULT 0,
+  fail_reason      TEXT         NOT NULL DEFAULT '',
+
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at       TIMESTAMPTZ      NULL,
+  started_at       TIMESTAMPTZ      NULL, -- When was the import queued
+  finished_at      TIMESTAMPTZ      NULL,
+  canceled_at      TIMESTAMPTZ      NULL,
+  heartbeat_at     TIMESTAMPTZ      NULL, -- Last sign of life from the worker running the import
+
+  PRIMARY KEY (id)
+);
+
+CREATE TABLE compose_record_import_error (
+  rel_session      BIGINT       NOT NULL,
+  entry            INTEGER      NOT NULL, -- Entry number (1-based) in the imported file
+  reason           TEXT         NOT NULL DEFAULT '',
+  errors           JSONB        NOT NULL, -- Value errors
+
+  PRIMARY KEY (rel_session, entry)
+);
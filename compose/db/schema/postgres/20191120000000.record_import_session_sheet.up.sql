@@ -0,0 +1,3 @@
+ALTER TABLE compose_record_import_session
+  ADD COLUMN sheet      VARCHAR(255) NOT NULL DEFAULT '',
+  ADD COLUMN header_row INTEGER      NOT NULL DEFAULT 0;
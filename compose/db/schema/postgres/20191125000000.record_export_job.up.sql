@@ -0,0 +1,29 @@
+CREATE TABLE compose_record_export_job (
+  id                     BIGINT       NOT NULL,
+  rel_namespace          BIGINT       NOT NULL,
+  rel_module             BIGINT       NOT NULL,
+  rel_owner              BIGINT       NOT NULL, -- Who requested the export (export runs as this user)
+
+  filename               TEXT         NOT NULL, -- Name of the exported file (without extension)
+  format                 VARCHAR(16)  NOT NULL, -- csv, json, xlsx
+  url                    VARCHAR(512) NOT NULL DEFAULT '', -- Location of the exported file in the store
+
+  filter                 TEXT         NOT NULL DEFAULT '',
+  sort                   TEXT         NOT NULL DEFAULT '',
+  deleted                SMALLINT     NOT NULL DEFAULT 0,
+  fields                 JSONB        NOT NULL, -- Exported fields
+  multi_value            VARCHAR(16)  NOT NULL DEFAULT '', -- join, columns, json
+  multi_value_delimiter  VARCHAR(16)  NOT NULL DEFAULT '',
+  labels                 BOOLEAN      NOT NULL DEFAULT FALSE,
+
+  exported               INTEGER      NOT NULL DEFAULT 0,
+  fail_reason            TEXT         NOT NULL DEFAULT '',
+
+  created_at             TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  started_at             TIMESTAMPTZ      NULL,
+  finished_at            TIMESTAMPTZ      NULL,
+  expires_at             TIMESTAMPTZ      NULL, -- When is the exported file removed
+  heartbeat_at           TIMESTAMPTZ      NULL, -- Last sign of life from the worker running the export
+
+  PRIMARY KEY (id)
+);
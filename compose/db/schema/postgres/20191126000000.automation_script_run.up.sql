@@ -0,0 +1,21 @@
+CREATE TABLE compose_automation_script_run (
+  id                     BIGINT       NOT NULL,
+  rel_script             BIGINT       NOT NULL,
+  rel_trigger            BIGINT       NOT NULL DEFAULT 0, -- Trigger that caused the run (0 for test runs)
+  resource               VARCHAR(128) NOT NULL DEFAULT '',
+  event                  VARCHAR(128) NOT NULL DEFAULT '',
+  rel_record             BIGINT       NOT NULL DEFAULT 0, -- Record that script was running on
+  rel_user               BIGINT       NOT NULL DEFAULT 0, -- User that invoked the script
+
+  outcome                VARCHAR(16)  NOT NULL, -- success, aborted, error
+  error                  TEXT         NOT NULL DEFAULT '',
+  output                 TEXT         NOT NULL DEFAULT '', -- Script output (truncated)
+
+  started_at             TIMESTAMPTZ  NOT NULL,
+  duration               INTEGER      NOT NULL DEFAULT 0, -- Duration of the run (ms)
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX compose_automation_script_run_script ON compose_automation_script_run (rel_script, started_at);
+CREATE INDEX compose_automation_script_run_started_at ON compose_automation_script_run (started_at);
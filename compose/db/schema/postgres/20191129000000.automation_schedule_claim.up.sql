@@ -0,0 +1,13 @@
+CREATE TABLE compose_automation_schedule_claim (
+  id                     BIGINT       NOT NULL,
+  rel_script             BIGINT       NOT NULL,
+  slot                   TIMESTAMPTZ  NOT NULL, -- Minute the run was scheduled for
+  claim_key              VARCHAR(255) NOT NULL DEFAULT '', -- Relative condition of the run
+  claimed_by             VARCHAR(255) NOT NULL DEFAULT '', -- Instance that claimed the run
+  claimed_at             TIMESTAMPTZ  NOT NULL,
+
+  PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX compose_automation_schedule_claim_run ON compose_automation_schedule_claim (rel_script, slot, claim_key);
+CREATE INDEX compose_automation_schedule_claim_claimed_at ON compose_automation_schedule_claim (claimed_at);
@@ -0,0 +1,2 @@
+ALTER TABLE compose_record_export_job
+  ADD COLUMN canceled_at TIMESTAMPTZ NULL; -- Deleted while running, job and file are removed by the worker
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS migrations (
+  project         VARCHAR(16)  NOT NULL, -- sam, crm, ...
+  filename        VARCHAR(255) NOT NULL, -- yyyymmddHHMMSS.sql
+  statement_index INTEGER      NOT NULL, -- Statement number from SQL file
+  status          TEXT         NOT NULL, -- ok or full error message
+
+  PRIMARY KEY (project, filename)
+);
@@ -0,0 +1,353 @@
+package decoder
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// odsReader reads the selected table from the content of the OpenDocument spreadsheet
+	//
+	// Other tables are skipped, only their names are read
+	odsReader struct {
+		d   *xml.Decoder
+		opt SpreadsheetOptions
+
+		sheets []string
+		sheet  *spreadsheetSheet
+
+		// Number of the next row in the sheet
+		rowNum int
+
+		// Number of non-empty rows and cells (with expanded repeats) in the sheet
+		rows, cells int
+	}
+)
+
+const (
+	odsNsOffice = "urn:oasis:names:tc:opendocument:xmlns:office:1.0"
+	odsNsTable  = "urn:oasis:names:tc:opendocument:xmlns:table:1.0"
+	odsNsText   = "urn:oasis:names:tc:opendocument:xmlns:text:1.0"
+)
+
+// NewOdsDecoder reads the selected table (sheet) of the OpenDocument spreadsheet
+//
+// Cells are typed by their value type
+func NewOdsDecoder(f io.Reader, opt SpreadsheetOptions) (*spreadsheetDecoder, error) {
+	z, err := openZip(f)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := zipFile(z, "content.xml")
+	if err != nil {
+		return nil, err
+	} else if content == nil {
+		return nil, errors.New("missing spreadsheet content")
+	}
+
+	defer content.Close()
+
+	r := &odsReader{d: xml.NewDecoder(content), opt: opt}
+	if err = r.read(); err != nil {
+		return nil, err
+	}
+
+	return newSpreadsheetDecoder(r.sheets, r.sheet, opt)
+}
+
+func (r *odsReader) read() error {
+	for {
+		tok, err := r.d.Token()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		se, ok := tok.(xml.StartElement)
+		if !ok || se.Name.Space != odsNsTable {
+			continue
+		}
+
+		if se.Name.Local != "table" {
+			continue
+		}
+
+		name := odsAttr(se, odsNsTable, "name")
+		r.sheets = append(r.sheets, name)
+
+		if r.sheet != nil || (r.opt.Sheet != "" && name != r.opt.Sheet) {
+			if err = r.d.Skip(); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		r.sheet = &spreadsheetSheet{name: name}
+		r.rowNum = 1
+
+		if err = r.table(); err != nil {
+			return err
+		}
+	}
+}
+
+// table reads rows of the selected table, including rows in row groups
+func (r *odsReader) table() error {
+	for {
+		tok, err := r.d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if t.Name.Space == odsNsTable && t.Name.Local == "table-row" {
+				if err = r.row(t); err != nil {
+					return err
+				}
+			}
+
+		case xml.EndElement:
+			if t.Name.Space == odsNsTable && t.Name.Local == "table" {
+				return nil
+			}
+		}
+	}
+}
+
+// row reads cells of the row; repeated rows are expanded only when they are not empty
+//
+// Expanded rows share cells
+func (r *odsReader) row(se xml.StartElement) error {
+	var (
+		row    = spreadsheetRow{num: r.rowNum}
+		repeat = odsRepeat(se, "number-rows-repeated")
+		col    = 0
+	)
+
+	r.rowNum += repeat
+
+	for {
+		tok, err := r.d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if t.Name.Space != odsNsTable || (t.Name.Local != "table-cell" && t.Name.Local != "covered-table-cell") {
+				if err = r.d.Skip(); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			c, err := r.cell(t)
+			if err != nil {
+				return err
+			}
+
+			n := odsRepeat(t, "number-columns-repeated")
+			if c.kind != cellEmpty {
+				for i := 0; i < n && col+i < spreadsheetMaxRepeat; i++ {
+					row.set(col+i, c)
+				}
+			}
+
+			col += n
+
+		case xml.EndElement:
+			if row.width() == 0 {
+				return nil
+			}
+
+			if r.rows += repeat; r.rows > spreadsheetMaxRows {
+				return errSpreadsheetTooManyRows
+			}
+
+			if r.cells += repeat * row.width(); r.cells > spreadsheetMaxCells {
+				return errSpreadsheetTooManyCells
+			}
+
+			for i := 0; i < repeat; i++ {
+				r.sheet.rows = append(r.sheet.rows, spreadsheetRow{num: row.num + i, cells: row.cells})
+			}
+
+			return nil
+		}
+	}
+}
+
+// cell reads cell value; text of the cell is used when value can not be parsed
+func (r *odsReader) cell(se xml.StartElement) (c cell, err error) {
+	var text []string
+
+	for {
+		tok, err := r.d.Token()
+		if err != nil {
+			return c, err
+		}
+
+		if t, ok := tok.(xml.StartElement); ok {
+			if t.Name.Space == odsNsText && t.Name.Local == "p" {
+				p, err := r.paragraph()
+				if err != nil {
+					return c, err
+				}
+
+				text = append(text, p)
+			} else if err = r.d.Skip(); err != nil {
+				return c, err
+			}
+		} else if _, ok := tok.(xml.EndElement); ok {
+			break
+		}
+	}
+
+	var (
+		str = strings.Join(text, "\n")
+		val string
+	)
+
+	switch odsAttr(se, odsNsOffice, "value-type") {
+	case "float", "percentage", "currency":
+		val = odsAttr(se, odsNsOffice, "value")
+		if c.num, err = strconv.ParseFloat(val, 64); err == nil {
+			c.kind = cellNumber
+			return c, nil
+		}
+
+	case "date":
+		val = odsAttr(se, odsNsOffice, "date-value")
+		if c.t, err = parseOdsDate(val); err == nil {
+			c.kind = cellDate
+			c.num = toSpreadsheetSerial(c.t)
+			return c, nil
+		}
+
+	case "time":
+		val = odsAttr(se, odsNsOffice, "time-value")
+		if d, err := parseOdsDuration(val); err == nil {
+			c.kind = cellDate
+			c.t = spreadsheetEpoch.Add(d)
+			c.num = d.Hours() / 24
+			return c, nil
+		}
+
+	case "boolean":
+		c.kind = cellBool
+		if b, _ := strconv.ParseBool(odsAttr(se, odsNsOffice, "boolean-value")); b {
+			c.num = 1
+		}
+
+		return c, nil
+
+	case "string":
+		if v := odsAttr(se, odsNsOffice, "string-value"); v != "" {
+			str = v
+		}
+	}
+
+	if str != "" {
+		c = cell{kind: cellString, str: str}
+	}
+
+	return c, nil
+}
+
+// paragraph reads text of the paragraph, including spans, spaces, tabs and line breaks
+func (r *odsReader) paragraph() (string, error) {
+	var (
+		b     strings.Builder
+		depth = 1
+	)
+
+	for depth > 0 {
+		tok, err := r.d.Token()
+		if err != nil {
+			return "", err
+		}
+
+		switch t := tok.(type) {
+		case xml.CharData:
+			b.Write(t)
+
+		case xml.StartElement:
+			depth++
+			if t.Name.Space != odsNsText {
+				continue
+			}
+
+			switch t.Name.Local {
+			case "s":
+				n, _ := strconv.Atoi(odsAttr(t, odsNsText, "c"))
+				if n < 1 {
+					n = 1
+				}
+
+				b.WriteString(strings.Repeat(" ", n))
+			case "tab":
+				b.WriteString("\t")
+			case "line-break":
+				b.WriteString("\n")
+			}
+
+		case xml.EndElement:
+			depth--
+		}
+	}
+
+	return b.String(), nil
+}
+
+func odsAttr(se xml.StartElement, space, local string) string {
+	for _, a := range se.Attr {
+		if a.Name.Space == space && a.Name.Local == local {
+			return a.Value
+		}
+	}
+
+	return ""
+}
+
+// odsRepeat returns value of the repeat attribute (1 when not set)
+//
+// Value is capped at spreadsheetMaxRows; longer repeats are either empty
+// (trailing rows and columns) or rejected anyway
+func odsRepeat(se xml.StartElement, attr string) int {
+	n, err := strconv.Atoi(odsAttr(se, odsNsTable, attr))
+	switch {
+	case err != nil || n < 1:
+		return 1
+	case n > spreadsheetMaxRows:
+		return spreadsheetMaxRows
+	}
+
+	return n
+}
+
+// parseOdsDate parses date (2019-11-15) or date and time (2019-11-15T10:20:30) without timezone
+func parseOdsDate(v string) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02T15:04:05.999999999", "2006-01-02", time.RFC3339Nano} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	return time.Time{}, errors.New("invalid date value")
+}
+
+// parseOdsDuration parses ISO 8601 duration that is used for time values (PT10H20M30S)
+func parseOdsDuration(v string) (time.Duration, error) {
+	v = strings.TrimPrefix(v, "PT")
+	v = strings.ToLower(v)
+	return time.ParseDuration(v)
+}
@@ -0,0 +1,148 @@
+package decoder
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testOdsContent = `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-content
+	xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0"
+	xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0"
+	xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0">
+<office:body><office:spreadsheet>
+<table:table table:name="Contacts">
+	<table:table-row><table:table-cell office:value-type="string"><text:p>Contacts export</text:p></table:table-cell></table:table-row>
+	<table:table-row><table:table-cell table:number-columns-repeated="4"/></table:table-row>
+	<table:table-row>
+		<table:table-cell office:value-type="string"><text:p>name</text:p></table:table-cell>
+		<table:table-cell office:value-type="string"><text:p>age</text:p></table:table-cell>
+		<table:table-cell office:value-type="string"><text:p>born</text:p></table:table-cell>
+		<table:table-cell office:value-type="string"><text:p>active</text:p></table:table-cell>
+	</table:table-row>
+	<table:table-row>
+		<table:table-cell office:value-type="string"><text:p>Jane<text:s text:c="2"/><text:span>Doe</text:span></text:p></table:table-cell>
+		<table:table-cell office:value-type="float" office:value="42"><text:p>42</text:p></table:table-cell>
+		<table:table-cell office:value-type="date" office:date-value="1977-05-25T10:30:00"><text:p>25.05.77</text:p></table:table-cell>
+		<table:table-cell office:value-type="boolean" office:boolean-value="true"><text:p>TRUE</text:p></table:table-cell>
+	</table:table-row>
+	<table:table-row table:number-rows-repeated="2">
+		<table:table-cell office:value-type="string"><text:p>John</text:p></table:table-cell>
+		<table:table-cell table:number-columns-repeated="2"/>
+		<table:table-cell office:value-type="boolean" office:boolean-value="false"><text:p>FALSE</text:p></table:table-cell>
+	</table:table-row>
+	<table:table-row table:number-rows-repeated="1048570"><table:table-cell table:number-columns-repeated="1024"/></table:table-row>
+</table:table>
+<table:table table:name="Other">
+	<table:table-row><table:table-cell office:value-type="string"><text:p>foo</text:p></table:table-cell></table:table-row>
+	<table:table-row><table:table-cell office:value-type="time" office:time-value="PT10H20M30S"><text:p>10:20:30</text:p></table:table-cell></table:table-row>
+</table:table>
+</office:spreadsheet></office:body>
+</office:document-content>`
+
+// makeZip returns zip archive with the given files (name => content)
+func makeZip(t *testing.T, files map[string]string) *bytes.Reader {
+	var (
+		req = require.New(t)
+		buf = &bytes.Buffer{}
+		z   = zip.NewWriter(buf)
+	)
+
+	for name, content := range files {
+		w, err := z.Create(name)
+		req.NoError(err)
+
+		_, err = w.Write([]byte(content))
+		req.NoError(err)
+	}
+
+	req.NoError(z.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+func makeOds(t *testing.T) *bytes.Reader {
+	return makeZip(t, map[string]string{"content.xml": testOdsContent})
+}
+
+func TestOdsDecoder(t *testing.T) {
+	t.Run("typed cells", func(t *testing.T) {
+		req := require.New(t)
+
+		dec, err := NewOdsDecoder(makeOds(t), SpreadsheetOptions{})
+		req.NoError(err)
+		req.Equal([]string{"Contacts", "Other"}, dec.Sheets())
+		req.Equal([]string{"name", "age", "born", "active"}, dec.Header())
+
+		c, err := dec.EntryCount()
+		req.NoError(err)
+		req.Equal(uint64(3), c)
+
+		vv, err := decodeSpreadsheetValues(dec, map[string]string{"name": "name", "age": "age", "born": "born", "active": "active"})
+		req.NoError(err)
+		req.Len(vv, 3)
+		req.Equal(map[string]string{"name": "Jane  Doe", "age": "42", "born": "1977-05-25T10:30:00Z", "active": "1"}, vv[0])
+		req.Equal(map[string]string{"name": "John", "age": "", "born": "", "active": "0"}, vv[1])
+		req.Equal(vv[1], vv[2])
+	})
+
+	t.Run("time cells", func(t *testing.T) {
+		req := require.New(t)
+
+		dec, err := NewOdsDecoder(makeOds(t), SpreadsheetOptions{Sheet: "Other"})
+		req.NoError(err)
+
+		vv, err := decodeSpreadsheetValues(dec, map[string]string{"foo": "foo"})
+		req.NoError(err)
+		req.Equal([]map[string]string{{"foo": "1899-12-30T10:20:30Z"}}, vv)
+	})
+
+	t.Run("not seekable", func(t *testing.T) {
+		req := require.New(t)
+
+		dec, err := NewOdsDecoder(struct{ io.Reader }{makeOds(t)}, SpreadsheetOptions{})
+		req.NoError(err)
+		req.Equal([]string{"Contacts", "Other"}, dec.Sheets())
+	})
+
+	t.Run("too many rows", func(t *testing.T) {
+		req := require.New(t)
+
+		content := strings.Replace(testOdsContent, `table:number-rows-repeated="2"`, `table:number-rows-repeated="1048576"`, 1)
+		_, err := NewOdsDecoder(makeZip(t, map[string]string{"content.xml": content}), SpreadsheetOptions{})
+		req.Equal(errSpreadsheetTooManyRows, err)
+	})
+
+	t.Run("too many cells", func(t *testing.T) {
+		req := require.New(t)
+
+		content := strings.Replace(testOdsContent, `table:number-rows-repeated="2"`, `table:number-rows-repeated="1000"`, 1)
+		content = strings.Replace(content,
+			`<table:table-cell table:number-columns-repeated="2"/>`,
+			`<table:table-cell table:number-columns-repeated="16384" office:value-type="float" office:value="1"/>`, 1)
+		_, err := NewOdsDecoder(makeZip(t, map[string]string{"content.xml": content}), SpreadsheetOptions{})
+		req.Equal(errSpreadsheetTooManyCells, err)
+	})
+
+	t.Run("only selected sheet is read", func(t *testing.T) {
+		req := require.New(t)
+
+		content := strings.Replace(testOdsContent,
+			`<table:table-row><table:table-cell office:value-type="string"><text:p>foo</text:p></table:table-cell></table:table-row>`,
+			`<table:table-row table:number-rows-repeated="1048577"><table:table-cell office:value-type="string"><text:p>foo</text:p></table:table-cell></table:table-row>`, 1)
+
+		dec, err := NewOdsDecoder(makeZip(t, map[string]string{"content.xml": content}), SpreadsheetOptions{})
+		req.NoError(err)
+		req.Equal([]string{"Contacts", "Other"}, dec.Sheets())
+
+		_, err = NewOdsDecoder(makeZip(t, map[string]string{"content.xml": content}), SpreadsheetOptions{Sheet: "Other"})
+		req.Equal(errSpreadsheetTooManyRows, err)
+
+		_, err = NewOdsDecoder(makeZip(t, map[string]string{"content.xml": content}), SpreadsheetOptions{Sheet: "Missing"})
+		req.Error(err)
+	})
+}
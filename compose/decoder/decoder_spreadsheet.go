@@ -0,0 +1,475 @@
+package decoder
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+)
+
+type (
+	cellKind int
+
+	// cell holds typed value of one spreadsheet cell
+	cell struct {
+		kind cellKind
+		str  string
+
+		// Numeric value of number, bool and date (serial date) cells
+		num float64
+		t   time.Time
+	}
+
+	// spreadsheetCell is a non-empty cell with its (0-based) column index
+	spreadsheetCell struct {
+		cell
+		col int
+	}
+
+	// spreadsheetRow holds non-empty cells (ordered by column) of one non-empty row
+	// and its (1-based) row number
+	//
+	// Only non-empty cells are kept; cells far to the right do not take any
+	// more memory than the ones at the start of the row
+	spreadsheetRow struct {
+		num   int
+		cells []spreadsheetCell
+	}
+
+	spreadsheetSheet struct {
+		name string
+		rows []spreadsheetRow
+	}
+
+	SpreadsheetOptions struct {
+		// Name of the sheet with records; first sheet is used when empty
+		Sheet string
+
+		// Number of the row (1-based) with column names; detected when 0
+		HeaderRow int
+
+		// Fields of the module records are imported into;
+		// typed cells are converted to the format of the field kind
+		Fields types.ModuleFieldSet
+	}
+
+	// spreadsheetDecoder decodes records from one sheet of a spreadsheet (xlsx, ods)
+	//
+	// Rows after the header row are imported, empty rows are skipped.
+	// Only the selected sheet is read, other sheets are listed by name
+	spreadsheetDecoder struct {
+		sheets []string
+		sheet  *spreadsheetSheet
+		fields types.ModuleFieldSet
+
+		header []string
+
+		// Index of the header row in the sheet's rows
+		headerIndex int
+
+		// Serial dates count days from 1904-01-01 (xlsx workbook option)
+		date1904 bool
+	}
+)
+
+const (
+	cellEmpty cellKind = iota
+	cellString
+	cellNumber
+	cellDate
+	cellBool
+)
+
+const (
+	// Number of rows that are checked when header row is detected
+	spreadsheetHeaderScan = 10
+
+	// Cells after this column are ignored; repeated (ods) columns are expanded up to it
+	spreadsheetMaxRepeat = 16384
+
+	// Non-empty rows of the selected sheet are kept in memory; sheets with more rows
+	// are rejected (same as the row limit of xlsx)
+	spreadsheetMaxRows = 1048576
+
+	// Non-empty cells of the selected sheet (with expanded ods repeats) are kept in memory;
+	// sheets with more cells are rejected
+	spreadsheetMaxCells = 8 << 20
+
+	// Spreadsheet that can not be read in place is read into memory up to spreadsheetMaxSize;
+	// each uncompressed part (sheet, shared strings) is read up to spreadsheetMaxPartSize
+	spreadsheetMaxSize     = 64 << 20
+	spreadsheetMaxPartSize = 512 << 20
+)
+
+var (
+	errSpreadsheetTooLarge     = errors.New("spreadsheet is too large")
+	errSpreadsheetTooManyRows  = fmt.Errorf("spreadsheet has more than %d rows", spreadsheetMaxRows)
+	errSpreadsheetTooManyCells = fmt.Errorf("spreadsheet has more than %d cells", spreadsheetMaxCells)
+
+	// Spreadsheets count days from this date
+	spreadsheetEpoch     = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+	spreadsheetEpoch1904 = time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// newSpreadsheetDecoder prepares decoder for the selected sheet (nil when it does not exist)
+// of the spreadsheet with the given sheet names
+func newSpreadsheetDecoder(sheets []string, sheet *spreadsheetSheet, opt SpreadsheetOptions) (*spreadsheetDecoder, error) {
+	if sheet == nil {
+		return nil, sheetNotFound(opt.Sheet)
+	}
+
+	dec := &spreadsheetDecoder{
+		sheets: sheets,
+		sheet:  sheet,
+		fields: opt.Fields,
+	}
+
+	if opt.HeaderRow > 0 {
+		dec.headerIndex = -1
+		for i, r := range dec.sheet.rows {
+			if r.num == opt.HeaderRow {
+				dec.headerIndex = i
+				break
+			}
+		}
+
+		if dec.headerIndex < 0 {
+			return nil, fmt.Errorf("header row %d is empty", opt.HeaderRow)
+		}
+	} else {
+		dec.headerIndex = detectHeaderRow(dec.sheet.rows)
+	}
+
+	if len(dec.sheet.rows) > 0 {
+		for _, c := range dec.sheet.rows[dec.headerIndex].cells {
+			// Empty header cells are named by their column
+			for len(dec.header) < c.col {
+				dec.header = append(dec.header, columnName(len(dec.header)))
+			}
+
+			if name := strings.TrimSpace(c.String()); name != "" {
+				dec.header = append(dec.header, name)
+			} else {
+				dec.header = append(dec.header, columnName(c.col))
+			}
+		}
+	}
+
+	return dec, nil
+}
+
+func sheetNotFound(name string) error {
+	if name == "" {
+		return errors.New("spreadsheet without sheets")
+	}
+
+	return fmt.Errorf("sheet %q does not exist", name)
+}
+
+// detectHeaderRow returns index of the first row with only text cells and
+// with as many cells as the widest of the scanned rows
+//
+// Title rows (usually one cell) before the header are skipped that way
+func detectHeaderRow(rows []spreadsheetRow) int {
+	var (
+		width = 0
+		scan  = rows
+	)
+
+	if len(scan) > spreadsheetHeaderScan {
+		scan = scan[:spreadsheetHeaderScan]
+	}
+
+	for _, r := range scan {
+		if w := r.width(); w > width {
+			width = w
+		}
+	}
+
+	for i, r := range scan {
+		if r.width() == width && r.isText() {
+			return i
+		}
+	}
+
+	return 0
+}
+
+// Sheets returns names of all sheets in the spreadsheet
+func (dec *spreadsheetDecoder) Sheets() []string {
+	return dec.sheets
+}
+
+func (dec *spreadsheetDecoder) Header() []string {
+	return dec.header
+}
+
+func (dec *spreadsheetDecoder) EntryCount() (uint64, error) {
+	if len(dec.sheet.rows) == 0 {
+		return 0, nil
+	}
+
+	return uint64(len(dec.sheet.rows) - dec.headerIndex - 1), nil
+}
+
+func (dec *spreadsheetDecoder) Records(fields map[string]string, Create RecordCreator) error {
+	var columns = make(map[string]int)
+	for i, h := range dec.header {
+		columns[h] = i
+	}
+
+	if len(dec.sheet.rows) == 0 {
+		return nil
+	}
+
+	for _, row := range dec.sheet.rows[dec.headerIndex+1:] {
+		r := types.Record{}
+		rvs := types.RecordValueSet{}
+
+		i := 0
+		for imp, rec := range fields {
+			if rec == "" {
+				return errors.New("Can not import record: Record field not defined")
+			}
+
+			var c cell
+			if col, ok := columns[imp]; ok {
+				c = row.get(col)
+			}
+
+			val := c.format(dec.fields.FindByName(rec), dec.date1904)
+			if system, err := setSystemField(&r, rec, val); err != nil {
+				return err
+			} else if !system {
+				rv := types.RecordValue{
+					Name:  rec,
+					Value: val,
+					Place: uint(i),
+				}
+				i++
+
+				rvs = append(rvs, &rv)
+			}
+		}
+
+		r.Values = rvs
+		if err := Create(&r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// set sets non-empty cell in the given column
+//
+// Cells are usually set in column order and appended
+func (r *spreadsheetRow) set(col int, c cell) {
+	i := sort.Search(len(r.cells), func(i int) bool { return r.cells[i].col >= col })
+	if i < len(r.cells) && r.cells[i].col == col {
+		r.cells[i].cell = c
+		return
+	}
+
+	r.cells = append(r.cells, spreadsheetCell{})
+	copy(r.cells[i+1:], r.cells[i:])
+	r.cells[i] = spreadsheetCell{cell: c, col: col}
+}
+
+// get returns cell in the given column; empty cell when there is none
+func (r spreadsheetRow) get(col int) cell {
+	i := sort.Search(len(r.cells), func(i int) bool { return r.cells[i].col >= col })
+	if i < len(r.cells) && r.cells[i].col == col {
+		return r.cells[i].cell
+	}
+
+	return cell{}
+}
+
+// width returns number of non-empty cells
+func (r spreadsheetRow) width() int {
+	return len(r.cells)
+}
+
+// isText returns true when all non-empty cells are text cells
+func (r spreadsheetRow) isText() bool {
+	for _, c := range r.cells {
+		if c.kind != cellString {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns cell value without any knowledge about the target field
+func (c cell) String() string {
+	return c.format(nil, false)
+}
+
+// format returns cell value in the format expected by the field kind
+//
+// Numbers imported into date fields are serial dates of the workbook's date system.
+// Spreadsheet dates do not have a timezone, UTC is used
+func (c cell) format(f *types.ModuleField, date1904 bool) string {
+	var kind string
+	if f != nil {
+		kind = f.Kind
+	}
+
+	switch c.kind {
+	case cellString:
+		return c.str
+
+	case cellBool:
+		if c.num != 0 {
+			return "1"
+		}
+		return "0"
+
+	case cellNumber:
+		switch kind {
+		case "Bool":
+			if c.num != 0 {
+				return "1"
+			}
+			return "0"
+
+		case "DateTime":
+			return formatSpreadsheetTime(f, fromSpreadsheetSerial(c.num, date1904))
+		}
+
+		if f != nil {
+			if p, ok := f.Options.Int64("precision"); ok && p >= 0 {
+				return strconv.FormatFloat(c.num, 'f', int(p), 64)
+			}
+		}
+
+		return strconv.FormatFloat(c.num, 'f', -1, 64)
+
+	case cellDate:
+		if kind == "Number" {
+			return strconv.FormatFloat(c.num, 'f', -1, 64)
+		}
+
+		return formatSpreadsheetTime(f, c.t)
+	}
+
+	return ""
+}
+
+func formatSpreadsheetTime(f *types.ModuleField, t time.Time) string {
+	switch {
+	case f != nil && f.Options.Bool("onlyDate"):
+		return t.Format("2006-01-02")
+	case f != nil && f.Options.Bool("onlyTime"):
+		return t.Format("15:04:05")
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+// fromSpreadsheetSerial converts serial date (number of days since epoch) to time
+//
+// Time is rounded to the nearest second
+func fromSpreadsheetSerial(serial float64, date1904 bool) time.Time {
+	var (
+		epoch   = spreadsheetEpoch
+		days    = math.Floor(serial)
+		seconds = math.Round((serial - days) * 86400)
+	)
+
+	if date1904 {
+		epoch = spreadsheetEpoch1904
+	}
+
+	return epoch.AddDate(0, 0, int(days)).Add(time.Duration(seconds) * time.Second)
+}
+
+// toSpreadsheetSerial converts time to serial date
+func toSpreadsheetSerial(t time.Time) float64 {
+	return t.Sub(spreadsheetEpoch).Hours() / 24
+}
+
+// columnName returns spreadsheet column name (A, B, ... AA, AB...) for the 0-based column index
+func columnName(i int) (name string) {
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+
+	return
+}
+
+// openZip opens the file as a zip archive
+//
+// Files with random access (uploads, stored files) are read in place,
+// others are read into memory
+func openZip(f io.Reader) (*zip.Reader, error) {
+	if ra, ok := f.(interface {
+		io.ReaderAt
+		io.Seeker
+	}); ok {
+		size, err := ra.Seek(0, io.SeekEnd)
+		if err != nil {
+			return nil, err
+		}
+
+		return zip.NewReader(ra, size)
+	}
+
+	buf, err := ioutil.ReadAll(io.LimitReader(f, spreadsheetMaxSize+1))
+	if err != nil {
+		return nil, err
+	} else if len(buf) > spreadsheetMaxSize {
+		return nil, errSpreadsheetTooLarge
+	}
+
+	return zip.NewReader(bytes.NewReader(buf), int64(len(buf)))
+}
+
+// zipFile opens file in the zip archive; returns nil when file does not exist
+//
+// Reading fails after spreadsheetMaxPartSize bytes of uncompressed content
+func zipFile(z *zip.Reader, name string) (io.ReadCloser, error) {
+	for _, f := range z.File {
+		if f.Name == name {
+			rc, err := f.Open()
+			if err != nil {
+				return nil, err
+			}
+
+			return &zipPart{ReadCloser: rc, n: spreadsheetMaxPartSize}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// zipPart is a file in the zip archive with limited size
+type zipPart struct {
+	io.ReadCloser
+	n int64
+}
+
+func (p *zipPart) Read(b []byte) (n int, err error) {
+	if p.n <= 0 {
+		return 0, errSpreadsheetTooLarge
+	}
+
+	if int64(len(b)) > p.n {
+		b = b[:p.n]
+	}
+
+	n, err = p.ReadCloser.Read(b)
+	p.n -= int64(n)
+	return
+}
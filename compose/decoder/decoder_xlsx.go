@@ -0,0 +1,364 @@
+package decoder
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type (
+	// xlsx holds parts of the workbook needed to read cell values
+	xlsx struct {
+		z *zip.Reader
+
+		date1904 bool
+		strings  []string
+
+		// Number of non-empty rows and cells in the sheet
+		rows, cells int
+
+		// Date formatted styles (index of the cell style => is date)
+		dateStyles []bool
+	}
+
+	xlsxWorkbook struct {
+		Pr struct {
+			Date1904 bool `xml:"date1904,attr"`
+		} `xml:"workbookPr"`
+		Sheets []struct {
+			Name  string `xml:"name,attr"`
+			RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+
+	xlsxRelationships struct {
+		Rels []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+
+	// xlsxText is a shared or inline string; rich text is split into runs
+	xlsxText struct {
+		T    string `xml:"t"`
+		Runs []struct {
+			T string `xml:"t"`
+		} `xml:"r"`
+	}
+
+	xlsxSharedStrings struct {
+		Items []xlsxText `xml:"si"`
+	}
+
+	xlsxStyles struct {
+		NumFmts []struct {
+			ID   int    `xml:"numFmtId,attr"`
+			Code string `xml:"formatCode,attr"`
+		} `xml:"numFmts>numFmt"`
+		CellXfs []struct {
+			NumFmtID int `xml:"numFmtId,attr"`
+		} `xml:"cellXfs>xf"`
+	}
+
+	// xlsxCell is a cell of the worksheet; cells are decoded one by one
+	// and only non-empty cells are kept
+	xlsxCell struct {
+		R  string    `xml:"r,attr"`
+		S  int       `xml:"s,attr"`
+		T  string    `xml:"t,attr"`
+		V  string    `xml:"v"`
+		Is *xlsxText `xml:"is"`
+	}
+)
+
+var (
+	// Quoted text, escaped characters and bracketed sections ([Red], [$-409]) of number format codes;
+	// these are ignored when format is checked for date & time placeholders
+	xlsxFormatLiterals = regexp.MustCompile(`"[^"]*"|\\.|\[[^\]]*\]`)
+
+	errXlsxMissingPart = errors.New("missing workbook part")
+)
+
+// NewXlsxDecoder reads the selected sheet of the Office Open XML workbook
+//
+// Cells are typed by their type and (for dates) by their number format
+func NewXlsxDecoder(f io.Reader, opt SpreadsheetOptions) (*spreadsheetDecoder, error) {
+	z, err := openZip(f)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		x = &xlsx{z: z}
+		w = &xlsxWorkbook{}
+		r = &xlsxRelationships{}
+		s = &xlsxSharedStrings{}
+		y = &xlsxStyles{}
+
+		names []string
+		sheet *spreadsheetSheet
+	)
+
+	if err = x.decode("xl/workbook.xml", w); err != nil {
+		return nil, err
+	}
+
+	for name, v := range map[string]interface{}{"xl/_rels/workbook.xml.rels": r, "xl/sharedStrings.xml": s, "xl/styles.xml": y} {
+		if err = x.decode(name, v); err != nil && err != errXlsxMissingPart {
+			return nil, err
+		}
+	}
+
+	x.date1904 = w.Pr.Date1904
+
+	for _, i := range s.Items {
+		x.strings = append(x.strings, i.String())
+	}
+
+	x.dateStyles = make([]bool, len(y.CellXfs))
+	for i, xf := range y.CellXfs {
+		x.dateStyles[i] = isXlsxDateFormat(xf.NumFmtID, "")
+		for _, nf := range y.NumFmts {
+			if nf.ID == xf.NumFmtID {
+				x.dateStyles[i] = isXlsxDateFormat(nf.ID, nf.Code)
+			}
+		}
+	}
+
+	for _, ws := range w.Sheets {
+		names = append(names, ws.Name)
+
+		if sheet != nil || (opt.Sheet != "" && ws.Name != opt.Sheet) {
+			continue
+		}
+
+		var target string
+		for _, rel := range r.Rels {
+			if rel.ID == ws.RelID {
+				target = rel.Target
+			}
+		}
+
+		if target == "" {
+			return nil, fmt.Errorf("sheet %q not found in the workbook", ws.Name)
+		}
+
+		// Targets are relative to the workbook
+		if strings.HasPrefix(target, "/") {
+			target = strings.TrimPrefix(target, "/")
+		} else {
+			target = path.Join("xl", target)
+		}
+
+		if sheet, err = x.sheet(ws.Name, target); err != nil {
+			return nil, err
+		}
+	}
+
+	dec, err := newSpreadsheetDecoder(names, sheet, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	dec.date1904 = x.date1904
+	return dec, nil
+}
+
+func (x *xlsx) decode(name string, v interface{}) error {
+	f, err := zipFile(x.z, name)
+	if err != nil {
+		return err
+	} else if f == nil {
+		return errXlsxMissingPart
+	}
+
+	defer f.Close()
+	return xml.NewDecoder(f).Decode(v)
+}
+
+// sheet reads rows of the worksheet
+//
+// Worksheet is read cell by cell so that only the non-empty cells are kept in memory
+func (x *xlsx) sheet(name, target string) (*spreadsheetSheet, error) {
+	f, err := zipFile(x.z, target)
+	if err != nil {
+		return nil, fmt.Errorf("could not read sheet %q: %v", name, err)
+	} else if f == nil {
+		return nil, fmt.Errorf("could not read sheet %q: %v", name, errXlsxMissingPart)
+	}
+
+	defer f.Close()
+
+	var (
+		d     = xml.NewDecoder(f)
+		sheet = &spreadsheetSheet{name: name}
+
+		row *spreadsheetRow
+
+		// Number of row elements and cell elements in the current row;
+		// row numbers and cell references are optional
+		rowElements, cellElements int
+	)
+
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return sheet, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("could not read sheet %q: %v", name, err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch {
+			case t.Name.Local == "row":
+				rowElements++
+				cellElements = 0
+				row = &spreadsheetRow{num: rowElements}
+
+				for _, a := range t.Attr {
+					if a.Name.Local != "r" {
+						continue
+					}
+
+					if n, err := strconv.Atoi(a.Value); err == nil && n > 0 {
+						row.num = n
+					}
+				}
+
+			case t.Name.Local == "c" && row != nil:
+				var wc = xlsxCell{}
+				if err = d.DecodeElement(&wc, &t); err != nil {
+					return nil, fmt.Errorf("could not read sheet %q: %v", name, err)
+				}
+
+				col := cellElements
+				cellElements++
+
+				if wc.R != "" {
+					col = xlsxColumn(wc.R)
+				}
+
+				if col < 0 || col >= spreadsheetMaxRepeat {
+					continue
+				}
+
+				c := x.cell(wc)
+				if c.kind == cellEmpty || (c.kind == cellString && c.str == "") {
+					continue
+				}
+
+				if x.cells++; x.cells > spreadsheetMaxCells {
+					return nil, errSpreadsheetTooManyCells
+				}
+
+				row.set(col, c)
+			}
+
+		case xml.EndElement:
+			if t.Name.Local != "row" || row == nil {
+				continue
+			}
+
+			if row.width() > 0 {
+				if x.rows++; x.rows > spreadsheetMaxRows {
+					return nil, errSpreadsheetTooManyRows
+				}
+
+				sheet.rows = append(sheet.rows, *row)
+			}
+
+			row = nil
+		}
+	}
+}
+
+// cell returns typed value of the worksheet cell
+func (x *xlsx) cell(wc xlsxCell) (c cell) {
+	switch wc.T {
+	case "s":
+		if n, err := strconv.Atoi(wc.V); err == nil && n >= 0 && n < len(x.strings) {
+			c = cell{kind: cellString, str: x.strings[n]}
+		}
+	case "inlineStr":
+		if wc.Is != nil {
+			c = cell{kind: cellString, str: wc.Is.String()}
+		}
+	case "str", "e":
+		c = cell{kind: cellString, str: wc.V}
+	case "b":
+		c = cell{kind: cellBool}
+		if wc.V == "1" {
+			c.num = 1
+		}
+	case "d":
+		// ISO 8601 dates (strict OOXML)
+		if t, err := fmtTime(wc.V); err == nil {
+			c = cell{kind: cellDate, t: t.UTC(), num: toSpreadsheetSerial(t)}
+		} else {
+			c = cell{kind: cellString, str: wc.V}
+		}
+	default:
+		if wc.V == "" {
+			break
+		}
+
+		n, err := strconv.ParseFloat(wc.V, 64)
+		if err != nil {
+			c = cell{kind: cellString, str: wc.V}
+		} else if wc.S >= 0 && wc.S < len(x.dateStyles) && x.dateStyles[wc.S] {
+			c = cell{kind: cellDate, t: fromSpreadsheetSerial(n, x.date1904), num: n}
+		} else {
+			c = cell{kind: cellNumber, num: n}
+		}
+	}
+
+	return c
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.T
+	}
+
+	var b strings.Builder
+	for _, r := range t.Runs {
+		b.WriteString(r.T)
+	}
+
+	return b.String()
+}
+
+// xlsxColumn returns 0-based column index from the cell reference (A1, BC12)
+func xlsxColumn(ref string) (col int) {
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+
+		col = col*26 + int(r-'A') + 1
+	}
+
+	return col - 1
+}
+
+// isXlsxDateFormat checks if builtin number format or custom format code is for dates or times
+func isXlsxDateFormat(ID int, code string) bool {
+	if code == "" {
+		return (ID >= 14 && ID <= 22) || (ID >= 27 && ID <= 36) || (ID >= 45 && ID <= 47) || (ID >= 50 && ID <= 58)
+	}
+
+	// Only the first section (positive numbers) is checked
+	code = xlsxFormatLiterals.ReplaceAllString(code, "")
+	if i := strings.Index(code, ";"); i >= 0 {
+		code = code[:i]
+	}
+
+	return strings.ContainsAny(strings.ToLower(code), "ymdhs")
+}
@@ -0,0 +1,205 @@
+package decoder
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/360EntSecGroup-Skylar/excelize/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+)
+
+// decodeSpreadsheetValues returns values of all decoded records (field name => value)
+func decodeSpreadsheetValues(dec *spreadsheetDecoder, fields map[string]string) (out []map[string]string, err error) {
+	return out, dec.Records(fields, func(r *types.Record) error {
+		vv := map[string]string{}
+		for _, v := range r.Values {
+			vv[v.Name] = v.Value
+		}
+
+		out = append(out, vv)
+		return nil
+	})
+}
+
+func makeXlsx(t *testing.T) *bytes.Reader {
+	var (
+		req = require.New(t)
+		f   = excelize.NewFile()
+		set = func(sheet, axis string, v interface{}) {
+			req.NoError(f.SetCellValue(sheet, axis, v))
+		}
+	)
+
+	f.SetSheetName("Sheet1", "Contacts")
+	f.NewSheet("Other")
+
+	// Title and an empty row before the header
+	set("Contacts", "A1", "Contacts export")
+	set("Contacts", "A3", "name")
+	set("Contacts", "B3", "age")
+	set("Contacts", "C3", "born")
+	set("Contacts", "D3", "active")
+
+	set("Contacts", "A4", "Jane")
+	set("Contacts", "B4", 42)
+	set("Contacts", "C4", time.Date(1977, 5, 25, 10, 30, 0, 0, time.UTC))
+	set("Contacts", "D4", true)
+
+	// Empty row in between is skipped
+	set("Contacts", "A6", "John")
+	set("Contacts", "B6", 12.5)
+	set("Contacts", "D6", false)
+
+	set("Other", "A1", "foo")
+	set("Other", "A2", "bar")
+
+	buf, err := f.WriteToBuffer()
+	req.NoError(err)
+
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestXlsxDecoder(t *testing.T) {
+	var (
+		fields = map[string]string{"name": "name", "age": "age", "born": "born", "active": "active"}
+	)
+
+	t.Run("typed cells", func(t *testing.T) {
+		req := require.New(t)
+
+		dec, err := NewXlsxDecoder(makeXlsx(t), SpreadsheetOptions{})
+		req.NoError(err)
+		req.Equal([]string{"Contacts", "Other"}, dec.Sheets())
+		req.Equal([]string{"name", "age", "born", "active"}, dec.Header())
+
+		c, err := dec.EntryCount()
+		req.NoError(err)
+		req.Equal(uint64(2), c)
+
+		vv, err := decodeSpreadsheetValues(dec, fields)
+		req.NoError(err)
+		req.Len(vv, 2)
+		req.Equal(map[string]string{"name": "Jane", "age": "42", "born": "1977-05-25T10:30:00Z", "active": "1"}, vv[0])
+		req.Equal(map[string]string{"name": "John", "age": "12.5", "born": "", "active": "0"}, vv[1])
+	})
+
+	t.Run("converted to field kinds", func(t *testing.T) {
+		req := require.New(t)
+
+		dec, err := NewXlsxDecoder(makeXlsx(t), SpreadsheetOptions{
+			Fields: types.ModuleFieldSet{
+				&types.ModuleField{Name: "age", Kind: "Number", Options: types.ModuleFieldOptions{"precision": 2}},
+				&types.ModuleField{Name: "born", Kind: "DateTime", Options: types.ModuleFieldOptions{"onlyDate": true}},
+			},
+		})
+		req.NoError(err)
+
+		vv, err := decodeSpreadsheetValues(dec, fields)
+		req.NoError(err)
+		req.Equal("42.00", vv[0]["age"])
+		req.Equal("1977-05-25", vv[0]["born"])
+	})
+
+	t.Run("sheet and header row", func(t *testing.T) {
+		req := require.New(t)
+
+		dec, err := NewXlsxDecoder(makeXlsx(t), SpreadsheetOptions{Sheet: "Other", HeaderRow: 1})
+		req.NoError(err)
+		req.Equal([]string{"foo"}, dec.Header())
+
+		vv, err := decodeSpreadsheetValues(dec, map[string]string{"foo": "foo"})
+		req.NoError(err)
+		req.Equal([]map[string]string{{"foo": "bar"}}, vv)
+
+		_, err = NewXlsxDecoder(makeXlsx(t), SpreadsheetOptions{Sheet: "Missing"})
+		req.Error(err)
+
+		_, err = NewXlsxDecoder(makeXlsx(t), SpreadsheetOptions{HeaderRow: 2})
+		req.Error(err)
+	})
+}
+
+// makeXlsxSheet returns workbook with one sheet (Sheet1) with the given sheet data;
+// second cell style is date formatted
+func makeXlsxSheet(t *testing.T, workbookPr, sheetData string) *bytes.Reader {
+	return makeZip(t, map[string]string{
+		"xl/workbook.xml": `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+			` + workbookPr + `
+			<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets>
+		</workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+			<Relationship Id="rId1" Target="worksheets/sheet1.xml"/>
+		</Relationships>`,
+		"xl/styles.xml": `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+			<cellXfs><xf numFmtId="0"/><xf numFmtId="14"/></cellXfs>
+		</styleSheet>`,
+		"xl/worksheets/sheet1.xml": `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>` +
+			sheetData +
+			`</sheetData></worksheet>`,
+	})
+}
+
+func TestXlsxDecoderDate1904(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		f = makeXlsxSheet(t, `<workbookPr date1904="1"/>`, `
+			<row r="1"><c r="A1" t="inlineStr"><is><t>date</t></is></c><c r="B1" t="inlineStr"><is><t>number</t></is></c></row>
+			<row r="2"><c r="A2" s="1"><v>1.5</v></c><c r="B2"><v>1.5</v></c></row>`)
+	)
+
+	dec, err := NewXlsxDecoder(f, SpreadsheetOptions{
+		Fields: types.ModuleFieldSet{
+			&types.ModuleField{Name: "number", Kind: "DateTime"},
+		},
+	})
+	req.NoError(err)
+
+	vv, err := decodeSpreadsheetValues(dec, map[string]string{"date": "date", "number": "number"})
+	req.NoError(err)
+	req.Equal([]map[string]string{{"date": "1904-01-02T12:00:00Z", "number": "1904-01-02T12:00:00Z"}}, vv)
+}
+
+func TestXlsxDecoderSparseCells(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		// Cells in the last column and cells out of column order
+		f = makeXlsxSheet(t, "", `
+			<row r="1"><c r="A1" t="inlineStr"><is><t>name</t></is></c><c r="XFD1" t="inlineStr"><is><t>last</t></is></c></row>
+			<row r="2"><c r="XFD2"><v>42</v></c><c r="A2" t="inlineStr"><is><t>Jane</t></is></c><c r="C2" t="inlineStr"><is><t>ignored</t></is></c></row>
+			<row><c t="inlineStr"><is><t>John</t></is></c></row>`)
+	)
+
+	dec, err := NewXlsxDecoder(f, SpreadsheetOptions{})
+	req.NoError(err)
+
+	header := dec.Header()
+	req.Len(header, spreadsheetMaxRepeat)
+	req.Equal("name", header[0])
+	req.Equal("B", header[1])
+	req.Equal("last", header[spreadsheetMaxRepeat-1])
+
+	for _, r := range dec.sheet.rows {
+		req.True(r.width() <= 3, "only non-empty cells should be kept")
+	}
+
+	vv, err := decodeSpreadsheetValues(dec, map[string]string{"name": "name", "last": "last"})
+	req.NoError(err)
+	req.Equal([]map[string]string{{"name": "Jane", "last": "42"}, {"name": "John", "last": ""}}, vv)
+}
+
+func TestIsXlsxDateFormat(t *testing.T) {
+	req := require.New(t)
+
+	req.True(isXlsxDateFormat(14, ""))
+	req.True(isXlsxDateFormat(22, ""))
+	req.False(isXlsxDateFormat(2, ""))
+	req.True(isXlsxDateFormat(164, "dd/mm/yyyy"))
+	req.True(isXlsxDateFormat(164, "[$-409]h:mm AM/PM"))
+	req.False(isXlsxDateFormat(164, `#,##0.00 "days"`))
+	req.False(isXlsxDateFormat(164, "[Red]0.00"))
+}
@@ -1,11 +1,61 @@
 package encoder
 
+import (
+	"strings"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+)
+
 type (
 	multiple uint
 
+	// MultiValue tells how flat encoders (csv, xlsx) encode values of multi-value fields
+	MultiValue string
+
+	// Options for encoding record values
+	Options struct {
+		MultiValue MultiValue
+
+		// Delimiter of joined values (MultiValueJoin)
+		Delimiter string
+
+		// Encode labels of referenced records and users instead of their IDs
+		Labels bool
+	}
+
+	// FieldInfo tells encoders more about exported fields; see Prepare
+	FieldInfo interface {
+		// Field returns module field with the given name; nil for system (and unknown) fields
+		Field(name string) *types.ModuleField
+
+		// MaxValueCount returns the highest number of values one of exported records has for the field
+		MaxValueCount(name string) (uint, error)
+
+		// RecordLabel returns label of the record that Record field references
+		RecordLabel(f *types.ModuleField, recordID uint64) string
+
+		// UserLabel returns label of the referenced user
+		UserLabel(userID uint64) string
+	}
+
 	field struct {
-		name           string
-		encodeAllMulti bool
+		name string
+
+		// Multi-value field; values are always encoded as multiple
+		multi bool
+
+		// Kind of the module field, empty for system fields
+		kind string
+
+		// Number of columns of the multi-value field (MultiValueColumns only)
+		columns uint
+	}
+
+	// fieldFormatter formats values of the exported fields
+	fieldFormatter struct {
+		ff  []field
+		opt Options
+		fi  FieldInfo
 	}
 
 	FlatWriter interface {
@@ -18,16 +68,32 @@ type (
 	}
 
 	flatWriter struct {
-		w  FlatWriter
-		ff []field
+		fieldFormatter
+
+		w      FlatWriter
+		header bool
 	}
 
 	structuredEncoder struct {
-		w  StructuredEncoder
-		ff []field
+		fieldFormatter
+
+		w StructuredEncoder
 	}
 )
 
+const (
+	// Values are joined with delimiter into one column (default)
+	MultiValueJoin MultiValue = "join"
+
+	// Values are encoded into repeated columns (name[1], name[2]...)
+	MultiValueColumns MultiValue = "columns"
+
+	// Values are encoded as JSON array into one column
+	MultiValueJSON MultiValue = "json"
+
+	DefaultDelimiter = ", "
+)
+
 func Field(name string) field {
 	return field{name: name}
 }
@@ -42,42 +108,125 @@ func MakeFields(nn ...string) []field {
 }
 
 func MultiValueField(name string) field {
-	return field{name: name, encodeAllMulti: true}
+	return field{name: name, multi: true}
 }
 
-func NewFlatWriter(w FlatWriter, header bool, ff ...field) *flatWriter {
-	f := &flatWriter{
-		w:  w,
-		ff: ff,
+// ParseMultiValue returns multi-value encoding; MultiValueJoin when empty
+func ParseMultiValue(s string) (MultiValue, bool) {
+	switch mv := MultiValue(strings.ToLower(strings.TrimSpace(s))); mv {
+	case "":
+		return MultiValueJoin, true
+	case MultiValueJoin, MultiValueColumns, MultiValueJSON:
+		return mv, true
 	}
 
-	if header {
-		f.writeHeader()
+	return "", false
+}
+
+func newFieldFormatter(opt Options, ff []field) fieldFormatter {
+	if opt.MultiValue == "" {
+		opt.MultiValue = MultiValueJoin
+	}
+
+	if opt.Delimiter == "" {
+		opt.Delimiter = DefaultDelimiter
+	}
+
+	return fieldFormatter{ff: ff, opt: opt}
+}
+
+// Prepare is called before the first encoded record
+//
+// Encoder learns kinds of the exported fields and (for MultiValueColumns) how many
+// columns each multi-value field needs; field info is kept to resolve labels
+func (enc *fieldFormatter) Prepare(fi FieldInfo) error {
+	enc.fi = fi
+
+	for i := range enc.ff {
+		mf := fi.Field(enc.ff[i].name)
+		if mf == nil {
+			continue
+		}
+
+		enc.ff[i].kind = mf.Kind
+		enc.ff[i].multi = mf.Multi
+
+		if mf.Multi && enc.opt.MultiValue == MultiValueColumns {
+			c, err := fi.MaxValueCount(mf.Name)
+			if err != nil {
+				return err
+			}
+
+			enc.ff[i].columns = c
+		}
+	}
+
+	return nil
+}
+
+// Labels returns true when encoder needs labels of referenced records and users
+func (enc fieldFormatter) Labels() bool {
+	return enc.opt.Labels
+}
+
+// header returns names of all columns
+func (enc fieldFormatter) header() []string {
+	var ss = make([]string, 0, len(enc.ff))
+	for _, f := range enc.ff {
+		if f.columns > 1 {
+			for c := uint(1); c <= f.columns; c++ {
+				ss = append(ss, f.name+"["+fmtUint64(uint64(c))+"]")
+			}
+		} else {
+			ss = append(ss, f.name)
+		}
 	}
 
-	return f
+	return ss
+}
+
+// NewFlatWriter creates flat writer
+//
+// Header is written with the first record (or on flush when there are no records)
+func NewFlatWriter(w FlatWriter, header bool, opt Options, ff ...field) *flatWriter {
+	return &flatWriter{
+		fieldFormatter: newFieldFormatter(opt, ff),
+		w:              w,
+		header:         header,
+	}
 }
 
-func (enc flatWriter) Flush() {
+func (enc *flatWriter) Flush() {
+	enc.writeHeader()
 	enc.w.Flush()
 }
 
-func (enc flatWriter) writeHeader() {
-	ss := make([]string, len(enc.ff))
-	for i := range enc.ff {
-		ss[i] = enc.ff[i].name
+func (enc *flatWriter) writeHeader() {
+	if !enc.header {
+		return
 	}
 
-	_ = enc.w.Write(ss)
+	enc.header = false
+	_ = enc.w.Write(enc.fieldFormatter.header())
 }
 
-func NewStructuredEncoder(w StructuredEncoder, ff ...field) *structuredEncoder {
+func NewStructuredEncoder(w StructuredEncoder, opt Options, ff ...field) *structuredEncoder {
 	return &structuredEncoder{
-		w:  w,
-		ff: ff,
+		fieldFormatter: newFieldFormatter(opt, ff),
+		w:              w,
 	}
 }
 
 func (enc structuredEncoder) Flush() {
 	// noop
 }
+
+// IsSystemField returns true for fields of the record (not its values) that can be encoded
+func IsSystemField(name string) bool {
+	switch name {
+	case "recordID", "ID", "moduleID", "namespaceID", "ownedBy", "createdBy", "createdAt", "updatedBy", "updatedAt", "deletedBy", "deletedAt":
+		return true
+	}
+
+	return false
+}
@@ -7,29 +7,31 @@ import (
 )
 
 type (
+	// excelizeEncoder encodes records into xlsx workbook
+	//
+	// Workbook is kept in memory and written on flush
 	excelizeEncoder struct {
-		row int
-		f   *excelize.File
-		w   io.Writer
-		ff  []field
+		fieldFormatter
+
+		row    int
+		f      *excelize.File
+		w      io.Writer
+		header bool
 	}
 )
 
-func NewExcelizeEncoder(w io.Writer, header bool, ff ...field) *excelizeEncoder {
-	enc := &excelizeEncoder{
-		f:  excelize.NewFile(),
-		w:  w,
-		ff: ff,
-	}
+func NewExcelizeEncoder(w io.Writer, header bool, opt Options, ff ...field) *excelizeEncoder {
+	return &excelizeEncoder{
+		fieldFormatter: newFieldFormatter(opt, ff),
 
-	if header {
-		enc.writeHeader()
+		f:      excelize.NewFile(),
+		w:      w,
+		header: header,
 	}
-
-	return enc
 }
 
 func (enc *excelizeEncoder) Flush() {
+	enc.writeHeader()
 	_ = enc.f.Write(enc.w)
 }
 
@@ -44,8 +46,13 @@ func (enc excelizeEncoder) sheet() string {
 }
 
 func (enc *excelizeEncoder) writeHeader() {
+	if !enc.header {
+		return
+	}
+
+	enc.header = false
 	enc.row++
-	for p := range enc.ff {
-		_ = enc.f.SetCellStr(enc.sheet(), enc.pos(p+1), enc.ff[p].name)
+	for p, name := range enc.fieldFormatter.header() {
+		_ = enc.f.SetCellStr(enc.sheet(), enc.pos(p+1), name)
 	}
 }
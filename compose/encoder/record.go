@@ -1,7 +1,9 @@
 package encoder
 
 import (
+	"encoding/json"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cortezaproject/corteza-server/compose/types"
@@ -22,43 +24,127 @@ func fmtUint64(u uint64) string {
 	return strconv.FormatUint(u, 10)
 }
 
-func (enc flatWriter) Record(r *types.Record) error {
-	var out = make([]string, len(enc.ff))
+// userRef formats ID of the referenced user (owner, creator...) or its label
+func (enc fieldFormatter) userRef(ID uint64) string {
+	if enc.opt.Labels && enc.fi != nil && ID > 0 {
+		return enc.fi.UserLabel(ID)
+	}
 
-	for f, field := range enc.ff {
-		switch field.name {
-		case "recordID", "ID":
-			out[f] = fmtUint64(r.ID)
-		case "moduleID":
-			out[f] = fmtUint64(r.ModuleID)
-		case "namespaceID":
-			out[f] = fmtUint64(r.NamespaceID)
-		case "ownedBy":
-			out[f] = fmtUint64(r.OwnedBy)
-		case "createdBy":
-			out[f] = fmtUint64(r.CreatedBy)
-		case "createdAt":
-			out[f] = fmtTime(&r.CreatedAt)
-		case "updatedBy":
-			out[f] = fmtUint64(r.UpdatedBy)
-		case "updatedAt":
-			out[f] = fmtTime(r.UpdatedAt)
-		case "deletedBy":
-			out[f] = fmtUint64(r.DeletedBy)
-		case "deletedAt":
-			out[f] = fmtTime(r.DeletedAt)
-		default:
-			vv := r.Values.FilterByName(field.name)
-			// @todo support for field.encodeAllMulti
+	return fmtUint64(ID)
+}
+
+// values returns values of the record field; references are replaced with
+// labels when encoder is configured to do so
+func (enc fieldFormatter) values(r *types.Record, f field) []string {
+	var (
+		vv  = r.Values.FilterByName(f.name)
+		out = make([]string, len(vv))
+	)
+
+	for i, v := range vv {
+		out[i] = v.Value
+
+		if !enc.opt.Labels || enc.fi == nil {
+			continue
+		}
+
+		switch f.kind {
+		case "Record":
+			if ID, err := strconv.ParseUint(v.Value, 10, 64); err == nil && ID > 0 {
+				out[i] = enc.fi.RecordLabel(enc.fi.Field(f.name), ID)
+			}
+		case "User":
+			if ID, err := strconv.ParseUint(v.Value, 10, 64); err == nil && ID > 0 {
+				out[i] = enc.fi.UserLabel(ID)
+			}
+		}
+	}
+
+	return out
+}
+
+// system returns value of the system field and true, or false for other fields
+func (enc fieldFormatter) system(r *types.Record, f field) (string, bool) {
+	switch f.name {
+	case "recordID", "ID":
+		return fmtUint64(r.ID), true
+	case "moduleID":
+		return fmtUint64(r.ModuleID), true
+	case "namespaceID":
+		return fmtUint64(r.NamespaceID), true
+	case "ownedBy":
+		return enc.userRef(r.OwnedBy), true
+	case "createdBy":
+		return enc.userRef(r.CreatedBy), true
+	case "createdAt":
+		return fmtTime(&r.CreatedAt), true
+	case "updatedBy":
+		return enc.userRef(r.UpdatedBy), true
+	case "updatedAt":
+		return fmtTime(r.UpdatedAt), true
+	case "deletedBy":
+		return enc.userRef(r.DeletedBy), true
+	case "deletedAt":
+		return fmtTime(r.DeletedAt), true
+	}
+
+	return "", false
+}
+
+// cells returns values of all columns of the flat (csv, xlsx) record
+//
+// Multi-value fields are encoded as configured (joined, repeated columns, JSON array);
+// fields that are not known to be multi-value are encoded the same way when they have more than one value
+func (enc fieldFormatter) cells(r *types.Record) []string {
+	var out = make([]string, 0, len(enc.ff))
+
+	for _, f := range enc.ff {
+		if v, ok := enc.system(r, f); ok {
+			out = append(out, v)
+			continue
+		}
+
+		var (
+			vv    = enc.values(r, f)
+			multi = f.multi || len(vv) > 1
+		)
+
+		switch {
+		case f.columns > 1:
+			for c := 0; c < int(f.columns); c++ {
+				if c < len(vv) {
+					out = append(out, vv[c])
+				} else {
+					out = append(out, "")
+				}
+			}
+
+		case multi && enc.opt.MultiValue == MultiValueJSON:
+			b, _ := json.Marshal(vv)
+			out = append(out, string(b))
+
+		case multi && enc.opt.MultiValue == MultiValueColumns:
+			// One column only, not more than one value can be encoded
 			if len(vv) > 0 {
-				out[f] = vv[0].Value
+				out = append(out, vv[0])
+			} else {
+				out = append(out, "")
 			}
+
+		default:
+			out = append(out, strings.Join(vv, enc.opt.Delimiter))
 		}
 	}
 
+	return out
+}
+
+func (enc *flatWriter) Record(r *types.Record) error {
+	enc.writeHeader()
+
 	defer enc.w.Flush()
 
-	return enc.w.Write(out)
+	return enc.w.Write(enc.cells(r))
 }
 
 func (enc structuredEncoder) Record(r *types.Record) error {
@@ -66,10 +152,18 @@ func (enc structuredEncoder) Record(r *types.Record) error {
 		// Exporter can choose fields so we need this buffer
 		// to hold just what we need
 		out = make(map[string]interface{})
-		vv  types.RecordValueSet
-		c   int
+		vv  []string
 	)
 
+	// User references are numbers unless they are replaced with labels
+	var userRef = func(ID uint64) interface{} {
+		if enc.opt.Labels && enc.fi != nil {
+			return enc.userRef(ID)
+		}
+
+		return ID
+	}
+
 	for _, f := range enc.ff {
 		switch f.name {
 		case "recordID", "ID":
@@ -79,13 +173,13 @@ func (enc structuredEncoder) Record(r *types.Record) error {
 		case "namespaceID":
 			out[f.name] = r.NamespaceID
 		case "ownedBy":
-			out[f.name] = r.OwnedBy
+			out[f.name] = userRef(r.OwnedBy)
 		case "createdBy":
-			out[f.name] = r.CreatedBy
+			out[f.name] = userRef(r.CreatedBy)
 		case "createdAt":
 			out[f.name] = fmtTime(&r.CreatedAt)
 		case "updatedBy":
-			out[f.name] = r.UpdatedBy
+			out[f.name] = userRef(r.UpdatedBy)
 		case "updatedAt":
 			if r.UpdatedAt == nil {
 				out[f.name] = nil
@@ -94,7 +188,7 @@ func (enc structuredEncoder) Record(r *types.Record) error {
 			}
 
 		case "deletedBy":
-			out[f.name] = r.DeletedBy
+			out[f.name] = userRef(r.DeletedBy)
 		case "deletedAt":
 			if r.DeletedAt == nil {
 				out[f.name] = nil
@@ -103,23 +197,13 @@ func (enc structuredEncoder) Record(r *types.Record) error {
 			}
 
 		default:
-			vv = r.Values.FilterByName(f.name)
-			c = len(vv)
+			vv = enc.values(r, f)
 
-			if c == 0 {
-				break
-			}
-
-			if c == 1 {
-				out[f.name] = vv[0].Value
-			} else {
-				multi := make([]string, c)
-
-				for n := range vv {
-					multi[n] = vv[n].Value
-				}
-
-				out[f.name] = multi
+			switch {
+			case f.multi || len(vv) > 1:
+				out[f.name] = vv
+			case len(vv) == 1:
+				out[f.name] = vv[0]
 			}
 		}
 	}
@@ -128,36 +212,12 @@ func (enc structuredEncoder) Record(r *types.Record) error {
 }
 
 func (enc *excelizeEncoder) Record(r *types.Record) error {
+	enc.writeHeader()
 	enc.row++
 
-	for p, f := range enc.ff {
-		p++
-		switch f.name {
-		case "recordID", "ID":
-			_ = enc.f.SetCellStr(enc.sheet(), enc.pos(p), fmtUint64(r.ID))
-		case "moduleID":
-			_ = enc.f.SetCellStr(enc.sheet(), enc.pos(p), fmtUint64(r.ModuleID))
-		case "namespaceID":
-			_ = enc.f.SetCellStr(enc.sheet(), enc.pos(p), fmtUint64(r.NamespaceID))
-		case "ownedBy":
-			_ = enc.f.SetCellStr(enc.sheet(), enc.pos(p), fmtUint64(r.OwnedBy))
-		case "createdBy":
-			_ = enc.f.SetCellStr(enc.sheet(), enc.pos(p), fmtUint64(r.CreatedBy))
-		case "createdAt":
-			_ = enc.f.SetCellStr(enc.sheet(), enc.pos(p), fmtTime(&r.CreatedAt))
-		case "updatedBy":
-			_ = enc.f.SetCellStr(enc.sheet(), enc.pos(p), fmtUint64(r.UpdatedBy))
-		case "updatedAt":
-			_ = enc.f.SetCellStr(enc.sheet(), enc.pos(p), fmtTime(r.UpdatedAt))
-		case "deletedBy":
-			_ = enc.f.SetCellStr(enc.sheet(), enc.pos(p), fmtUint64(r.DeletedBy))
-		case "deletedAt":
-			_ = enc.f.SetCellStr(enc.sheet(), enc.pos(p), fmtTime(r.DeletedAt))
-		default:
-			vv := r.Values.FilterByName(f.name)
-			if len(vv) > 0 {
-				_ = enc.f.SetCellStr(enc.sheet(), enc.pos(p), vv[0].Value)
-			}
+	for p, v := range enc.cells(r) {
+		if v != "" {
+			_ = enc.f.SetCellStr(enc.sheet(), enc.pos(p+1), v)
 		}
 	}
 
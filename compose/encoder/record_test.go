@@ -54,7 +54,7 @@ func Test_RecordEncoding(t *testing.T) {
 
 			flatResult: `recordID,ownedBy,createdAt,deletedAt,some-foo-field,foo,fff` + "\n" +
 				`12345,12345,2017-09-09T17:00:00Z,,,,` + "\n" +
-				`54321,12345,1970-01-01T03:25:45Z,,,bar,1` + "\n",
+				`54321,12345,1970-01-01T03:25:45Z,,,bar,"1, 2"` + "\n",
 
 			structResult: `{"createdAt":"2017-09-09T17:00:00Z","deletedAt":null,"ownedBy":12345,"recordID":12345}` + "\n" +
 				`{"createdAt":"1970-01-01T03:25:45Z","deletedAt":null,"fff":["1","2"],"foo":"bar","ownedBy":12345,"recordID":54321}` + "\n",
@@ -67,14 +67,14 @@ func Test_RecordEncoding(t *testing.T) {
 			buf := bytes.NewBuffer([]byte{})
 			csvWriter := csv.NewWriter(buf)
 
-			fenc := NewFlatWriter(csvWriter, true, tt.ff...)
+			fenc := NewFlatWriter(csvWriter, true, Options{}, tt.ff...)
 			for _, r := range tt.rr {
 				if err := fenc.Record(r); err != nil {
 					t.Errorf("unexpected error = %v,", err)
 				}
 			}
 
-			csvWriter.Flush()
+			fenc.Flush()
 			require.True(t,
 				buf.String() == tt.flatResult,
 				"Unexpected result: \n%s\n%s",
@@ -86,7 +86,7 @@ func Test_RecordEncoding(t *testing.T) {
 			buf := bytes.NewBuffer([]byte{})
 			jsonEnc := json.NewEncoder(buf)
 
-			senc := NewStructuredEncoder(jsonEnc, tt.ff...)
+			senc := NewStructuredEncoder(jsonEnc, Options{}, tt.ff...)
 			for _, r := range tt.rr {
 				if err := senc.Record(r); err != nil {
 					t.Errorf("unexpected error = %v,", err)
@@ -101,3 +101,124 @@ func Test_RecordEncoding(t *testing.T) {
 		})
 	}
 }
+
+type (
+	testFieldInfo struct {
+		ff types.ModuleFieldSet
+	}
+)
+
+func (fi testFieldInfo) Field(name string) *types.ModuleField {
+	return fi.ff.FindByName(name)
+}
+
+func (fi testFieldInfo) MaxValueCount(name string) (uint, error) {
+	return 3, nil
+}
+
+func (fi testFieldInfo) RecordLabel(f *types.ModuleField, recordID uint64) string {
+	return f.Name + " #" + fmtUint64(recordID)
+}
+
+func (fi testFieldInfo) UserLabel(userID uint64) string {
+	return "user #" + fmtUint64(userID)
+}
+
+func Test_RecordEncodingMultiValue(t *testing.T) {
+	var (
+		fi = testFieldInfo{ff: types.ModuleFieldSet{
+			&types.ModuleField{Name: "tags", Multi: true},
+			&types.ModuleField{Name: "account", Kind: "Record"},
+			&types.ModuleField{Name: "manager", Kind: "User"},
+		}}
+
+		r = &types.Record{
+			ID:      1,
+			OwnedBy: 2,
+			Values: types.RecordValueSet{
+				{Name: "tags", Value: "a"},
+				{Name: "tags", Value: "b"},
+				{Name: "account", Value: "3"},
+				{Name: "manager", Value: "4"},
+			},
+		}
+
+		ff = []string{"recordID", "ownedBy", "tags", "account", "manager"}
+	)
+
+	tests := []struct {
+		name string
+		opt  Options
+		flat string
+		json string
+	}{
+		{
+			name: "joined",
+			opt:  Options{Delimiter: "|"},
+			flat: "recordID,ownedBy,tags,account,manager\n1,2,a|b,3,4\n",
+			json: `{"account":"3","manager":"4","ownedBy":2,"recordID":1,"tags":["a","b"]}` + "\n",
+		},
+		{
+			name: "columns",
+			opt:  Options{MultiValue: MultiValueColumns},
+			flat: "recordID,ownedBy,tags[1],tags[2],tags[3],account,manager\n1,2,a,b,,3,4\n",
+		},
+		{
+			name: "json",
+			opt:  Options{MultiValue: MultiValueJSON},
+			flat: "recordID,ownedBy,tags,account,manager\n1,2,\"[\"\"a\"\",\"\"b\"\"]\",3,4\n",
+		},
+		{
+			name: "labels",
+			opt:  Options{Labels: true},
+			flat: "recordID,ownedBy,tags,account,manager\n1,user #2,\"a, b\",account #3,user #4\n",
+			json: `{"account":"account #3","manager":"user #4","ownedBy":"user #2","recordID":1,"tags":["a","b"]}` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+" (csv)", func(t *testing.T) {
+			var (
+				req  = require.New(t)
+				buf  = &bytes.Buffer{}
+				fenc = NewFlatWriter(csv.NewWriter(buf), true, tt.opt, MakeFields(ff...)...)
+			)
+
+			req.NoError(fenc.Prepare(fi))
+			req.NoError(fenc.Record(r))
+			fenc.Flush()
+			req.Equal(tt.flat, buf.String())
+		})
+
+		if tt.json == "" {
+			continue
+		}
+
+		t.Run(tt.name+" (json)", func(t *testing.T) {
+			var (
+				req  = require.New(t)
+				buf  = &bytes.Buffer{}
+				senc = NewStructuredEncoder(json.NewEncoder(buf), tt.opt, MakeFields(ff...)...)
+			)
+
+			req.NoError(senc.Prepare(fi))
+			req.NoError(senc.Record(r))
+			req.Equal(tt.json, buf.String())
+		})
+	}
+}
+
+func TestParseMultiValue(t *testing.T) {
+	var req = require.New(t)
+
+	mv, ok := ParseMultiValue("")
+	req.True(ok)
+	req.Equal(MultiValueJoin, mv)
+
+	mv, ok = ParseMultiValue("Columns")
+	req.True(ok)
+	req.Equal(MultiValueColumns, mv)
+
+	_, ok = ParseMultiValue("nope")
+	req.False(ok)
+}
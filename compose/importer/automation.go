@@ -165,6 +165,8 @@ func (asImp *AutomationScript) castTriggers(handle string, script *automation.Sc
 				t.Resource = deinterfacer.ToString(val)
 			case "condition":
 				t.Condition = deinterfacer.ToString(val)
+			case "expression":
+				t.Expression = deinterfacer.ToString(val)
 			case "module":
 				module := deinterfacer.ToString(val)
 				asImp.modRefs = append(asImp.modRefs, automationTriggerModuleRef{handle, len(tt), module})
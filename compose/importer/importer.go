@@ -47,6 +47,7 @@ type (
 	recordKeeper interface {
 		Update(*types.Record) (*types.Record, error)
 		Create(*types.Record) (*types.Record, error)
+		Upsert(*types.Record, ...string) (*types.Record, error)
 	}
 
 	automationScriptKeeper interface {
@@ -16,6 +16,10 @@ type (
 		namespace *types.Namespace
 		set       map[string]types.RecordSet
 		dirty     map[uint64]bool
+
+		// fields that identify existing records (see RecordService.Upsert)
+		upsert map[*types.Record][]string
+
 		// modRefs   []recordModuleRef
 	}
 
@@ -37,6 +41,7 @@ func NewRecordImporter(imp *Importer, ns *types.Namespace) *Record {
 		namespace: ns,
 		set:       make(map[string]types.RecordSet),
 		dirty:     make(map[uint64]bool),
+		upsert:    make(map[*types.Record][]string),
 	}
 
 	return out
@@ -97,6 +102,20 @@ func (rImp *Record) Cast(moduleHandle string, def interface{}) (err error) {
 			case "values":
 				record.Values, err = rImp.castValues(module, val)
 
+			case "upsert":
+				// One or more fields used for matching existing record
+				if deinterfacer.IsSlice(val) {
+					rImp.upsert[record] = deinterfacer.ToStrings(val)
+				} else {
+					rImp.upsert[record] = []string{deinterfacer.ToString(val)}
+				}
+
+				for _, name := range rImp.upsert[record] {
+					if module.Fields.FindByName(name) == nil {
+						return fmt.Errorf("unknown upsert field %q for record on module %q", name, module.Handle)
+					}
+				}
+
 			default:
 				return fmt.Errorf("unexpected key %q for record on module %q", key, module.Handle)
 			}
@@ -145,7 +164,9 @@ func (rImp *Record) Store(ctx context.Context, k recordKeeper) (err error) {
 			record.NamespaceID = rImp.namespace.ID
 			record.ModuleID = module.ID
 
-			if record.ID == 0 {
+			if keys, has := rImp.upsert[record]; has && record.ID == 0 {
+				record, err = k.Upsert(record, keys...)
+			} else if record.ID == 0 {
 				record, err = k.Create(record)
 			} else if rImp.dirty[record.ID] {
 				record, err = k.Update(record)
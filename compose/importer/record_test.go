@@ -48,3 +48,27 @@ func TestRecordImport(t *testing.T) {
 
 	})
 }
+
+func TestRecordImportUpsert(t *testing.T) {
+	imp.namespaces.Setup(ns)
+	imp.GetModuleImporter(ns.Slug).set = types.ModuleSet{
+		{
+			NamespaceID: ns.ID,
+			Handle:      "TestModule",
+			Fields: types.ModuleFieldSet{
+				{Name: "field1"},
+				{Name: "field2"},
+			},
+		},
+	}
+
+	impFixTester(t, "records_upsert", func(t *testing.T, record *Record) {
+		req := require.New(t)
+
+		req.Len(record.set["TestModule"], 3)
+
+		req.Equal([]string{"field1"}, record.upsert[record.set["TestModule"][0]])
+		req.Equal([]string{"field1", "field2"}, record.upsert[record.set["TestModule"][1]])
+		req.NotContains(record.upsert, record.set["TestModule"][2])
+	})
+}
@@ -163,7 +163,7 @@ func (r attachment) Create(mod *types.Attachment) (*types.Attachment, error) {
 
 	mod.CreatedAt = time.Now()
 
-	return mod, r.db().Insert(r.table(), mod)
+	return mod, rh.Insert(r.db(), r.table(), mod)
 }
 
 func (r attachment) DeleteByID(namespaceID, attachmentID uint64) error {
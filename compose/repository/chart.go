@@ -141,7 +141,7 @@ func (r chart) Create(mod *types.Chart) (*types.Chart, error) {
 	rh.SetCurrentTimeRounded(&mod.CreatedAt)
 	mod.UpdatedAt = nil
 
-	return mod, r.db().Insert(r.table(), mod)
+	return mod, rh.Insert(r.db(), r.table(), mod)
 }
 
 func (r chart) Update(mod *types.Chart) (*types.Chart, error) {
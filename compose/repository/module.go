@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
@@ -25,8 +26,10 @@ type (
 		FindFields(moduleIDs ...uint64) (ff types.ModuleFieldSet, err error)
 		Create(mod *types.Module) (*types.Module, error)
 		Update(mod *types.Module) (*types.Module, error)
-		UpdateFields(moduleID uint64, ff types.ModuleFieldSet, hasRecords bool) (err error)
+		UpdateFields(moduleID uint64, ff types.ModuleFieldSet) (err error)
 		DeleteByID(namespaceID, moduleID uint64) error
+		LockByID(moduleID uint64) error
+		Version(namespaceID uint64) (string, error)
 	}
 
 	module struct {
@@ -164,7 +167,7 @@ func (r module) Create(mod *types.Module) (*types.Module, error) {
 	rh.SetCurrentTimeRounded(&mod.CreatedAt)
 	mod.UpdatedAt = nil
 
-	if err = r.db().Insert(r.table(), mod); err != nil {
+	if err = rh.Insert(r.db(), r.table(), mod); err != nil {
 		return nil, err
 	}
 
@@ -177,7 +180,11 @@ func (r module) Update(mod *types.Module) (*types.Module, error) {
 	return mod, r.db().Update(r.table(), mod, "id")
 }
 
-func (r module) UpdateFields(moduleID uint64, ff types.ModuleFieldSet, hasRecords bool) error {
+// UpdateFields replaces module fields with the given set
+//
+// Renamed fields and fields with a changed kind need their values
+// migrated (see service's module field migration)
+func (r module) UpdateFields(moduleID uint64, ff types.ModuleFieldSet) error {
 	if existing, err := r.FindFields(moduleID); err != nil {
 		return err
 	} else {
@@ -197,16 +204,7 @@ func (r module) UpdateFields(moduleID uint64, ff types.ModuleFieldSet, hasRecord
 		for idx, f := range ff {
 			if e := existing.FindByID(f.ID); e != nil {
 				f.CreatedAt = e.CreatedAt
-
-				// We do not have any other code in place that would handle changes of field name and kind, so we need
-				// to reset any changes made to the field.
-				// @todo remove when we are able to handle field rename & type change
-				if hasRecords {
-					f.Name = e.Name
-					f.Kind = e.Kind
-				} else {
-					rh.SetCurrentTimeRounded(&f.UpdatedAt)
-				}
+				rh.SetCurrentTimeRounded(&f.UpdatedAt)
 			} else {
 				f.ID = 0
 			}
@@ -220,7 +218,7 @@ func (r module) UpdateFields(moduleID uint64, ff types.ModuleFieldSet, hasRecord
 			f.Place = idx
 			f.DeletedAt = nil
 
-			if err := r.db().Replace(r.tableFields(), f); err != nil {
+			if err := rh.Upsert(r.db(), r.tableFields(), f, "id"); err != nil {
 				return errors.Wrap(err, "Error updating module fields")
 			}
 
@@ -250,6 +248,50 @@ func (r module) DeleteByID(namespaceID, moduleID uint64) error {
 	return err
 }
 
+// LockByID locks module row until the end of the current transaction
+//
+// Used to serialize operations on module's records (see record upsert)
+func (r module) LockByID(moduleID uint64) error {
+	var id uint64
+
+	return r.db().Get(&id, fmt.Sprintf("SELECT id FROM %s WHERE id = ? FOR UPDATE", r.table()), moduleID)
+}
+
+// Version returns a stamp that changes whenever a module of the namespace is created, updated or deleted
+//
+// Used to tell if data derived from modules (rollup dependencies) is still valid
+func (r module) Version(namespaceID uint64) (string, error) {
+	var (
+		v struct {
+			Count     uint       `db:"count"`
+			CreatedAt *time.Time `db:"created_at"`
+			UpdatedAt *time.Time `db:"updated_at"`
+			DeletedAt *time.Time `db:"deleted_at"`
+		}
+
+		q = squirrel.
+			Select("COUNT(*) AS count", "MAX(created_at) AS created_at", "MAX(updated_at) AS updated_at", "MAX(deleted_at) AS deleted_at").
+			From(r.table()).
+			Where(squirrel.Eq{"rel_namespace": namespaceID})
+	)
+
+	if sql, args, err := q.ToSql(); err != nil {
+		return "", err
+	} else if err = r.db().Get(&v, sql, args...); err != nil {
+		return "", errors.Wrap(err, "could not load module version")
+	}
+
+	var stamp = func(t *time.Time) int64 {
+		if t == nil {
+			return 0
+		}
+
+		return t.UnixNano()
+	}
+
+	return fmt.Sprintf("%d-%d-%d-%d", v.Count, stamp(v.CreatedAt), stamp(v.UpdatedAt), stamp(v.DeletedAt)), nil
+}
+
 func (r module) FindFields(moduleIDs ...uint64) (ff types.ModuleFieldSet, err error) {
 	if len(moduleIDs) == 0 {
 		return
@@ -139,7 +139,7 @@ func (r *namespace) Create(mod *types.Namespace) (*types.Namespace, error) {
 	rh.SetCurrentTimeRounded(&mod.CreatedAt)
 	mod.UpdatedAt = nil
 
-	return mod, r.db().Insert(r.table(), mod)
+	return mod, rh.Insert(r.db(), r.table(), mod)
 }
 
 func (r *namespace) Update(mod *types.Namespace) (*types.Namespace, error) {
@@ -197,7 +197,7 @@ func (r page) Create(mod *types.Page) (*types.Page, error) {
 	rh.SetCurrentTimeRounded(&mod.CreatedAt)
 	mod.UpdatedAt = nil
 
-	return mod, r.db().Insert(r.table(), mod)
+	return mod, rh.Insert(r.db(), r.table(), mod)
 }
 
 func (r page) Update(mod *types.Page) (*types.Page, error) {
@@ -3,14 +3,20 @@ package repository
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
+	"github.com/lann/builder"
 	"github.com/pkg/errors"
 	"github.com/titpetric/factory"
 
 	"github.com/cortezaproject/corteza-server/compose/types"
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
+	"github.com/cortezaproject/corteza-server/pkg/permissions"
 	"github.com/cortezaproject/corteza-server/pkg/ql"
 	"github.com/cortezaproject/corteza-server/pkg/rh"
 )
@@ -20,28 +26,60 @@ type (
 		With(ctx context.Context, db *factory.DB) RecordRepository
 
 		FindByID(namespaceID, recordID uint64) (*types.Record, error)
+		FindDeletedByID(namespaceID, recordID uint64) (*types.Record, error)
 
-		Report(module *types.Module, metrics, dimensions, filter string) (results interface{}, err error)
+		Report(module *types.Module, metrics, dimensions, filter string, isReadable *permissions.ResourceFilter, isRefReadable map[string]*permissions.ResourceFilter) (report *types.RecordReport, err error)
 		Find(module *types.Module, filter types.RecordFilter) (set types.RecordSet, f types.RecordFilter, err error)
-		Export(module *types.Module, filter types.RecordFilter) (set types.RecordSet, err error)
+		Export(module *types.Module, filter types.RecordFilter, fn func(types.RecordSet) error) error
+		MaxValueCount(module *types.Module, filter types.RecordFilter, fieldName string) (uint, error)
+		UserIDs(module *types.Module, ref string) ([]uint64, error)
+		FindReadableIDs(moduleID uint64, IDs []uint64, isReadable *permissions.ResourceFilter) ([]uint64, error)
+		LockByIDs(IDs ...uint64) error
 
 		Create(record *types.Record) (*types.Record, error)
 		Update(record *types.Record) (*types.Record, error)
 		Delete(record *types.Record) error
+		Undelete(record *types.Record) error
+		Purge(deletedBefore time.Time) (uint64, error)
 
 		LoadValues(fieldNames []string, IDs []uint64) (rvs types.RecordValueSet, err error)
+		LoadFieldValues(moduleID uint64, fieldName string) (rvs types.RecordValueSet, err error)
+		FindRefs(moduleID uint64, fieldName string, refID uint64, isReadable *permissions.ResourceFilter) ([]uint64, error)
+		DeleteRefValues(fieldName string, refID uint64, recordIDs ...uint64) error
+		RenameValues(moduleID uint64, from, to string) error
 		DeleteValues(record *types.Record) error
+		UndeleteValues(record *types.Record) error
 		UpdateValues(recordID uint64, rvs types.RecordValueSet) (err error)
 		PartialUpdateValues(rvs ...*types.RecordValue) (err error)
+
+		FindDuplicate(moduleID, recordID uint64, value *types.RecordValue, uc types.ModuleFieldUniqueConstraint, scope string) (uint64, error)
+		FindByKeys(moduleID uint64, keys types.RecordValueSet) ([]uint64, error)
+
+		UpdateIndex(module *types.Module) error
 	}
 
 	record struct {
 		*repository
 	}
+
+	// moduleFieldFinder loads fields of referenced modules when building record queries
+	moduleFieldFinder func(moduleIDs ...uint64) (types.ModuleFieldSet, error)
 )
 
 const (
 	ErrRecordNotFound = repositoryError("RecordNotFound")
+
+	// Number of records fetched at once when exporting
+	recordExportChunkSize = 500
+
+	// Users table of the system service, see IsSystemUserJoinable
+	systemUserTable = "sys_user"
+)
+
+var (
+	systemUserJoinable = func() bool {
+		return dbx.SameDatabase("compose", "system")
+	}
 )
 
 func Record(ctx context.Context, db *factory.DB) RecordRepository {
@@ -73,24 +111,27 @@ func (r record) columns() []string {
 	}
 }
 
+// query returns select builder for records without deleted_at condition
 func (r record) query() squirrel.SelectBuilder {
 	return squirrel.
 		Select(r.columns()...).
-		From(r.table() + " AS r").
-		Where("r.deleted_at IS NULL")
+		From(r.table() + " AS r")
 }
 
-// @todo: update to accepted DeletedAt column semantics from Messaging
-
 func (r record) FindByID(namespaceID, recordID uint64) (*types.Record, error) {
-	return r.findOneBy(namespaceID, "id", recordID)
+	return r.findOneBy(namespaceID, "id", recordID, rh.FilterStateExcluded)
+}
+
+// FindDeletedByID returns record only when it is (soft) deleted
+func (r record) FindDeletedByID(namespaceID, recordID uint64) (*types.Record, error) {
+	return r.findOneBy(namespaceID, "id", recordID, rh.FilterStateExclusive)
 }
 
-func (r record) findOneBy(namespaceID uint64, field string, value interface{}) (*types.Record, error) {
+func (r record) findOneBy(namespaceID uint64, field string, value interface{}, deleted rh.FilterState) (*types.Record, error) {
 	var (
 		rec = &types.Record{}
 
-		q = r.query().
+		q = rh.FilterNullByState(r.query(), "r.deleted_at", deleted).
 			Where(squirrel.Eq{field: value, "rel_namespace": namespaceID})
 
 		err = rh.FetchOne(r.db(), q, rec)
@@ -105,8 +146,22 @@ func (r record) findOneBy(namespaceID uint64, field string, value interface{}) (
 	return rec, nil
 }
 
-func (r record) Report(module *types.Module, metrics, dimensions, filter string) (results interface{}, err error) {
+// Report aggregates records of the module
+//
+// When isReadable is set, only records that pass record-level permission check are included
+func (r record) Report(module *types.Module, metrics, dimensions, filter string, isReadable *permissions.ResourceFilter, isRefReadable map[string]*permissions.ResourceFilter) (report *types.RecordReport, err error) {
 	crb := NewRecordReportBuilder(module)
+	crb.dialect = r.dialect()
+	crb.refFields = Module(r.ctx, r.db()).FindFields
+	crb.refReadable = isRefReadable
+
+	if crb.index, err = r.currentIndex(module); err != nil {
+		return
+	}
+
+	if isReadable != nil {
+		crb.report = crb.report.Where(isReadable)
+	}
 
 	var result = make([]map[string]interface{}, 0)
 
@@ -119,47 +174,206 @@ func (r record) Report(module *types.Module, metrics, dimensions, filter string)
 			result = append(result, crb.Cast(rows))
 		}
 
-		return result, nil
+		return crb.Report(result), nil
 	}
 }
 
+// Find returns page of records that match the filter
+//
+// Besides page number, cursor paging is supported (see rh.PagingCursor);
+// cursors to the next and to the previous page are returned with the filter
 func (r record) Find(module *types.Module, filter types.RecordFilter) (set types.RecordSet, f types.RecordFilter, err error) {
-	var query squirrel.SelectBuilder
+	var (
+		query squirrel.SelectBuilder
+		ks    rh.Keyset
+
+		hasPrev, hasNext bool
+	)
+
 	f = filter
+	f.PrevPage, f.NextPage = nil, nil
+
+	ri, err := r.currentIndex(module)
+	if err != nil {
+		return
+	}
 
-	query, err = r.buildQuery(module, filter)
+	query, ks, err = r.buildQuery(module, filter, Module(r.ctx, r.db()).FindFields, ri)
 	if err != nil {
 		return
 	}
 
-	if f.Count, err = rh.Count(r.db(), query); err != nil || f.Count == 0 {
+	if !f.SkipCount {
+		if f.Count, err = rh.Count(r.db(), query); err != nil || f.Count == 0 {
+			return
+		}
+	}
+
+	if hasPrev, hasNext, err = rh.FetchCursorPaged(r.db(), query, ks, f.PageFilter, &set); err != nil || len(set) == 0 {
 		return
 	}
 
-	return set, f, rh.FetchPaged(r.db(), query, f.Page, f.PerPage, &set)
+	if hasPrev {
+		if f.PrevPage, err = rh.KeysetCursor(r.db(), query, ks, set[0].ID, true); err != nil {
+			return
+		}
+	}
+
+	if hasNext {
+		if f.NextPage, err = rh.KeysetCursor(r.db(), query, ks, set[len(set)-1].ID, false); err != nil {
+			return
+		}
+	}
+
+	return
 }
 
-// Export ignores paging and does not return filter
+// Export calls fn with chunks of records (without values) that match the filter
 //
-// @todo optimize and include value loading
-func (r record) Export(module *types.Module, filter types.RecordFilter) (set types.RecordSet, err error) {
-	filter.PerPage = 0
-	filter.Page = 0
+// Records are fetched with keyset paging, one chunk at a time, so that the
+// whole set is never held in memory; paging of the filter is ignored
+func (r record) Export(module *types.Module, filter types.RecordFilter, fn func(types.RecordSet) error) error {
+	ri, err := r.currentIndex(module)
+	if err != nil {
+		return err
+	}
+
+	query, ks, err := r.buildQuery(module, filter, Module(r.ctx, r.db()).FindFields, ri)
+	if err != nil {
+		return err
+	}
+
+	var (
+		pf      = rh.PageFilter{PerPage: recordExportChunkSize}
+		hasNext bool
+	)
+
+	for {
+		var set types.RecordSet
+
+		if _, hasNext, err = rh.FetchCursorPaged(r.db(), query, ks, pf, &set); err != nil {
+			return err
+		}
+
+		if len(set) > 0 {
+			if err = fn(set); err != nil {
+				return err
+			}
+		}
+
+		if !hasNext {
+			return nil
+		}
+
+		if pf.PageCursor, err = rh.KeysetCursor(r.db(), query, ks, set[len(set)-1].ID, false); err != nil {
+			return err
+		}
+	}
+}
+
+// MaxValueCount returns the highest number of values of the (multi-value) field
+// that one of the records matching the filter has
+func (r record) MaxValueCount(module *types.Module, filter types.RecordFilter, fieldName string) (c uint, err error) {
+	ri, err := r.currentIndex(module)
+	if err != nil {
+		return
+	}
+
+	query, _, err := r.buildQuery(module, filter, Module(r.ctx, r.db()).FindFields, ri)
+	if err != nil {
+		return
+	}
+
+	q, err := r.maxValueCountQuery(query, fieldName)
+	if err != nil {
+		return
+	}
 
-	query, err := r.buildQuery(module, filter)
+	return c, rh.FetchOne(r.db(), q, &c)
+}
+
+func (r record) maxValueCountQuery(query squirrel.SelectBuilder, fieldName string) (squirrel.SelectBuilder, error) {
+	sql, args, err := builder.Delete(query, "Columns").(squirrel.SelectBuilder).Column("r.id").ToSql()
 	if err != nil {
+		return query, err
+	}
+
+	counts := squirrel.
+		Select("COUNT(*) AS c").
+		From("compose_record_value AS rv").
+		Where("rv.deleted_at IS NULL").
+		Where(squirrel.Eq{"rv.name": fieldName}).
+		Where("rv.record_id IN ("+sql+")", args...).
+		GroupBy("rv.record_id")
+
+	return squirrel.
+		Select("COALESCE(MAX(c.c), 0)").
+		FromSelect(counts, "c"), nil
+}
+
+// LockByIDs locks record rows until the end of the current transaction
+//
+// Rows are locked in the order of their IDs so that concurrent writers do not deadlock
+func (r record) LockByIDs(IDs ...uint64) error {
+	if len(IDs) == 0 {
+		return nil
+	}
+
+	var (
+		locked []uint64
+
+		q = squirrel.
+			Select("id").
+			From(r.table()).
+			Where(squirrel.Eq{"id": IDs}).
+			OrderBy("id").
+			Suffix("FOR UPDATE")
+	)
+
+	return errors.Wrap(rh.FetchAll(r.db(), q, &locked), "could not lock records")
+}
+
+// FindReadableIDs returns IDs of (undeleted) records of the module from the given set
+// that pass the permission check filter
+func (r record) FindReadableIDs(moduleID uint64, IDs []uint64, isReadable *permissions.ResourceFilter) (ids []uint64, err error) {
+	if len(IDs) == 0 {
 		return
 	}
 
-	return set, rh.FetchAll(r.db(), query, &set)
+	return ids, rh.FetchAll(r.db(), r.readableIDsQuery(moduleID, IDs, isReadable), &ids)
 }
 
-func (r record) buildQuery(module *types.Module, f types.RecordFilter) (query squirrel.SelectBuilder, err error) {
+func (r record) readableIDsQuery(moduleID uint64, IDs []uint64, isReadable *permissions.ResourceFilter) squirrel.SelectBuilder {
+	var q = squirrel.
+		Select("r.id").
+		From(r.table() + " AS r").
+		Where(squirrel.Eq{"r.module_id": moduleID, "r.id": IDs}).
+		Where("r.deleted_at IS NULL")
+
+	if isReadable != nil {
+		q = q.Where(isReadable)
+	}
+
+	return q
+}
+
+// buildQuery creates query for fetching and counting records
+//
+// Filter and sort can use paths to fields of referenced records (account.name)
+// or to referenced users (ownedBy.email); ff is used to load fields of referenced modules.
+// Indexed fields with a current index column (ri, see currentIndex) are read from the record index table
+//
+// Query is not ordered, sorting is returned as keyset (always ending with record ID)
+func (r record) buildQuery(module *types.Module, f types.RecordFilter, ff moduleFieldFinder, ri recordIndexColumnSet) (query squirrel.SelectBuilder, ks rh.Keyset, err error) {
 	// Create query for fetching and counting records.
-	query = r.query().
+	query = rh.FilterNullByState(r.query(), "r.deleted_at", f.Deleted).
 		Where("r.module_id = ?", module.ID).
 		Where("r.rel_namespace = ?", module.NamespaceID)
 
+	if f.IsReadable != nil {
+		query = query.Where(f.IsReadable)
+	}
+
 	var joinedFields = []string{}
 	var alreadyJoined = func(f string) bool {
 		for _, a := range joinedFields {
@@ -172,6 +386,142 @@ func (r record) buildQuery(module *types.Module, f types.RecordFilter) (query sq
 		return false
 	}
 
+	var joinValue = func(name string) {
+		cnd := fmt.Sprintf("rv_%s.record_id = r.id AND rv_%s.name = ?", name, name)
+
+		// Values are soft-deleted together with their record so
+		// deleted values can not be ignored when deleted records are included
+		if f.Deleted == rh.FilterStateExcluded {
+			cnd += fmt.Sprintf(" AND rv_%s.deleted_at IS NULL", name)
+		}
+
+		query = query.LeftJoin(fmt.Sprintf("compose_record_value AS rv_%s ON (%s)", name, cnd), name)
+	}
+
+	// Indexed fields are read from typed columns of the record index table
+	var joinIndex = func(name string) string {
+		c := ri.FindByField(name)
+		if c == nil {
+			return ""
+		}
+
+		if !alreadyJoined(recordIndexAlias) {
+			query = query.LeftJoin(fmt.Sprintf(
+				"%s AS %s ON (%s.record_id = r.id)",
+				recordIndexTable(module.ID), recordIndexAlias, recordIndexAlias,
+			))
+		}
+
+		return recordIndexAlias + "." + c.Column
+	}
+
+	// Resolves <ref>.<name> identifier and joins referenced record values or users
+	//
+	// Returns alias of the joined value (with value & ref columns) or, for users, the column
+	// and a field that describes the kind of the value
+	var joinRef = func(path string) (alias, col string, field *types.ModuleField, err error) {
+		var (
+			pp        = strings.SplitN(path, ".", 2)
+			ref, name = pp[0], pp[1]
+			refCol    string
+			toUser    bool
+		)
+
+		if c, is := isRealRecordCol(ref); is {
+			if !isUserRecordCol(c) {
+				return "", "", nil, errors.Errorf("%q is not a reference", ref)
+			}
+
+			refCol, toUser = c, true
+		} else if rf := module.Fields.FindByName(ref); rf == nil {
+			return "", "", nil, errors.Errorf("unknown field %q", ref)
+		} else if rf.Multi {
+			return "", "", nil, errors.Errorf("can not use multi-value field %q in path %q", ref, path)
+		} else {
+			if !alreadyJoined(ref) {
+				joinValue(ref)
+			}
+
+			switch rf.Kind {
+			case "Record":
+				refCol = fmt.Sprintf("rv_%s.ref", ref)
+			case "User", "Owner":
+				refCol, toUser = r.dialect().NumericValue(fmt.Sprintf("rv_%s.value", ref)), true
+			default:
+				return "", "", nil, errors.Errorf("field %q is not a reference", ref)
+			}
+
+			if !toUser {
+				var refFields types.ModuleFieldSet
+				if refFields, err = ff(rf.RefModuleID()); err != nil {
+					return
+				}
+
+				if field = refFields.FindByName(name); field == nil {
+					return "", "", nil, errors.Errorf("unknown field %q in path %q", name, path)
+				} else if field.Multi {
+					return "", "", nil, errors.Errorf("can not use multi-value field %q in path %q", name, path)
+				}
+
+				// Values are joined only for referenced records that exist and,
+				// with IsRefReadable filter, can be read by the user
+				rr := RecordRefAlias(ref)
+				if !alreadyJoined(rr) {
+					var (
+						cnd  = fmt.Sprintf("%s.id = %s AND %s.deleted_at IS NULL", rr, refCol, rr)
+						args []interface{}
+					)
+
+					if isReadable := f.IsRefReadable[ref]; isReadable != nil {
+						var sql string
+						if sql, args, err = isReadable.ToSql(); err != nil {
+							return
+						}
+
+						cnd += " AND (" + sql + ")"
+					}
+
+					query = query.LeftJoin(fmt.Sprintf("%s AS %s ON (%s)", r.table(), rr, cnd), args...)
+				}
+
+				alias = fmt.Sprintf("rrv_%s_%s", ref, name)
+				if !alreadyJoined(path) {
+					query = query.LeftJoin(fmt.Sprintf(
+						"compose_record_value AS %s ON (%s.record_id = %s.id AND %s.name = ? AND %s.deleted_at IS NULL)",
+						alias, alias, rr, alias, alias,
+					), name)
+				}
+
+				return alias, alias + ".value", field, nil
+			}
+		}
+
+		switch name {
+		case "email", "name", "handle":
+		default:
+			return "", "", nil, errors.Errorf("unknown user field %q in path %q", name, path)
+		}
+
+		alias = fmt.Sprintf("ru_%s_%s", ref, name)
+
+		// Users are stored by the system service; they are joined when it uses the
+		// same database and resolved in advance otherwise (see RecordFilter.UserValues)
+		if IsSystemUserJoinable() {
+			if !alreadyJoined(alias) {
+				query = query.LeftJoin(fmt.Sprintf("%s AS %s ON (%s.id = %s)", systemUserTable, alias, alias, refCol))
+			}
+
+			return alias, alias + "." + name, &types.ModuleField{Kind: "String"}, nil
+		}
+
+		if !alreadyJoined(alias) {
+			src, args := userValuesSource(f.UserValues[path])
+			query = query.LeftJoin(fmt.Sprintf("(%s) AS %s ON (%s.id = %s)", src, alias, alias, refCol), args...)
+		}
+
+		return alias, alias + ".value", &types.ModuleField{Kind: "String"}, nil
+	}
+
 	// Parse filters.
 	if f.Filter != "" {
 		var (
@@ -182,26 +532,40 @@ func (r record) buildQuery(module *types.Module, f types.RecordFilter) (query sq
 			fn ql.ASTNode
 		)
 
+		fp.OnOperator = r.dialect().QlOperator
+		fp.OnFunction = r.dialect().QlFunction
+
 		// Make a nice wrapper that will translate module fields to subqueries
 		fp.OnIdent = func(i ql.Ident) (ql.Ident, error) {
-			var is bool
-			if i.Value, is = isRealRecordCol(i.Value); is {
+			var (
+				is    bool
+				err   error
+				field *types.ModuleField
+			)
+
+			if strings.Contains(i.Value, ".") {
+				if _, i.Value, field, err = joinRef(i.Value); err != nil {
+					return i, err
+				}
+			} else if i.Value, is = isRealRecordCol(i.Value); is {
 				return i, nil
-			}
-
-			if !module.Fields.HasName(i.Value) {
+			} else if field = module.Fields.FindByName(i.Value); field == nil {
 				return i, errors.Errorf("unknown field %q", i.Value)
-			}
+			} else if col := joinIndex(i.Value); col != "" {
+				// Indexed columns are already typed
+				i.Value = col
+				return i, nil
+			} else {
+				if !alreadyJoined(i.Value) {
+					joinValue(i.Value)
+				}
 
-			if !alreadyJoined(i.Value) {
-				query = query.LeftJoin(fmt.Sprintf(
-					"compose_record_value AS rv_%s ON (rv_%s.record_id = r.id AND rv_%s.name = ? AND rv_%s.deleted_at IS NULL)",
-					i.Value, i.Value, i.Value, i.Value,
-				), i.Value)
+				i.Value = fmt.Sprintf("rv_%s.value", i.Value)
 			}
 
-			// @todo switch value for ref when doing Record/Owner lookup
-			i.Value = fmt.Sprintf("rv_%s.value", i.Value)
+			if field.IsNumeric() || field.IsRef() {
+				i.Value = r.dialect().NumericValue(i.Value)
+			}
 
 			return i, nil
 		}
@@ -209,50 +573,62 @@ func (r record) buildQuery(module *types.Module, f types.RecordFilter) (query sq
 		if fn, err = fp.ParseExpression(f.Filter); err != nil {
 			return
 		} else if filterSql, filterArgs, err := fn.ToSql(); err != nil {
-			return query, err
+			return query, nil, err
 		} else {
 			query = query.Where("("+filterSql+")", filterArgs...)
 		}
 	}
 
+	var (
+		// Sort columns
+		sc ql.Columns
+	)
+
 	if f.Sort != "" {
 		var (
 			// Sort parser
 			sp = ql.NewParser()
-
-			// Sort columns
-			sc ql.Columns
 		)
 
 		sp.OnIdent = func(i ql.Ident) (ql.Ident, error) {
-			var is bool
-			if i.Value, is = isRealRecordCol(i.Value); is {
+			var (
+				is    bool
+				err   error
+				alias string
+				col   string
+				field *types.ModuleField
+			)
+
+			if strings.Contains(i.Value, ".") {
+				if alias, col, field, err = joinRef(i.Value); err != nil {
+					return i, err
+				}
+			} else if i.Value, is = isRealRecordCol(i.Value); is {
 				i.Value += " "
 				return i, nil
-			}
-
-			if !module.Fields.HasName(i.Value) {
+			} else if field = module.Fields.FindByName(i.Value); field == nil {
 				return i, errors.Errorf("unknown field %q", i.Value)
-			}
-
-			field := module.Fields.FindByName(i.Value)
+			} else if col = joinIndex(i.Value); col != "" {
+				i.Value = col + " "
+				return i, nil
+			} else {
+				if !alreadyJoined(i.Value) {
+					joinValue(i.Value)
+				}
 
-			if !alreadyJoined(i.Value) {
-				query = query.LeftJoin(fmt.Sprintf(
-					"compose_record_value AS rv_%s ON (rv_%s.record_id = r.id AND rv_%s.name = ? AND rv_%s.deleted_at IS NULL)",
-					i.Value, i.Value, i.Value, i.Value,
-				), i.Value)
+				alias = "rv_" + i.Value
+				col = alias + ".value"
 			}
 
 			switch true {
 			case field.IsRef():
-				i.Value = fmt.Sprintf("rv_%s.ref ", i.Value)
+				i.Value = alias + ".ref "
 			case field.IsNumeric():
-				i.Value = fmt.Sprintf("CAST(rv_%s.value AS SIGNED)", i.Value)
+				i.Value = r.dialect().CastAsNumber(col)
 			case field.IsDateTime():
-				i.Value = fmt.Sprintf("CAST(rv_%s.value AS DATETIME)", i.Value)
+				i.Value = r.dialect().CastAsDateTime(col)
 			default:
-				i.Value = fmt.Sprintf("rv_%s.value ", i.Value)
+				i.Value = col + " "
 			}
 
 			return i, nil
@@ -262,17 +638,17 @@ func (r record) buildQuery(module *types.Module, f types.RecordFilter) (query sq
 			return
 
 		}
-
-		query = query.OrderBy(sc.Strings()...)
 	}
 
+	ks = rh.KeysetFromColumns(sc, "r.id")
+
 	return
 }
 
 func (r record) Create(record *types.Record) (*types.Record, error) {
 	record.ID = factory.Sonyflake.NextID()
 
-	if err := r.db().Insert("compose_record", record); err != nil {
+	if err := rh.Insert(r.db(), "compose_record", record); err != nil {
 		return nil, errors.Wrap(err, "could not update record")
 	}
 
@@ -299,6 +675,61 @@ func (r record) Delete(record *types.Record) error {
 	return err
 }
 
+func (r record) Undelete(record *types.Record) error {
+	_, err := r.db().Exec(
+		"UPDATE compose_record SET deleted_at = NULL, deleted_by = 0 WHERE rel_namespace = ? AND id = ?",
+		record.NamespaceID,
+		record.ID,
+	)
+
+	return err
+}
+
+// Purge permanently removes records that were deleted before the given time
+//
+// Values, revisions, record-level permission rules (shared records) and script
+// run logs of the removed records are removed with them; call it in a transaction.
+//
+// Returns number of removed records
+func (r record) Purge(deletedBefore time.Time) (uint64, error) {
+	for _, q := range r.purgeRelatedQueries() {
+		if _, err := r.db().Exec(q.query, deletedBefore); err != nil {
+			return 0, errors.Wrapf(err, "could not purge record %s", q.name)
+		}
+	}
+
+	res, err := r.db().Exec("DELETE FROM compose_record WHERE deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not purge records")
+	}
+
+	if err = r.purgeIndex(); err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	return uint64(n), err
+}
+
+// purgeRelatedQueries returns statements that remove rows referring to purged records
+//
+// Statements expect deleted-before time as the only argument
+func (r record) purgeRelatedQueries() []struct{ name, query string } {
+	const (
+		trash = "SELECT id FROM compose_record WHERE deleted_at IS NOT NULL AND deleted_at < ?"
+	)
+
+	return []struct{ name, query string }{
+		{"values", "DELETE FROM compose_record_value WHERE record_id IN (" + trash + ")"},
+		{"revisions", "DELETE FROM compose_record_revision WHERE rel_record IN (" + trash + ")"},
+		{"permission rules", fmt.Sprintf(
+			"DELETE FROM compose_permission_rules WHERE resource IN (SELECT CONCAT('%s', id) FROM compose_record WHERE deleted_at IS NOT NULL AND deleted_at < ?)",
+			types.RecordPermissionResource,
+		)},
+		{"script runs", "DELETE FROM compose_automation_script_run WHERE rel_record IN (" + trash + ")"},
+	}
+}
+
 func (r record) DeleteValues(record *types.Record) error {
 	_, err := r.db().Exec(
 		"UPDATE compose_record_value SET deleted_at = ? WHERE record_id = ?",
@@ -308,6 +739,14 @@ func (r record) DeleteValues(record *types.Record) error {
 	return err
 }
 
+func (r record) UndeleteValues(record *types.Record) error {
+	_, err := r.db().Exec(
+		"UPDATE compose_record_value SET deleted_at = NULL WHERE record_id = ?",
+		record.ID)
+
+	return err
+}
+
 func (r record) UpdateValues(recordID uint64, rvs types.RecordValueSet) (err error) {
 	// Remove all records and prepare to be updated
 	// @todo be more selective and delete only removed values
@@ -317,22 +756,44 @@ func (r record) UpdateValues(recordID uint64, rvs types.RecordValueSet) (err err
 
 	err = rvs.Walk(func(value *types.RecordValue) error {
 		value.RecordID = recordID
-		return r.db().Insert("compose_record_value", value)
+		return rh.Insert(r.db(), "compose_record_value", value)
 	})
 
-	return errors.Wrap(err, "could not insert record values")
+	if err != nil {
+		return errors.Wrap(err, "could not insert record values")
+	}
 
+	return r.refreshIndex(recordID)
 }
 
 func (r record) PartialUpdateValues(rvs ...*types.RecordValue) (err error) {
 	err = types.RecordValueSet(rvs).Walk(func(value *types.RecordValue) error {
-		return r.db().Replace("compose_record_value", value)
+		return rh.Upsert(r.db(), "compose_record_value", value, "record_id", "name", "place")
 	})
 
-	return errors.Wrap(err, "could not replace record values")
+	if err != nil {
+		return errors.Wrap(err, "could not replace record values")
+	}
+
+	var (
+		ids  = []uint64{}
+		seen = map[uint64]bool{}
+	)
+
+	for _, v := range rvs {
+		if !seen[v.RecordID] {
+			seen[v.RecordID] = true
+			ids = append(ids, v.RecordID)
+		}
+	}
 
+	return r.refreshIndex(ids...)
 }
 
+// LoadValues loads values of given fields for all given records
+//
+// Values are soft-deleted only together with their record (updates replace them),
+// so deleted values are loaded as well; that way records in trash have their values
 func (r record) LoadValues(fieldNames []string, IDs []uint64) (rvs types.RecordValueSet, err error) {
 	if len(fieldNames) == 0 || len(IDs) == 0 {
 		return
@@ -342,7 +803,6 @@ func (r record) LoadValues(fieldNames []string, IDs []uint64) (rvs types.RecordV
 		"  FROM compose_record_value " +
 		" WHERE record_id IN (?) " +
 		"   AND name IN (?) " +
-		"   AND deleted_at IS NULL " +
 		" ORDER BY record_id, place"
 
 	if sql, args, err := sqlx.In(sql, IDs, fieldNames); err != nil {
@@ -352,6 +812,243 @@ func (r record) LoadValues(fieldNames []string, IDs []uint64) (rvs types.RecordV
 	}
 }
 
+// LoadFieldValues loads values of the field for all records of the module (including deleted)
+func (r record) LoadFieldValues(moduleID uint64, fieldName string) (rvs types.RecordValueSet, err error) {
+	var q = r.fieldValuesQuery(moduleID, fieldName)
+
+	return rvs, rh.FetchAll(r.db(), q, &rvs)
+}
+
+func (r record) fieldValuesQuery(moduleID uint64, fieldName string) squirrel.SelectBuilder {
+	return squirrel.
+		Select("rv.record_id", "rv.name", "rv.value", "rv.ref", "rv.place", "rv.deleted_at").
+		From("compose_record_value AS rv").
+		Join(r.table()+" AS r ON (r.id = rv.record_id)").
+		Where(squirrel.Eq{"r.module_id": moduleID, "rv.name": fieldName}).
+		OrderBy("rv.record_id", "rv.place")
+}
+
+// FindRefs returns IDs of records of the module that reference record refID with the field
+//
+// Deleted records are ignored; when isReadable is set, only readable records are returned
+func (r record) FindRefs(moduleID uint64, fieldName string, refID uint64, isReadable *permissions.ResourceFilter) (ids []uint64, err error) {
+	return ids, rh.FetchAll(r.db(), r.refsQuery(moduleID, fieldName, refID, isReadable), &ids)
+}
+
+func (r record) refsQuery(moduleID uint64, fieldName string, refID uint64, isReadable *permissions.ResourceFilter) squirrel.SelectBuilder {
+	const sub = "SELECT 1 FROM compose_record_value AS rv WHERE rv.record_id = r.id AND rv.name = ? AND rv.ref = ? AND rv.deleted_at IS NULL"
+
+	var q = squirrel.
+		Select("r.id").
+		From(r.table()+" AS r").
+		Where(squirrel.Eq{"r.module_id": moduleID}).
+		Where("r.deleted_at IS NULL").
+		Where("EXISTS ("+sub+")", fieldName, refID).
+		OrderBy("r.id")
+
+	if isReadable != nil {
+		q = q.Where(isReadable)
+	}
+
+	return q
+}
+
+// DeleteRefValues removes values of the field that reference record refID from given records
+func (r record) DeleteRefValues(fieldName string, refID uint64, recordIDs ...uint64) error {
+	if len(recordIDs) == 0 {
+		return nil
+	}
+
+	sql, args, err := sqlx.In(
+		"DELETE FROM compose_record_value WHERE name = ? AND ref = ? AND record_id IN (?)",
+		fieldName,
+		refID,
+		recordIDs,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = r.db().Exec(sql, args...); err != nil {
+		return errors.Wrap(err, "could not remove record values")
+	}
+
+	return r.refreshIndex(recordIDs...)
+}
+
+// RenameValues renames values of the field on all records of the module
+func (r record) RenameValues(moduleID uint64, from, to string) error {
+	_, err := r.db().Exec(
+		"UPDATE compose_record_value SET name = ? WHERE name = ? AND record_id IN (SELECT id FROM "+r.table()+" WHERE module_id = ?)",
+		to,
+		from,
+		moduleID,
+	)
+
+	return errors.Wrap(err, "could not rename record values")
+}
+
+// FindDuplicate returns ID of the (first) record in the module that already uses the given value
+//
+// Record with recordID is excluded from the search. When constraint is scoped,
+// only records with the same value of the scope field are considered.
+// Returns 0 when value is not used.
+func (r record) FindDuplicate(moduleID, recordID uint64, value *types.RecordValue, uc types.ModuleFieldUniqueConstraint, scope string) (uint64, error) {
+	var ids []uint64
+
+	if err := rh.FetchAll(r.db(), r.duplicateQuery(moduleID, recordID, value, uc, scope), &ids); err != nil || len(ids) == 0 {
+		return 0, err
+	}
+
+	return ids[0], nil
+}
+
+// FindByKeys returns IDs of (at most two) records in the module with all given key values
+//
+// Caller can tell if key is ambiguous (more than one ID returned) without fetching all matches
+func (r record) FindByKeys(moduleID uint64, keys types.RecordValueSet) (ids []uint64, err error) {
+	return ids, rh.FetchAll(r.db(), r.keysQuery(moduleID, keys), &ids)
+}
+
+func (r record) keysQuery(moduleID uint64, keys types.RecordValueSet) squirrel.SelectBuilder {
+	const sub = "SELECT 1 FROM compose_record_value AS kv WHERE kv.record_id = r.id AND kv.name = ? AND kv.value = ? AND kv.deleted_at IS NULL"
+
+	var q = squirrel.
+		Select("r.id").
+		From(r.table() + " AS r").
+		Where(squirrel.Eq{"r.module_id": moduleID}).
+		Where("r.deleted_at IS NULL").
+		OrderBy("r.id").
+		Limit(2)
+
+	for _, k := range keys {
+		q = q.Where("EXISTS ("+sub+")", k.Name, k.Value)
+	}
+
+	return q
+}
+
+func (r record) duplicateQuery(moduleID, recordID uint64, value *types.RecordValue, uc types.ModuleFieldUniqueConstraint, scope string) squirrel.SelectBuilder {
+	var (
+		q = squirrel.
+			Select("rv.record_id").
+			From("compose_record_value AS rv").
+			Join("compose_record AS r ON (r.id = rv.record_id)").
+			Where(squirrel.Eq{"r.module_id": moduleID, "rv.name": value.Name}).
+			Where(squirrel.NotEq{"rv.record_id": recordID}).
+			Where("r.deleted_at IS NULL AND rv.deleted_at IS NULL").
+			Limit(1)
+	)
+
+	if uc.IgnoreCase {
+		q = q.Where("LOWER(rv.value) = LOWER(?)", value.Value)
+	} else {
+		q = q.Where(squirrel.Eq{"rv.value": value.Value})
+	}
+
+	if uc.Scope != "" {
+		const sub = "SELECT 1 FROM compose_record_value AS sv WHERE sv.record_id = rv.record_id AND sv.name = ? AND sv.deleted_at IS NULL"
+
+		if scope != "" {
+			q = q.Where("EXISTS ("+sub+" AND sv.value = ?)", uc.Scope, scope)
+		} else {
+			// Value without scope clashes only with other values without scope
+			q = q.Where("NOT EXISTS ("+sub+" AND sv.value <> '')", uc.Scope)
+		}
+	}
+
+	return q
+}
+
+// RecordRefAlias returns alias of the referenced record that is joined
+// for the paths in record filter and sort (<ref>.<field>)
+func RecordRefAlias(ref string) string {
+	return "rr_" + ref
+}
+
+// userValuesSource returns select statement with user ID and value columns
+func userValuesSource(vv map[uint64]string) (string, []interface{}) {
+	if len(vv) == 0 {
+		return "SELECT 0 AS id, NULL AS value", nil
+	}
+
+	var (
+		IDs  = make([]uint64, 0, len(vv))
+		ss   = make([]string, 0, len(vv))
+		args = make([]interface{}, 0, len(vv))
+	)
+
+	for ID := range vv {
+		IDs = append(IDs, ID)
+	}
+
+	sort.Slice(IDs, func(i, j int) bool { return IDs[i] < IDs[j] })
+
+	for _, ID := range IDs {
+		ss = append(ss, fmt.Sprintf("SELECT %d AS id, ? AS value", ID))
+		args = append(args, vv[ID])
+	}
+
+	return strings.Join(ss, " UNION ALL "), args
+}
+
+// UserIDs returns IDs of users in the user column or field (ref) of module's records
+func (r record) UserIDs(module *types.Module, ref string) (IDs []uint64, err error) {
+	var (
+		vv []string
+		q  squirrel.SelectBuilder
+	)
+
+	if IsRecordUserColumn(ref) {
+		c, _ := isRealRecordCol(ref)
+		q = squirrel.
+			Select("DISTINCT " + c).
+			From(r.table() + " AS r").
+			Where(squirrel.Eq{"r.module_id": module.ID})
+	} else {
+		q = squirrel.
+			Select("DISTINCT v.value").
+			From("compose_record_value AS v").
+			Join(r.table() + " AS r ON (r.id = v.record_id)").
+			Where(squirrel.Eq{"r.module_id": module.ID, "v.name": ref})
+	}
+
+	if err = rh.FetchAll(r.db(), q, &vv); err != nil {
+		return nil, errors.Wrap(err, "could not load user IDs")
+	}
+
+	for _, v := range vv {
+		if ID, _ := strconv.ParseUint(v, 10, 64); ID > 0 {
+			IDs = append(IDs, ID)
+		}
+	}
+
+	return
+}
+
+// IsSystemUserJoinable checks if system users are stored in the same database
+// as records so that user paths (<ref>.<field>) can join them
+func IsSystemUserJoinable() bool {
+	return systemUserJoinable()
+}
+
+// IsRecordUserColumn checks if name is a record column that references a user (ownedBy, createdBy...)
+func IsRecordUserColumn(name string) bool {
+	c, is := isRealRecordCol(name)
+	return is && isUserRecordCol(c)
+}
+
+// isUserRecordCol returns true for (real) record columns that reference users
+func isUserRecordCol(col string) bool {
+	switch col {
+	case "r.owned_by", "r.created_by", "r.updated_by", "r.deleted_by":
+		return true
+	}
+
+	return false
+}
+
 // Checks if field name is "real column", reformats it and returns
 func isRealRecordCol(name string) (string, bool) {
 	switch name {
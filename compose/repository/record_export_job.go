@@ -0,0 +1,257 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+	"github.com/titpetric/factory"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/rh"
+)
+
+type (
+	RecordExportJobRepository interface {
+		With(ctx context.Context, db *factory.DB) RecordExportJobRepository
+
+		FindByID(ID uint64) (*types.RecordExportJob, error)
+		FindExpired(now time.Time) (types.RecordExportJobSet, error)
+		Create(mod *types.RecordExportJob) (*types.RecordExportJob, error)
+		Update(mod *types.RecordExportJob) (*types.RecordExportJob, error)
+		Claim(staleBefore time.Time) (*types.RecordExportJob, error)
+		Heartbeat(ID uint64) error
+		Release(ID uint64) error
+		Cancel(ID uint64) error
+		DeleteByID(ID uint64) error
+	}
+
+	recordExportJob struct {
+		*repository
+	}
+)
+
+const (
+	ErrRecordExportJobNotFound = repositoryError("RecordExportJobNotFound")
+
+	// How many candidates are checked when claiming a job
+	recordExportClaimCandidates = 10
+)
+
+func RecordExportJob(ctx context.Context, db *factory.DB) RecordExportJobRepository {
+	return (&recordExportJob{}).With(ctx, db)
+}
+
+func (r recordExportJob) With(ctx context.Context, db *factory.DB) RecordExportJobRepository {
+	return &recordExportJob{
+		repository: r.repository.With(ctx, db),
+	}
+}
+
+func (r recordExportJob) table() string {
+	return "compose_record_export_job"
+}
+
+func (r recordExportJob) columns() []string {
+	return []string{
+		"rej.id",
+		"rej.rel_namespace",
+		"rej.rel_module",
+		"rej.rel_owner",
+		"rej.filename",
+		"rej.format",
+		"rej.url",
+		"rej.filter",
+		"rej.sort",
+		"rej.deleted",
+		"rej.fields",
+		"rej.multi_value",
+		"rej.multi_value_delimiter",
+		"rej.labels",
+		"rej.exported",
+		"rej.fail_reason",
+		"rej.created_at",
+		"rej.started_at",
+		"rej.finished_at",
+		"rej.expires_at",
+		"rej.canceled_at",
+		"rej.heartbeat_at",
+	}
+}
+
+// values returns values of all columns that can be changed with Update
+//
+// Heartbeat is changed only with Claim, Heartbeat and Release, cancel time with Cancel
+func (r recordExportJob) values(job *types.RecordExportJob) map[string]interface{} {
+	return map[string]interface{}{
+		"url":         job.Url,
+		"exported":    job.Exported,
+		"fail_reason": job.FailReason,
+		"started_at":  job.StartedAt,
+		"finished_at": job.FinishedAt,
+		"expires_at":  job.ExpiresAt,
+	}
+}
+
+func (r recordExportJob) query() squirrel.SelectBuilder {
+	return squirrel.
+		Select(r.columns()...).
+		From(r.table() + " AS rej")
+}
+
+func (r recordExportJob) FindByID(ID uint64) (*types.RecordExportJob, error) {
+	var (
+		job = &types.RecordExportJob{}
+
+		q = r.query().
+			Where(squirrel.Eq{"rej.id": ID})
+
+		err = rh.FetchOne(r.db(), q, job)
+	)
+
+	if err != nil {
+		return nil, err
+	} else if job.ID == 0 {
+		return nil, ErrRecordExportJobNotFound
+	}
+
+	return job, nil
+}
+
+// FindExpired returns finished jobs that expired before the given time or were canceled
+func (r recordExportJob) FindExpired(now time.Time) (set types.RecordExportJobSet, err error) {
+	return set, rh.FetchAll(r.db(), r.expiredQuery(now), &set)
+}
+
+func (r recordExportJob) expiredQuery(now time.Time) squirrel.SelectBuilder {
+	return r.query().
+		Where(squirrel.NotEq{"rej.finished_at": nil}).
+		Where(squirrel.Or{
+			squirrel.Lt{"rej.expires_at": now},
+			squirrel.NotEq{"rej.canceled_at": nil},
+		})
+}
+
+func (r recordExportJob) Create(mod *types.RecordExportJob) (*types.RecordExportJob, error) {
+	mod.ID = factory.Sonyflake.NextID()
+	mod.CreatedAt = time.Now()
+
+	values := r.values(mod)
+	values["id"] = mod.ID
+	values["rel_namespace"] = mod.NamespaceID
+	values["rel_module"] = mod.ModuleID
+	values["rel_owner"] = mod.UserID
+	values["filename"] = mod.Filename
+	values["format"] = mod.Format
+	values["filter"] = mod.Filter
+	values["sort"] = mod.Sort
+	values["deleted"] = mod.Deleted
+	values["fields"] = mod.Fields
+	values["multi_value"] = mod.MultiValue
+	values["multi_value_delimiter"] = mod.MultiValueDelimiter
+	values["labels"] = mod.Labels
+	values["created_at"] = mod.CreatedAt
+
+	if _, err := squirrel.ExecWith(r.db(), squirrel.Insert(r.table()).SetMap(values)); err != nil {
+		return nil, errors.Wrap(err, "could not create record export job")
+	}
+
+	return mod, nil
+}
+
+func (r recordExportJob) Update(mod *types.RecordExportJob) (*types.RecordExportJob, error) {
+	q := squirrel.
+		Update(r.table()).
+		SetMap(r.values(mod)).
+		Where(squirrel.Eq{"id": mod.ID})
+
+	if _, err := squirrel.ExecWith(r.db(), q); err != nil {
+		return nil, errors.Wrap(err, "could not update record export job")
+	}
+
+	return mod, nil
+}
+
+// Claim finds one unfinished job without a (live) worker and marks it as taken
+//
+// Job is taken by updating its heartbeat; when more workers try to claim the
+// same job at the same time, only one of them succeeds with the update
+func (r recordExportJob) Claim(staleBefore time.Time) (*types.RecordExportJob, error) {
+	var (
+		IDs = make([]uint64, 0)
+		now = time.Now()
+	)
+
+	if err := rh.FetchAll(r.db(), r.claimableQuery(staleBefore), &IDs); err != nil {
+		return nil, err
+	}
+
+	for _, ID := range IDs {
+		q := squirrel.
+			Update(r.table()).
+			Set("heartbeat_at", now).
+			Where(squirrel.Eq{"id": ID}).
+			Where(r.claimable(staleBefore))
+
+		res, err := squirrel.ExecWith(r.db(), q)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not claim record export job")
+		}
+
+		if n, _ := res.RowsAffected(); n == 1 {
+			return r.FindByID(ID)
+		}
+	}
+
+	return nil, nil
+}
+
+func (r recordExportJob) claimableQuery(staleBefore time.Time) squirrel.SelectBuilder {
+	return squirrel.
+		Select("id").
+		From(r.table()).
+		Where(r.claimable(staleBefore)).
+		OrderBy("created_at").
+		Limit(recordExportClaimCandidates)
+}
+
+func (r recordExportJob) claimable(staleBefore time.Time) squirrel.Sqlizer {
+	return squirrel.And{
+		squirrel.Eq{"finished_at": nil},
+		squirrel.Or{
+			squirrel.Eq{"heartbeat_at": nil},
+			squirrel.Lt{"heartbeat_at": staleBefore},
+		},
+	}
+}
+
+func (r recordExportJob) Heartbeat(ID uint64) error {
+	return rh.UpdateColumns(r.db(), r.table(), rh.Set{"heartbeat_at": time.Now()}, squirrel.Eq{"id": ID})
+}
+
+// Release clears heartbeat of the job so that it can be claimed by another worker
+// without waiting for the heartbeat timeout
+func (r recordExportJob) Release(ID uint64) error {
+	return rh.UpdateColumns(r.db(), r.table(), rh.Set{"heartbeat_at": nil}, squirrel.Eq{"id": ID})
+}
+
+// Cancel marks unfinished job as canceled
+//
+// Job is removed by the worker that runs the export
+func (r recordExportJob) Cancel(ID uint64) error {
+	return rh.UpdateColumns(
+		r.db(),
+		r.table(),
+		rh.Set{"canceled_at": time.Now()},
+		squirrel.And{
+			squirrel.Eq{"id": ID},
+			squirrel.Eq{"finished_at": nil},
+			squirrel.Eq{"canceled_at": nil},
+		},
+	)
+}
+
+func (r recordExportJob) DeleteByID(ID uint64) error {
+	return rh.Delete(r.db(), r.table(), squirrel.Eq{"id": ID})
+}
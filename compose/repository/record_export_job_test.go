@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordExportJobClaimableQuery(t *testing.T) {
+	var (
+		r   = recordExportJob{}
+		now = time.Now()
+	)
+
+	sql, args, err := r.claimableQuery(now).ToSql()
+	require.NoError(t, err)
+	require.Equal(t, "SELECT id FROM compose_record_export_job "+
+		"WHERE (finished_at IS NULL AND (heartbeat_at IS NULL OR heartbeat_at < ?)) "+
+		"ORDER BY created_at LIMIT 10", sql)
+	require.Equal(t, []interface{}{now}, args)
+}
+
+func TestRecordExportJobExpiredQuery(t *testing.T) {
+	var (
+		r   = recordExportJob{}
+		now = time.Now()
+	)
+
+	sql, args, err := r.expiredQuery(now).ToSql()
+	require.NoError(t, err)
+	require.Contains(t, sql, "WHERE rej.finished_at IS NOT NULL AND (rej.expires_at < ? OR rej.canceled_at IS NOT NULL)")
+	require.Equal(t, []interface{}{now}, args)
+}
@@ -0,0 +1,299 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+	"github.com/titpetric/factory"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/rh"
+)
+
+type (
+	RecordImportSessionRepository interface {
+		With(ctx context.Context, db *factory.DB) RecordImportSessionRepository
+
+		FindByID(ID uint64) (*types.RecordImportSession, error)
+		FindExpired(before time.Time) (types.RecordImportSessionSet, error)
+		Create(mod *types.RecordImportSession) (*types.RecordImportSession, error)
+		Update(mod *types.RecordImportSession) (*types.RecordImportSession, error)
+		Claim(staleBefore time.Time) (*types.RecordImportSession, error)
+		Heartbeat(ID uint64) error
+		Release(ID uint64) error
+		Cancel(ID uint64) error
+		DeleteByID(ID uint64) error
+
+		FindFailures(sessionID uint64, limit uint) (types.RecordImportFailureSet, error)
+		CreateFailure(mod *types.RecordImportFailure) error
+	}
+
+	recordImportSession struct {
+		*repository
+	}
+)
+
+const (
+	ErrRecordImportSessionNotFound = repositoryError("RecordImportSessionNotFound")
+
+	// How many candidates are checked when claiming a session
+	recordImportClaimCandidates = 10
+)
+
+func RecordImportSession(ctx context.Context, db *factory.DB) RecordImportSessionRepository {
+	return (&recordImportSession{}).With(ctx, db)
+}
+
+func (r recordImportSession) With(ctx context.Context, db *factory.DB) RecordImportSessionRepository {
+	return &recordImportSession{
+		repository: r.repository.With(ctx, db),
+	}
+}
+
+func (r recordImportSession) table() string {
+	return "compose_record_import_session"
+}
+
+func (r recordImportSession) tableFailures() string {
+	return "compose_record_import_error"
+}
+
+func (r recordImportSession) columns() []string {
+	return []string{
+		"ris.id",
+		"ris.rel_namespace",
+		"ris.rel_module",
+		"ris.rel_owner",
+		"ris.name",
+		"ris.format",
+		"ris.url",
+		"ris.sheet",
+		"ris.header_row",
+		"ris.fields",
+		"ris.on_error",
+		"ris.upsert_keys",
+		"ris.entry_count",
+		"ris.completed",
+		"ris.failed",
+		"ris.fail_reason",
+		"ris.created_at",
+		"ris.updated_at",
+		"ris.started_at",
+		"ris.finished_at",
+		"ris.canceled_at",
+		"ris.heartbeat_at",
+	}
+}
+
+// values returns values of all columns that can be changed with Update
+//
+// Progress is embedded into the session struct and factory's helpers
+// (rh.Insert, rh.Update) do not handle embedded structs.
+//
+// Cancellation and heartbeat are changed only with Cancel, Heartbeat and Release so
+// that the worker running the import does not overwrite them
+func (r recordImportSession) values(ses *types.RecordImportSession) map[string]interface{} {
+	return map[string]interface{}{
+		"fields":      ses.Fields,
+		"on_error":    ses.OnError,
+		"upsert_keys": ses.UpsertKeys,
+		"entry_count": ses.EntryCount,
+		"completed":   ses.Completed,
+		"failed":      ses.Failed,
+		"fail_reason": ses.FailReason,
+		"updated_at":  ses.UpdatedAt,
+		"started_at":  ses.StartedAt,
+		"finished_at": ses.FinishedAt,
+	}
+}
+
+func (r recordImportSession) query() squirrel.SelectBuilder {
+	return squirrel.
+		Select(r.columns()...).
+		From(r.table() + " AS ris")
+}
+
+func (r recordImportSession) FindByID(ID uint64) (*types.RecordImportSession, error) {
+	var (
+		ses = &types.RecordImportSession{}
+
+		q = r.query().
+			Where(squirrel.Eq{"ris.id": ID})
+
+		err = rh.FetchOne(r.db(), q, ses)
+	)
+
+	if err != nil {
+		return nil, err
+	} else if ses.ID == 0 {
+		return nil, ErrRecordImportSessionNotFound
+	}
+
+	return ses, nil
+}
+
+// FindExpired returns sessions that were not changed since the given time
+//
+// Sessions that are still running are not included
+func (r recordImportSession) FindExpired(before time.Time) (set types.RecordImportSessionSet, err error) {
+	return set, rh.FetchAll(r.db(), r.expiredQuery(before), &set)
+}
+
+func (r recordImportSession) expiredQuery(before time.Time) squirrel.SelectBuilder {
+	return r.query().
+		Where(squirrel.Or{
+			squirrel.Eq{"ris.started_at": nil},
+			squirrel.NotEq{"ris.finished_at": nil},
+		}).
+		Where(squirrel.Lt{"COALESCE(ris.updated_at, ris.created_at)": before})
+}
+
+func (r recordImportSession) Create(mod *types.RecordImportSession) (*types.RecordImportSession, error) {
+	mod.ID = factory.Sonyflake.NextID()
+	mod.CreatedAt = time.Now()
+
+	values := r.values(mod)
+	values["id"] = mod.ID
+	values["rel_namespace"] = mod.NamespaceID
+	values["rel_module"] = mod.ModuleID
+	values["rel_owner"] = mod.UserID
+	values["name"] = mod.Name
+	values["format"] = mod.Format
+	values["url"] = mod.Url
+	values["sheet"] = mod.Sheet
+	values["header_row"] = mod.HeaderRow
+	values["created_at"] = mod.CreatedAt
+
+	if _, err := squirrel.ExecWith(r.db(), squirrel.Insert(r.table()).SetMap(values)); err != nil {
+		return nil, errors.Wrap(err, "could not create record import session")
+	}
+
+	return mod, nil
+}
+
+func (r recordImportSession) Update(mod *types.RecordImportSession) (*types.RecordImportSession, error) {
+	now := time.Now()
+	mod.UpdatedAt = &now
+
+	q := squirrel.
+		Update(r.table()).
+		SetMap(r.values(mod)).
+		Where(squirrel.Eq{"id": mod.ID})
+
+	if _, err := squirrel.ExecWith(r.db(), q); err != nil {
+		return nil, errors.Wrap(err, "could not update record import session")
+	}
+
+	return mod, nil
+}
+
+// Claim finds one queued session without a (live) worker and marks it as taken
+//
+// Session is taken by updating its heartbeat; when more workers try to claim the
+// same session at the same time, only one of them succeeds with the update
+func (r recordImportSession) Claim(staleBefore time.Time) (*types.RecordImportSession, error) {
+	var (
+		IDs = make([]uint64, 0)
+		now = time.Now()
+	)
+
+	if err := rh.FetchAll(r.db(), r.claimableQuery(staleBefore), &IDs); err != nil {
+		return nil, err
+	}
+
+	for _, ID := range IDs {
+		q := squirrel.
+			Update(r.table()).
+			Set("heartbeat_at", now).
+			Where(squirrel.Eq{"id": ID}).
+			Where(r.claimable(staleBefore))
+
+		res, err := squirrel.ExecWith(r.db(), q)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not claim record import session")
+		}
+
+		if n, _ := res.RowsAffected(); n == 1 {
+			return r.FindByID(ID)
+		}
+	}
+
+	return nil, nil
+}
+
+func (r recordImportSession) claimableQuery(staleBefore time.Time) squirrel.SelectBuilder {
+	return squirrel.
+		Select("id").
+		From(r.table()).
+		Where(r.claimable(staleBefore)).
+		OrderBy("started_at").
+		Limit(recordImportClaimCandidates)
+}
+
+func (r recordImportSession) claimable(staleBefore time.Time) squirrel.Sqlizer {
+	return squirrel.And{
+		squirrel.NotEq{"started_at": nil},
+		squirrel.Eq{"finished_at": nil},
+		squirrel.Or{
+			squirrel.Eq{"heartbeat_at": nil},
+			squirrel.Lt{"heartbeat_at": staleBefore},
+		},
+	}
+}
+
+func (r recordImportSession) Heartbeat(ID uint64) error {
+	return rh.UpdateColumns(r.db(), r.table(), rh.Set{"heartbeat_at": time.Now()}, squirrel.Eq{"id": ID})
+}
+
+// Release clears heartbeat of the session so that it can be claimed by another worker
+// without waiting for the heartbeat timeout
+func (r recordImportSession) Release(ID uint64) error {
+	return rh.UpdateColumns(r.db(), r.table(), rh.Set{"heartbeat_at": nil}, squirrel.Eq{"id": ID})
+}
+
+// Cancel marks unfinished session as canceled
+//
+// Session is finished by the worker that runs the import
+func (r recordImportSession) Cancel(ID uint64) error {
+	return rh.UpdateColumns(
+		r.db(),
+		r.table(),
+		rh.Set{"canceled_at": time.Now()},
+		squirrel.And{
+			squirrel.Eq{"id": ID},
+			squirrel.Eq{"finished_at": nil},
+			squirrel.Eq{"canceled_at": nil},
+		},
+	)
+}
+
+func (r recordImportSession) DeleteByID(ID uint64) error {
+	if err := rh.Delete(r.db(), r.tableFailures(), squirrel.Eq{"rel_session": ID}); err != nil {
+		return err
+	}
+
+	return rh.Delete(r.db(), r.table(), squirrel.Eq{"id": ID})
+}
+
+// FindFailures returns failed entries of the session, ordered by entry number
+//
+// All failures are returned when limit is 0
+func (r recordImportSession) FindFailures(sessionID uint64, limit uint) (set types.RecordImportFailureSet, err error) {
+	q := squirrel.
+		Select("rel_session", "entry", "reason", "errors").
+		From(r.tableFailures()).
+		Where(squirrel.Eq{"rel_session": sessionID}).
+		OrderBy("entry")
+
+	if limit > 0 {
+		q = q.Limit(uint64(limit))
+	}
+
+	return set, rh.FetchAll(r.db(), q, &set)
+}
+
+func (r recordImportSession) CreateFailure(mod *types.RecordImportFailure) error {
+	return rh.Insert(r.db(), r.tableFailures(), mod)
+}
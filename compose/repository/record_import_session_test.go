@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordImportSessionClaimableQuery(t *testing.T) {
+	var (
+		r   = recordImportSession{}
+		now = time.Now()
+	)
+
+	sql, args, err := r.claimableQuery(now).ToSql()
+	require.NoError(t, err)
+	require.Equal(t, "SELECT id FROM compose_record_import_session "+
+		"WHERE (started_at IS NOT NULL AND finished_at IS NULL AND (heartbeat_at IS NULL OR heartbeat_at < ?)) "+
+		"ORDER BY started_at LIMIT 10", sql)
+	require.Equal(t, []interface{}{now}, args)
+}
+
+func TestRecordImportSessionExpiredQuery(t *testing.T) {
+	var (
+		r   = recordImportSession{}
+		now = time.Now()
+	)
+
+	sql, args, err := r.expiredQuery(now).ToSql()
+	require.NoError(t, err)
+	require.Contains(t, sql, "WHERE (ris.started_at IS NULL OR ris.finished_at IS NOT NULL) AND COALESCE(ris.updated_at, ris.created_at) < ?")
+	require.Equal(t, []interface{}{now}, args)
+}
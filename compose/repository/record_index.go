@@ -0,0 +1,424 @@
+package repository
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
+	"github.com/cortezaproject/corteza-server/pkg/rh"
+)
+
+// Record index keeps values of indexed fields (see ModuleField.IsIndexed) in typed
+// and indexed columns of a per-module table (compose_record_idx_<module ID>)
+//
+// Record queries and reports use these columns instead of joining
+// compose_record_value for each filtered or sorted field.
+//
+// Index tables are (re)built by UpdateIndex when module's indexed fields change and
+// kept in sync when record values are updated. Built indexes are registered in
+// compose_record_index table; queries use only registered columns that match the
+// current definition of the field and fall back to compose_record_value otherwise
+// (index is not built yet, rebuild failed or field changed after the rebuild)
+
+type (
+	// recordIndexColumn is a column of the record index table
+	recordIndexColumn struct {
+		Field  string `json:"field"`
+		Column string `json:"column"`
+		Type   string `json:"type"`
+	}
+
+	recordIndexColumnSet []*recordIndexColumn
+
+	recordIndexRegistry struct {
+		ModuleID  uint64               `db:"rel_module"`
+		Columns   recordIndexColumnSet `db:"columns"`
+		UpdatedAt time.Time            `db:"updated_at"`
+	}
+)
+
+const (
+	recordIndexRegistryTable = "compose_record_index"
+
+	// Alias of the index table in record queries
+	recordIndexAlias = "ri"
+
+	// Records changed this long before the rebuild started are copied to the rebuilt
+	// index again; covers write transactions that were still open when it started
+	recordIndexRebuildMargin = time.Minute * 5
+)
+
+func recordIndexTable(moduleID uint64) string {
+	return fmt.Sprintf("compose_record_idx_%d", moduleID)
+}
+
+// recordIndexFor returns index table columns for all indexed fields of the module
+func recordIndexFor(module *types.Module) (cc recordIndexColumnSet) {
+	_ = module.Fields.Walk(func(f *types.ModuleField) error {
+		if !f.IsIndexed() {
+			return nil
+		}
+
+		var typ = dbx.ColumnTypeText
+
+		switch f.ValueKind() {
+		case "Number":
+			typ = dbx.ColumnTypeNumber
+		case "DateTime":
+			if !f.Options.Bool("onlyTime") {
+				typ = dbx.ColumnTypeDateTime
+			}
+		case "Record", "User", "Owner", "File":
+			typ = dbx.ColumnTypeID
+		}
+
+		cc = append(cc, &recordIndexColumn{
+			Field:  f.Name,
+			Column: fmt.Sprintf("c_%d", f.ID),
+			Type:   typ,
+		})
+
+		return nil
+	})
+
+	return
+}
+
+// FindByField returns column of the indexed field
+func (cc recordIndexColumnSet) FindByField(name string) *recordIndexColumn {
+	for i := range cc {
+		if cc[i].Field == name {
+			return cc[i]
+		}
+	}
+
+	return nil
+}
+
+// current returns columns of the built index (cc) that match the definition (def)
+func (cc recordIndexColumnSet) current(def recordIndexColumnSet) (out recordIndexColumnSet) {
+	for _, c := range def {
+		if b := cc.FindByField(c.Field); b != nil && *b == *c {
+			out = append(out, c)
+		}
+	}
+
+	return
+}
+
+func (cc recordIndexColumnSet) equal(other recordIndexColumnSet) bool {
+	if len(cc) != len(other) {
+		return false
+	}
+
+	for i := range cc {
+		if *cc[i] != *other[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (cc *recordIndexColumnSet) Scan(value interface{}) error {
+	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
+	switch value.(type) {
+	case nil:
+		*cc = recordIndexColumnSet{}
+	case []uint8:
+		if err := json.Unmarshal(value.([]byte), cc); err != nil {
+			return errors.Wrapf(err, "Can not scan '%v' into recordIndexColumnSet", value)
+		}
+	}
+
+	return nil
+}
+
+func (cc recordIndexColumnSet) Value() (driver.Value, error) {
+	if cc == nil {
+		cc = recordIndexColumnSet{}
+	}
+
+	return json.Marshal(cc)
+}
+
+// loadIndex returns registered (built) record index of the module
+func (r record) loadIndex(moduleID uint64) (ri recordIndexRegistry, err error) {
+	var q = squirrel.
+		Select("rel_module", "columns", "updated_at").
+		From(recordIndexRegistryTable).
+		Where(squirrel.Eq{"rel_module": moduleID})
+
+	return ri, errors.Wrap(rh.FetchOne(r.db(), q, &ri), "could not load record index")
+}
+
+// currentIndex returns index columns that queries can use for the module's fields
+func (r record) currentIndex(module *types.Module) (recordIndexColumnSet, error) {
+	if ri, err := r.loadIndex(module.ID); err != nil {
+		return nil, err
+	} else {
+		return ri.Columns.current(recordIndexFor(module)), nil
+	}
+}
+
+// UpdateIndex (re)builds module's record index table when indexed fields change
+//
+// Index is unregistered before the table is dropped and registered again only after the
+// new table is filled so queries use values from compose_record_value in the meantime
+// (or when rebuild fails).
+//
+// Records that are written while the index is not registered do not update it; values of
+// records created, updated or deleted since the rebuild started (minus recordIndexRebuildMargin)
+// are copied again before and after the index is registered.
+//
+// Rebuilds of the same module are serialized with a lock shared by all instances.
+//
+// Table is dropped when there are no indexed fields left
+func (r record) UpdateIndex(module *types.Module) (err error) {
+	var (
+		cc    = recordIndexFor(module)
+		table = recordIndexTable(module.ID)
+
+		unlock func() error
+	)
+
+	if unlock, err = dbx.Lock(r.Context(), r.db(), table); err != nil {
+		return errors.Wrap(err, "could not lock record index")
+	}
+
+	defer func() {
+		if uerr := unlock(); err == nil {
+			err = uerr
+		}
+	}()
+
+	// Loaded after the lock is taken; concurrent rebuild might have done the work already
+	current, err := r.loadIndex(module.ID)
+	if err != nil {
+		return
+	}
+
+	if current.ModuleID > 0 && current.Columns.equal(cc) {
+		return nil
+	}
+
+	var since = time.Now().Add(-recordIndexRebuildMargin)
+
+	if err = rh.Delete(r.db(), recordIndexRegistryTable, squirrel.Eq{"rel_module": module.ID}); err != nil {
+		return errors.Wrap(err, "could not unregister record index")
+	}
+
+	if _, err = r.db().Exec("DROP TABLE IF EXISTS " + table); err != nil {
+		return errors.Wrap(err, "could not drop record index table")
+	}
+
+	if len(cc) == 0 {
+		return nil
+	}
+
+	for _, ddl := range r.indexTableDDL(module.ID, cc) {
+		if _, err = r.db().Exec(ddl); err != nil {
+			return errors.Wrap(err, "could not create record index table")
+		}
+	}
+
+	if _, err = squirrel.ExecWith(r.db(), r.indexFillQuery(module.ID, cc)); err != nil {
+		return errors.Wrap(err, "could not fill record index table")
+	}
+
+	// Records written while the table was filled
+	if err = r.refreshIndexSince(module.ID, cc, since); err != nil {
+		return
+	}
+
+	current = recordIndexRegistry{ModuleID: module.ID, Columns: cc, UpdatedAt: time.Now()}
+	if err = rh.Insert(r.db(), recordIndexRegistryTable, current); err != nil {
+		return errors.Wrap(err, "could not register record index")
+	}
+
+	// Records written after the previous refresh by writers that did not see the registered index yet
+	return r.refreshIndexSince(module.ID, cc, since)
+}
+
+// indexTableDDL returns statements that create index table and indexes on all columns
+func (r record) indexTableDDL(moduleID uint64, cc recordIndexColumnSet) []string {
+	var (
+		d     = r.dialect()
+		table = recordIndexTable(moduleID)
+		cols  = fmt.Sprintf("record_id %s NOT NULL", d.TypedColumn(dbx.ColumnTypeID))
+		ddl   = make([]string, 0, len(cc)+1)
+	)
+
+	for _, c := range cc {
+		cols += fmt.Sprintf(", %s %s NULL", c.Column, d.TypedColumn(c.Type))
+	}
+
+	ddl = append(ddl, fmt.Sprintf("CREATE TABLE %s (%s, PRIMARY KEY (record_id))", table, cols))
+
+	for _, c := range cc {
+		ddl = append(ddl, fmt.Sprintf(
+			"CREATE INDEX %s_%s ON %s (%s)",
+			table, c.Column, table, d.IndexedColumn(c.Column, c.Type),
+		))
+	}
+
+	return ddl
+}
+
+// indexFillQuery returns insert statement that copies values from compose_record_value to index table
+//
+// All module's records are copied when no IDs are given. Soft-deleted values
+// are copied as well; they belong to deleted records that can still be listed.
+//
+// Values that can not be converted to the column type (invalid legacy values) are indexed as NULL
+func (r record) indexFillQuery(moduleID uint64, cc recordIndexColumnSet, recordIDs ...uint64) squirrel.InsertBuilder {
+	if len(recordIDs) > 0 {
+		return r.indexFillQueryWhere(moduleID, cc, squirrel.Eq{"r.id": recordIDs})
+	}
+
+	return r.indexFillQueryWhere(moduleID, cc, nil)
+}
+
+// indexFillQueryWhere returns insert statement that copies values of module's records
+// that match the condition (on compose_record AS r) to index table
+func (r record) indexFillQueryWhere(moduleID uint64, cc recordIndexColumnSet, cnd squirrel.Sqlizer) squirrel.InsertBuilder {
+	var (
+		d    = r.dialect()
+		cols = []string{"record_id"}
+
+		src = squirrel.
+			Select("r.id AS record_id").
+			From(r.table() + " AS r").
+			Where(squirrel.Eq{"r.module_id": moduleID})
+
+		typed = squirrel.Select("src.record_id")
+	)
+
+	if cnd != nil {
+		src = src.Where(cnd)
+	}
+
+	for _, c := range cc {
+		cols = append(cols, c.Column)
+		src = src.Column(
+			"(SELECT MAX(v.value) FROM compose_record_value AS v WHERE v.record_id = r.id AND v.name = ?) AS "+c.Column,
+			c.Field,
+		)
+
+		typed = typed.Column(d.TypedValue("src."+c.Column, c.Type))
+	}
+
+	return squirrel.
+		Insert(recordIndexTable(moduleID)).
+		Columns(cols...).
+		Select(typed.FromSelect(src, "src"))
+}
+
+// refreshIndex copies current values of given records to index tables of their modules
+func (r record) refreshIndex(recordIDs ...uint64) (err error) {
+	if len(recordIDs) == 0 {
+		return nil
+	}
+
+	var (
+		rr = []*struct {
+			RecordID uint64               `db:"record_id"`
+			ModuleID uint64               `db:"rel_module"`
+			Columns  recordIndexColumnSet `db:"columns"`
+		}{}
+
+		q = squirrel.
+			Select("r.id AS record_id", "ri.rel_module", "ri.columns").
+			From(r.table() + " AS r").
+			Join(recordIndexRegistryTable + " AS ri ON (ri.rel_module = r.module_id)").
+			Where(squirrel.Eq{"r.id": recordIDs})
+
+		ids     = map[uint64][]uint64{}
+		columns = map[uint64]recordIndexColumnSet{}
+	)
+
+	if err = rh.FetchAll(r.db(), q, &rr); err != nil {
+		return errors.Wrap(err, "could not load record index")
+	}
+
+	for _, i := range rr {
+		ids[i.ModuleID] = append(ids[i.ModuleID], i.RecordID)
+		columns[i.ModuleID] = i.Columns
+	}
+
+	for moduleID := range ids {
+		if err = rh.Delete(r.db(), recordIndexTable(moduleID), squirrel.Eq{"record_id": ids[moduleID]}); err != nil {
+			return errors.Wrap(err, "could not update record index")
+		}
+
+		if _, err = squirrel.ExecWith(r.db(), r.indexFillQuery(moduleID, columns[moduleID], ids[moduleID]...)); err != nil {
+			return errors.Wrap(err, "could not update record index")
+		}
+	}
+
+	return nil
+}
+
+// refreshIndexSince copies current values of module's records created, updated or deleted
+// at or after the given time to the index table
+func (r record) refreshIndexSince(moduleID uint64, cc recordIndexColumnSet, since time.Time) (err error) {
+	var (
+		changed = squirrel.Or{
+			squirrel.GtOrEq{"r.created_at": since},
+			squirrel.GtOrEq{"r.updated_at": since},
+			squirrel.GtOrEq{"r.deleted_at": since},
+		}
+
+		ids = squirrel.
+			Select("r.id").
+			From(r.table() + " AS r").
+			Where(squirrel.Eq{"r.module_id": moduleID}).
+			Where(changed)
+	)
+
+	sub, args, err := ids.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "could not refresh record index")
+	}
+
+	if err = rh.Delete(r.db(), recordIndexTable(moduleID), squirrel.Expr("record_id IN ("+sub+")", args...)); err != nil {
+		return errors.Wrap(err, "could not refresh record index")
+	}
+
+	if _, err = squirrel.ExecWith(r.db(), r.indexFillQueryWhere(moduleID, cc, changed)); err != nil {
+		return errors.Wrap(err, "could not refresh record index")
+	}
+
+	return nil
+}
+
+// purgeIndex removes rows of records that no longer exist from all index tables
+func (r record) purgeIndex() (err error) {
+	var (
+		mm []uint64
+		q  = squirrel.Select("rel_module").From(recordIndexRegistryTable)
+	)
+
+	if err = rh.FetchAll(r.db(), q, &mm); err != nil {
+		return errors.Wrap(err, "could not load record indexes")
+	}
+
+	for _, moduleID := range mm {
+		_, err = r.db().Exec(fmt.Sprintf(
+			"DELETE FROM %s WHERE record_id NOT IN (SELECT id FROM %s WHERE module_id = ?)",
+			recordIndexTable(moduleID), r.table(),
+		), moduleID)
+
+		if err != nil {
+			return errors.Wrap(err, "could not purge record index")
+		}
+	}
+
+	return nil
+}
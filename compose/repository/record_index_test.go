@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+)
+
+func TestRecordIndexFor(t *testing.T) {
+	m := &types.Module{
+		Fields: types.ModuleFieldSet{
+			&types.ModuleField{ID: 1, Name: "name", Options: types.ModuleFieldOptions{"indexed": true}},
+			&types.ModuleField{ID: 2, Name: "revenue", Kind: "Number", Options: types.ModuleFieldOptions{"indexed": true}},
+			&types.ModuleField{ID: 3, Name: "closedAt", Kind: "DateTime", Options: types.ModuleFieldOptions{"indexed": true}},
+			&types.ModuleField{ID: 4, Name: "account", Kind: "Record", Options: types.ModuleFieldOptions{"indexed": true}},
+			&types.ModuleField{ID: 5, Name: "tags", Multi: true, Options: types.ModuleFieldOptions{"indexed": true}},
+			&types.ModuleField{ID: 6, Name: "notes"},
+		},
+	}
+
+	require.Equal(t,
+		recordIndexColumnSet{
+			{Field: "name", Column: "c_1", Type: "text"},
+			{Field: "revenue", Column: "c_2", Type: "number"},
+			{Field: "closedAt", Column: "c_3", Type: "datetime"},
+			{Field: "account", Column: "c_4", Type: "id"},
+		},
+		recordIndexFor(m),
+	)
+}
+
+func TestRecordIndexQueries(t *testing.T) {
+	var (
+		r  = record{}
+		cc = recordIndexColumnSet{
+			{Field: "name", Column: "c_1", Type: "text"},
+			{Field: "revenue", Column: "c_2", Type: "number"},
+		}
+	)
+
+	require.Equal(t,
+		[]string{
+			"CREATE TABLE compose_record_idx_42 (record_id BIGINT UNSIGNED NOT NULL, c_1 TEXT NULL, c_2 DECIMAL(38,10) NULL, PRIMARY KEY (record_id))",
+			"CREATE INDEX compose_record_idx_42_c_1 ON compose_record_idx_42 (c_1(191))",
+			"CREATE INDEX compose_record_idx_42_c_2 ON compose_record_idx_42 (c_2)",
+		},
+		r.indexTableDDL(42, cc),
+	)
+
+	sql, args, err := r.indexFillQuery(42, cc, 7).ToSql()
+	require.NoError(t, err)
+	require.Equal(t,
+		"INSERT INTO compose_record_idx_42 (record_id,c_1,c_2) SELECT src.record_id, src.c_1, "+
+			"CASE WHEN src.c_2 REGEXP '^[-+]?[0-9]{1,28}([.][0-9]*)?$' THEN CAST(src.c_2 AS DECIMAL(38,10)) END "+
+			"FROM (SELECT r.id AS record_id, "+
+			"(SELECT MAX(v.value) FROM compose_record_value AS v WHERE v.record_id = r.id AND v.name = ?) AS c_1, "+
+			"(SELECT MAX(v.value) FROM compose_record_value AS v WHERE v.record_id = r.id AND v.name = ?) AS c_2 "+
+			"FROM compose_record AS r WHERE r.module_id = ? AND r.id IN (?)) AS src",
+		sql,
+	)
+	require.Equal(t, []interface{}{"name", "revenue", uint64(42), uint64(7)}, args)
+}
+
+func TestRecordIndexCurrent(t *testing.T) {
+	var (
+		built = recordIndexColumnSet{
+			{Field: "name", Column: "c_1", Type: "text"},
+			{Field: "revenue", Column: "c_2", Type: "text"},
+			{Field: "title", Column: "c_3", Type: "text"},
+		}
+
+		def = recordIndexColumnSet{
+			{Field: "name", Column: "c_1", Type: "text"},
+			{Field: "revenue", Column: "c_2", Type: "number"},
+			{Field: "closedAt", Column: "c_4", Type: "datetime"},
+		}
+	)
+
+	// Only columns that were built for the current definition of the field can be used
+	require.Equal(t, recordIndexColumnSet{def[0]}, built.current(def))
+	require.Len(t, recordIndexColumnSet(nil).current(def), 0)
+}
+
+func TestRecordFinderIndexed(t *testing.T) {
+	var (
+		r = record{}
+		m = &types.Module{
+			ID:          123,
+			NamespaceID: 456,
+			Fields: types.ModuleFieldSet{
+				&types.ModuleField{ID: 1, Name: "foo"},
+				&types.ModuleField{ID: 2, Name: "revenue", Kind: "Number", Options: types.ModuleFieldOptions{"indexed": true}},
+				&types.ModuleField{ID: 3, Name: "name", Options: types.ModuleFieldOptions{"indexed": true}},
+			},
+		}
+	)
+
+	sb, ks, err := r.buildQuery(m, types.RecordFilter{Filter: "revenue > 1000 AND name = 'x' AND foo = 'y'", Sort: "revenue DESC"}, nil, recordIndexFor(m))
+	require.NoError(t, err)
+
+	sql, args, err := sb.OrderBy(ks.OrderBy(r.dialect(), false)...).ToSql()
+	require.NoError(t, err)
+	require.Contains(t, sql, "LEFT JOIN compose_record_idx_123 AS ri ON (ri.record_id = r.id) LEFT JOIN compose_record_value AS rv_foo ")
+	require.Contains(t, sql, "(ri.c_2 > 1000 AND ri.c_3 = ? AND rv_foo.value = ?)")
+	require.Contains(t, sql, "ORDER BY ri.c_2 DESC, r.id ASC")
+	require.Equal(t, []interface{}{"foo", uint64(123), uint64(456), "x", "y"}, args)
+}
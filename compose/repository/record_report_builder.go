@@ -1,7 +1,10 @@
 package repository
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -10,6 +13,8 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/cortezaproject/corteza-server/compose/types"
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
+	"github.com/cortezaproject/corteza-server/pkg/permissions"
 	"github.com/cortezaproject/corteza-server/pkg/ql"
 	"github.com/cortezaproject/corteza-server/pkg/rh"
 )
@@ -21,17 +26,74 @@ type (
 		// This is set by metric/column building to assist Cast()
 		numerics []string
 
-		report squirrel.SelectBuilder
-		parser *ql.Parser
+		// Percentiles of metrics that are calculated by Cast()
+		// from JSON array of values (dialects without percentile aggregate)
+		percentiles map[string]float64
+
+		// Metrics and dimensions of the built report, see Report()
+		metrics    []*types.RecordReportMetric
+		dimensions []*types.RecordReportDimension
+
+		// Loads fields of referenced modules (ref.field identifiers)
+		refFields moduleFieldFinder
+
+		// Record-level permission check filters of referenced modules,
+		// by name of the reference field (see RecordFilter.IsRefReadable)
+		refReadable map[string]*permissions.ResourceFilter
+
+		// Current record index columns (see record.currentIndex);
+		// values of other fields are read from compose_record_value
+		index recordIndexColumnSet
+
+		report  squirrel.SelectBuilder
+		parser  *ql.Parser
+		dialect dbx.Dialect
 	}
 )
 
 // Identifiers should be names of the fields (physical table columns OR json fields, defined in module)
+//
+// COUNTD(x) counts distinct values, MEDIAN(x) is PERCENTILE(x, 0.5);
+// CUMSUM(metric) reports running total of the metric
 func stdAggregationHandler(f ql.Function) (ql.Function, error) {
 	switch strings.ToUpper(f.Name) {
-	// case "COUNTD":
-	// 	return SqlConcatExpr("COUNT(DISTINCT ", aggrFuncArgs, ")")
-	//
+	case "COUNTD":
+		if len(f.Arguments) != 1 {
+			return f, fmt.Errorf("%s expects one argument", f.Name)
+		}
+
+		return ql.Function{Name: "COUNT", Arguments: ql.ASTSet{ql.ASTNodes{
+			ql.Keyword{Keyword: "DISTINCT "},
+			f.Arguments[0],
+		}}}, nil
+
+	case "MEDIAN":
+		if len(f.Arguments) != 1 {
+			return f, fmt.Errorf("%s expects one argument", f.Name)
+		}
+
+		return ql.Function{Name: "PERCENTILE", Arguments: ql.ASTSet{f.Arguments[0], ql.Number{Value: "0.5"}}}, nil
+
+	case "PERCENTILE":
+		if len(f.Arguments) != 2 {
+			return f, fmt.Errorf("%s expects two arguments", f.Name)
+		}
+
+		if _, err := percentileOf(f); err != nil {
+			return f, err
+		}
+
+		f.Name = "PERCENTILE"
+		return f, nil
+
+	case "CUMSUM":
+		if len(f.Arguments) != 1 {
+			return f, fmt.Errorf("%s expects one argument", f.Name)
+		}
+
+		f.Name = "CUMSUM"
+		return f, nil
+
 	case "COUNT", "SUM", "MAX", "MIN", "AVG", "STD":
 		return f, nil
 	default:
@@ -45,6 +107,18 @@ func stdFilterFuncHandler(f ql.Function) (ql.Function, error) {
 	case "CONCAT", "QUARTER", "YEAR", "DATE", "NOW", "DATE_ADD", "DATE_SUB", "DATE_FORMAT":
 		return f, nil
 
+	case "DATE_TRUNC":
+		if len(f.Arguments) != 2 {
+			return f, fmt.Errorf("%s expects two arguments", f.Name)
+		}
+
+		if _, ok := f.Arguments[0].(ql.String); !ok {
+			return f, fmt.Errorf("%s expects string unit", f.Name)
+		}
+
+		f.Name = "DATE_TRUNC"
+		return f, nil
+
 	default:
 		return f, fmt.Errorf("unsupported group-by function %q", f.Name)
 	}
@@ -59,9 +133,11 @@ func NewRecordReportBuilder(module *types.Module) *recordReportBuilder {
 		Where("r.module_id = ?", module.ID)
 
 	return &recordReportBuilder{
-		parser: ql.NewParser(),
-		module: module,
-		report: report,
+		parser:      ql.NewParser(),
+		module:      module,
+		report:      report,
+		dialect:     dbx.DialectFor("compose"),
+		percentiles: map[string]float64{},
 	}
 }
 
@@ -78,16 +154,101 @@ func (b *recordReportBuilder) Build(metrics, dimensions, filters string) (sql st
 		return false
 	}
 
+	// Indexed fields are read from the record index table
+	var ri = b.index
+
+	// Fields of value columns, used to cast arguments of MIN and MAX
+	var columnFields = map[string]*types.ModuleField{}
+
 	b.parser.OnIdent = func(i ql.Ident) (ql.Ident, error) {
 		var is bool
 		if i.Value, is = isRealRecordCol(i.Value); is {
 			return i, nil
 		}
 
-		if !b.module.Fields.HasName(i.Value) {
+		if p := strings.Index(i.Value, "."); p > 0 {
+			// Field of the referenced record
+			var (
+				ref, name = i.Value[:p], i.Value[p+1:]
+				rf        = b.module.Fields.FindByName(ref)
+			)
+
+			if rf == nil {
+				return i, errors.Errorf("unknown field %q", ref)
+			} else if rf.Kind != "Record" || rf.RefModuleID() == 0 {
+				return i, errors.Errorf("field %q is not a reference", ref)
+			} else if b.refFields == nil {
+				return i, errors.Errorf("can not use fields of referenced records (%q)", i.Value)
+			}
+
+			refFields, err := b.refFields(rf.RefModuleID())
+			if err != nil {
+				return i, err
+			}
+
+			field := refFields.FindByName(name)
+			if field == nil {
+				return i, errors.Errorf("unknown field %q in path %q", name, i.Value)
+			}
+
+			if !alreadyJoined(ref) {
+				b.report = b.report.LeftJoin(fmt.Sprintf(
+					"compose_record_value AS rv_%s ON (rv_%s.record_id = r.id AND rv_%s.name = ? AND rv_%s.deleted_at IS NULL)",
+					ref, ref, ref, ref,
+				), ref)
+			}
+
+			// Values are joined only for referenced records that exist and can be read
+			rr := RecordRefAlias(ref)
+			if !alreadyJoined(rr) {
+				var (
+					cnd  = fmt.Sprintf("%s.id = rv_%s.ref AND %s.deleted_at IS NULL", rr, ref, rr)
+					args []interface{}
+				)
+
+				if isReadable := b.refReadable[ref]; isReadable != nil {
+					sql, aa, err := isReadable.ToSql()
+					if err != nil {
+						return i, err
+					}
+
+					cnd += " AND (" + sql + ")"
+					args = aa
+				}
+
+				b.report = b.report.LeftJoin(fmt.Sprintf("compose_record AS %s ON (%s)", rr, cnd), args...)
+			}
+
+			alias := fmt.Sprintf("rrv_%s_%s", ref, name)
+			if !alreadyJoined(i.Value) {
+				b.report = b.report.LeftJoin(fmt.Sprintf(
+					"compose_record_value AS %s ON (%s.record_id = %s.id AND %s.name = ? AND %s.deleted_at IS NULL)",
+					alias, alias, rr, alias, alias,
+				), name)
+			}
+
+			i.Value = alias + ".value"
+			columnFields[i.Value] = field
+			return i, nil
+		}
+
+		field := b.module.Fields.FindByName(i.Value)
+		if field == nil {
 			return i, errors.Errorf("unknown field %q", i.Value)
 		}
 
+		if c := ri.FindByField(i.Value); c != nil {
+			if !alreadyJoined(recordIndexAlias) {
+				b.report = b.report.LeftJoin(fmt.Sprintf(
+					"%s AS %s ON (%s.record_id = r.id)",
+					recordIndexTable(b.module.ID), recordIndexAlias, recordIndexAlias,
+				))
+			}
+
+			i.Value = recordIndexAlias + "." + c.Column
+			return i, nil
+		}
+
 		if !alreadyJoined(i.Value) {
 			b.report = b.report.LeftJoin(fmt.Sprintf(
 				"compose_record_value AS rv_%s ON (rv_%s.record_id = r.id AND rv_%s.name = ? AND rv_%s.deleted_at IS NULL)",
@@ -97,12 +258,46 @@ func (b *recordReportBuilder) Build(metrics, dimensions, filters string) (sql st
 
 		// @todo switch value for ref when doing Record/Owner lookup
 		i.Value = fmt.Sprintf("rv_%s.value", i.Value)
+		columnFields[i.Value] = field
 
 		return i, nil
 	}
 
+	// Chain function handlers: validate first, then translate to dialect
+	//
+	// Translation of functions that need to know where they are used is deferred
+	// (see translateDeferred)
+	var funcHandler = func(validator ql.FunctionHandler) ql.FunctionHandler {
+		return func(f ql.Function) (ql.Function, error) {
+			f, err := validator(f)
+			if err != nil {
+				return f, err
+			}
+
+			if isDeferredFunction(f) {
+				return f, nil
+			}
+
+			switch strings.ToUpper(f.Name) {
+			case "MIN", "MAX":
+				// Values are stored as strings; numbers must be
+				// cast to be compared as such, other values are not
+				for a := range f.Arguments {
+					if i, ok := f.Arguments[a].(ql.Ident); ok && columnFields[i.Value] != nil && columnFields[i.Value].IsNumeric() {
+						i.Value = fmt.Sprintf("CAST(NULLIF(%s, '') AS %s)", i.Value, b.dialect.TypedColumn(dbx.ColumnTypeNumber))
+						f.Arguments[a] = i
+					}
+				}
+			}
+
+			return b.dialect.QlFunction(f)
+		}
+	}
+
+	b.parser.OnOperator = b.dialect.QlOperator
+
 	var columns ql.Columns
-	b.parser.OnFunction = stdAggregationHandler
+	b.parser.OnFunction = funcHandler(stdAggregationHandler)
 	if columns, err = b.parser.ParseColumns(metrics); err != nil {
 		err = errors.Wrapf(err, "could not parse metrics %q", metrics)
 		return
@@ -115,14 +310,66 @@ func (b *recordReportBuilder) Build(metrics, dimensions, filters string) (sql st
 			m.Alias = fmt.Sprintf("metric_%d", i)
 		}
 
+		var (
+			metric = &types.RecordReportMetric{Alias: m.Alias}
+			fn, ok = topFunction(m.Expr)
+		)
+
+		if ok && fn.Name == "CUMSUM" {
+			// Running totals are calculated from the reported values
+			metric.Cumulative = true
+			m.Expr = ql.ASTNodes{fn.Arguments[0]}
+			fn, ok = topFunction(m.Expr)
+		}
+
+		if ok {
+			metric.Aggregate = aggregateOf(fn)
+		}
+
+		if ok && fn.Name == "PERCENTILE" {
+			var p float64
+			if p, err = percentileOf(fn); err != nil {
+				return
+			}
+
+			if fn, err = b.dialect.QlFunction(fn); err != nil {
+				return
+			}
+
+			m.Expr = ql.ASTNodes{fn}
+
+			if fn.Name == "JSON_ARRAYAGG" {
+				// Percentile is calculated from the list of values
+				b.percentiles[m.Alias] = p
+				b.report = b.report.Column(squirrel.Alias(m.Expr, m.Alias))
+				b.metrics = append(b.metrics, metric)
+				continue
+			}
+		}
+
+		if err = b.translateDeferred(m.Expr); err != nil {
+			err = errors.Wrapf(err, "could not parse metrics %q", metrics)
+			return
+		}
+
+		if ok && (metric.Aggregate == "MIN" || metric.Aggregate == "MAX") && len(fn.Arguments) == 1 {
+			if i, is := fn.Arguments[0].(ql.Ident); is && columnFields[i.Value] != nil && columnFields[i.Value].IsDateTime() {
+				// Minimum or maximum date is reported as-is
+				b.report = b.report.Column(squirrel.Alias(m.Expr, m.Alias))
+				b.metrics = append(b.metrics, metric)
+				continue
+			}
+		}
+
 		// Wrap to cast func to ensure numeric output
 		col := squirrel.Alias(rh.SquirrelConcatExpr("CAST(", m.Expr, " AS DECIMAL(14,2))"), m.Alias)
 		b.report = b.report.Column(col)
 
 		b.numerics = append(b.numerics, m.Alias)
+		b.metrics = append(b.metrics, metric)
 	}
 
-	b.parser.OnFunction = stdFilterFuncHandler
+	b.parser.OnFunction = funcHandler(stdFilterFuncHandler)
 	if columns, err = b.parser.ParseColumns(dimensions); err != nil {
 		err = errors.Wrapf(err, "could not parse dimensions %q", dimensions)
 		return
@@ -134,6 +381,18 @@ func (b *recordReportBuilder) Build(metrics, dimensions, filters string) (sql st
 			d.Alias = fmt.Sprintf("dimension_%d", i)
 		}
 
+		var dimension = &types.RecordReportDimension{Alias: d.Alias}
+		if fn, ok := topFunction(d.Expr); ok && fn.Name == "DATE_TRUNC" {
+			// Gaps between time buckets can be filled
+			dimension.Bucket = strings.ToLower(fn.Arguments[0].(ql.String).Value)
+		}
+
+		if err = b.translateDeferred(d.Expr); err != nil {
+			err = errors.Wrapf(err, "could not parse dimensions %q", dimensions)
+			return
+		}
+
+		b.dimensions = append(b.dimensions, dimension)
 		b.report = b.report.
 			Column(d).
 			GroupBy(d.Alias).
@@ -141,7 +400,7 @@ func (b *recordReportBuilder) Build(metrics, dimensions, filters string) (sql st
 	}
 
 	// Use a different handler for filter functions for this
-	b.parser.OnFunction = stdFilterFuncHandler
+	b.parser.OnFunction = funcHandler(stdFilterFuncHandler)
 
 	if len(filters) > 0 {
 		var filter ql.ASTNode
@@ -150,6 +409,11 @@ func (b *recordReportBuilder) Build(metrics, dimensions, filters string) (sql st
 			return
 		}
 
+		if err = b.translateDeferred(filter); err != nil {
+			err = errors.Wrapf(err, "could not parse filters %q", filters)
+			return
+		}
+
 		b.report = b.report.Where(filter)
 	}
 
@@ -175,5 +439,141 @@ func (b recordReportBuilder) Cast(row sqlx.ColScanner) map[string]interface{} {
 		}
 	}
 
+	for fname, p := range b.percentiles {
+		out[fname] = percentileCont(out[fname], p)
+	}
+
 	return out
 }
+
+// Report returns report with metrics and dimensions of the built query and given rows
+func (b recordReportBuilder) Report(rows []map[string]interface{}) *types.RecordReport {
+	return &types.RecordReport{
+		Dimensions: b.dimensions,
+		Metrics:    b.metrics,
+		Rows:       rows,
+	}
+}
+
+// translateDeferred translates deferred functions that are not used at the top of metric or dimension
+//
+// Only DATE_TRUNC can be used anywhere
+func (b recordReportBuilder) translateDeferred(n ql.ASTNode) (err error) {
+	var nn []ql.ASTNode
+
+	switch n := n.(type) {
+	case ql.ASTNodes:
+		nn = n
+	case ql.ASTSet:
+		nn = n
+	case ql.Function:
+		nn = n.Arguments
+	}
+
+	for i := range nn {
+		if fn, ok := nn[i].(ql.Function); ok && isDeferredFunction(fn) {
+			if fn.Name != "DATE_TRUNC" {
+				return errors.Errorf("%s can not be nested", fn.Name)
+			}
+
+			if nn[i], err = b.dialect.QlFunction(fn); err != nil {
+				return
+			}
+		}
+
+		if err = b.translateDeferred(nn[i]); err != nil {
+			return
+		}
+	}
+
+	return nil
+}
+
+func isDeferredFunction(fn ql.Function) bool {
+	switch fn.Name {
+	case "PERCENTILE", "CUMSUM", "DATE_TRUNC":
+		return true
+	}
+
+	return false
+}
+
+// topFunction returns function when expression is a single function call
+func topFunction(expr ql.ASTNodes) (ql.Function, bool) {
+	if len(expr) != 1 {
+		return ql.Function{}, false
+	}
+
+	fn, ok := expr[0].(ql.Function)
+	return fn, ok
+}
+
+// aggregateOf returns (uppercase) name of the aggregate function; COUNTD for COUNT(DISTINCT ...)
+func aggregateOf(fn ql.Function) string {
+	var name = strings.ToUpper(fn.Name)
+
+	if name == "COUNT" && len(fn.Arguments) == 1 {
+		if nn, ok := fn.Arguments[0].(ql.ASTNodes); ok && len(nn) > 0 {
+			if kw, ok := nn[0].(ql.Keyword); ok && kw.Keyword == "DISTINCT " {
+				return "COUNTD"
+			}
+		}
+	}
+
+	return name
+}
+
+// percentileOf returns percentile (0-1) of the PERCENTILE(expr, p) function
+func percentileOf(fn ql.Function) (float64, error) {
+	if n, ok := fn.Arguments[1].(ql.Number); ok {
+		if p, err := strconv.ParseFloat(n.Value, 64); err == nil && p >= 0 && p <= 1 {
+			return p, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%s expects percentile between 0 and 1", fn.Name)
+}
+
+// percentileCont calculates percentile of values in JSON array
+//
+// Percentile is interpolated between the closest values (as PERCENTILE_CONT does);
+// result is rounded the same way as other metrics
+func percentileCont(v interface{}, p float64) interface{} {
+	var (
+		vv  []*float64
+		nn  = make([]float64, 0)
+		src string
+	)
+
+	switch v := v.(type) {
+	case string:
+		src = v
+	default:
+		return nil
+	}
+
+	if err := json.Unmarshal([]byte(src), &vv); err != nil {
+		return nil
+	}
+
+	for _, n := range vv {
+		if n != nil {
+			nn = append(nn, *n)
+		}
+	}
+
+	if len(nn) == 0 {
+		return nil
+	}
+
+	sort.Float64s(nn)
+
+	var (
+		pos  = p * float64(len(nn)-1)
+		low  = int(math.Floor(pos))
+		high = int(math.Ceil(pos))
+		out  = nn[low] + (nn[high]-nn[low])*(pos-float64(low))
+	)
+
+	return math.Round(out*100) / 100
+}
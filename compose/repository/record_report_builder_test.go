@@ -3,9 +3,11 @@ package repository
 import (
 	"testing"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/permissions"
 )
 
 func TestRecordReportBuilder2(t *testing.T) {
@@ -32,3 +34,171 @@ func TestRecordReportBuilder2(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expected, sql)
 }
+
+func TestRecordReportBuilderMinMax(t *testing.T) {
+	builder := NewRecordReportBuilder(&types.Module{
+		ID: 1000,
+		Fields: types.ModuleFieldSet{
+			&types.ModuleField{Name: "price", Kind: "Number"},
+			&types.ModuleField{Name: "closed", Kind: "DateTime"},
+		}},
+	)
+
+	sql, _, err := builder.Build("MIN(price) AS low, MAX(closed) AS last", "closed", "")
+	require.NoError(t, err)
+	require.Contains(t, sql, "(CAST(MIN(CAST(NULLIF(rv_price.value, '') AS DECIMAL(38,10))) AS DECIMAL(14,2))) AS low")
+	require.Contains(t, sql, "(MAX(rv_closed.value)) AS last")
+}
+
+func TestRecordReportBuilderIndexed(t *testing.T) {
+	m := &types.Module{
+		ID: 1000,
+		Fields: types.ModuleFieldSet{
+			&types.ModuleField{ID: 1, Name: "amount", Kind: "Number", Options: types.ModuleFieldOptions{"indexed": true}},
+			&types.ModuleField{ID: 2, Name: "status", Options: types.ModuleFieldOptions{"indexed": true}},
+		},
+	}
+
+	builder := NewRecordReportBuilder(m)
+	builder.index = recordIndexFor(m)
+
+	expected := "SELECT (COUNT(*)) AS count, (CAST(sum(ri.c_1) AS DECIMAL(14,2))) AS metric_0, " +
+		"(ri.c_2) AS dimension_0 " +
+		"FROM compose_record AS r " +
+		"LEFT JOIN compose_record_idx_1000 AS ri ON (ri.record_id = r.id) " +
+		"WHERE r.deleted_at IS NULL AND r.module_id = ? " +
+		"GROUP BY dimension_0 " +
+		"ORDER BY dimension_0"
+
+	sql, _, err := builder.Build("sum(amount)", "status", "")
+	require.NoError(t, err)
+	require.Equal(t, expected, sql)
+}
+
+func TestRecordReportBuilderAggregates(t *testing.T) {
+	builder := NewRecordReportBuilder(&types.Module{
+		ID: 1000,
+		Fields: types.ModuleFieldSet{
+			&types.ModuleField{Name: "customer"},
+			&types.ModuleField{Name: "amount", Kind: "Number"},
+		}},
+	)
+
+	expected := "SELECT (COUNT(*)) AS count, " +
+		"(CAST(COUNT(DISTINCT rv_customer.value) AS DECIMAL(14,2))) AS customers, " +
+		"(JSON_ARRAYAGG(CAST(NULLIF(rv_amount.value, ?) AS DECIMAL(38,10)))) AS median, " +
+		"(CAST(sum(rv_amount.value) AS DECIMAL(14,2))) AS total, " +
+		"(DATE_FORMAT(r.created_at, ?)) AS dimension_0 " +
+		"FROM compose_record AS r " +
+		"LEFT JOIN compose_record_value AS rv_customer ON (rv_customer.record_id = r.id AND rv_customer.name = ? AND rv_customer.deleted_at IS NULL) " +
+		"LEFT JOIN compose_record_value AS rv_amount ON (rv_amount.record_id = r.id AND rv_amount.name = ? AND rv_amount.deleted_at IS NULL) " +
+		"WHERE r.deleted_at IS NULL AND r.module_id = ? " +
+		"GROUP BY dimension_0 " +
+		"ORDER BY dimension_0"
+
+	sql, _, err := builder.Build(
+		"COUNTD(customer) AS customers, MEDIAN(amount) AS median, CUMSUM(sum(amount)) AS total",
+		"DATE_TRUNC('month', createdAt)",
+		"",
+	)
+	require.NoError(t, err)
+	require.Equal(t, expected, sql)
+
+	report := builder.Report(nil)
+	require.Equal(t, []*types.RecordReportMetric{
+		{Alias: "customers", Aggregate: "COUNTD"},
+		{Alias: "median", Aggregate: "PERCENTILE"},
+		{Alias: "total", Aggregate: "SUM", Cumulative: true},
+	}, report.Metrics)
+	require.Equal(t, []*types.RecordReportDimension{{Alias: "dimension_0", Bucket: "month"}}, report.Dimensions)
+	require.Equal(t, map[string]float64{"median": 0.5}, builder.percentiles)
+}
+
+func TestRecordReportBuilderInvalid(t *testing.T) {
+	var module = &types.Module{
+		ID:     1000,
+		Fields: types.ModuleFieldSet{&types.ModuleField{Name: "amount"}},
+	}
+
+	tests := []struct {
+		metrics    string
+		dimensions string
+	}{
+		{"PERCENTILE(amount, 2)", "amount"},
+		{"SUM(CUMSUM(amount))", "amount"},
+		{"CUMSUM(CUMSUM(amount))", "amount"},
+		{"COUNT(*)", "DATE_TRUNC('decade', createdAt)"},
+		{"COUNT(*)", "amount.name"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.metrics+" "+tc.dimensions, func(t *testing.T) {
+			_, _, err := NewRecordReportBuilder(module).Build(tc.metrics, tc.dimensions, "")
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestRecordReportBuilderRefFields(t *testing.T) {
+	builder := NewRecordReportBuilder(&types.Module{
+		ID: 1000,
+		Fields: types.ModuleFieldSet{
+			&types.ModuleField{Name: "account", Kind: "Record", Options: types.ModuleFieldOptions{"moduleID": "2000"}},
+		}},
+	)
+
+	builder.refFields = func(moduleIDs ...uint64) (types.ModuleFieldSet, error) {
+		require.Equal(t, []uint64{2000}, moduleIDs)
+		return types.ModuleFieldSet{&types.ModuleField{Name: "industry"}}, nil
+	}
+
+	expected := "SELECT (COUNT(*)) AS count, " +
+		"(rrv_account_industry.value) AS dimension_0 " +
+		"FROM compose_record AS r " +
+		"LEFT JOIN compose_record_value AS rv_account ON (rv_account.record_id = r.id AND rv_account.name = ? AND rv_account.deleted_at IS NULL) " +
+		"LEFT JOIN compose_record AS rr_account ON (rr_account.id = rv_account.ref AND rr_account.deleted_at IS NULL) " +
+		"LEFT JOIN compose_record_value AS rrv_account_industry ON (rrv_account_industry.record_id = rr_account.id AND rrv_account_industry.name = ? AND rrv_account_industry.deleted_at IS NULL) " +
+		"WHERE r.deleted_at IS NULL AND r.module_id = ? " +
+		"GROUP BY dimension_0 " +
+		"ORDER BY dimension_0"
+
+	sql, args, err := builder.Build("", "account.industry", "")
+	require.NoError(t, err)
+	require.Equal(t, expected, sql)
+	require.Equal(t, []interface{}{"account", "industry", uint64(1000)}, args)
+}
+
+func TestRecordReportBuilderRefReadable(t *testing.T) {
+	builder := NewRecordReportBuilder(&types.Module{
+		ID: 1000,
+		Fields: types.ModuleFieldSet{
+			&types.ModuleField{Name: "account", Kind: "Record", Options: types.ModuleFieldOptions{"moduleID": "2000"}},
+		}},
+	)
+
+	builder.refFields = func(moduleIDs ...uint64) (types.ModuleFieldSet, error) {
+		return types.ModuleFieldSet{&types.ModuleField{Name: "industry"}}, nil
+	}
+
+	isReadable := (&permissions.ResourceFilter{}).
+		Build("rr_account.id").
+		Fallback(squirrel.Eq{"rr_account.owned_by": 42})
+
+	builder.refReadable = map[string]*permissions.ResourceFilter{"account": isReadable}
+
+	sql, args, err := builder.Build("", "account.industry", "")
+	require.NoError(t, err)
+	require.Contains(t, sql, "LEFT JOIN compose_record AS rr_account ON (rr_account.id = rv_account.ref AND rr_account.deleted_at IS NULL AND (COALESCE(")
+	require.Contains(t, sql, "rr_account.owned_by = ?))) LEFT JOIN compose_record_value AS rrv_account_industry ")
+
+	_, readableArgs, _ := isReadable.ToSql()
+	require.Equal(t, append(append([]interface{}{"account"}, readableArgs...), "industry", uint64(1000)), args)
+}
+
+func TestPercentileCont(t *testing.T) {
+	require.Nil(t, percentileCont(nil, 0.5))
+	require.Nil(t, percentileCont("[null]", 0.5))
+	require.Equal(t, 2.5, percentileCont("[4, 1, null, 2, 3]", 0.5))
+	require.Equal(t, 3.7, percentileCont("[4, 1, 2, 3]", 0.9))
+	require.Equal(t, 1.0, percentileCont("[4, 1, 2, 3]", 0))
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+	"github.com/titpetric/factory"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/rh"
+)
+
+type (
+	RecordRevisionRepository interface {
+		With(ctx context.Context, db *factory.DB) RecordRevisionRepository
+
+		Find(filter types.RecordRevisionFilter) (types.RecordRevisionSet, types.RecordRevisionFilter, error)
+		FindByRevision(namespaceID, recordID uint64, revision uint) (*types.RecordRevision, error)
+		Create(mod *types.RecordRevision) (*types.RecordRevision, error)
+	}
+
+	recordRevision struct {
+		*repository
+	}
+)
+
+const (
+	ErrRecordRevisionNotFound = repositoryError("RecordRevisionNotFound")
+)
+
+func RecordRevision(ctx context.Context, db *factory.DB) RecordRevisionRepository {
+	return (&recordRevision{}).With(ctx, db)
+}
+
+func (r recordRevision) With(ctx context.Context, db *factory.DB) RecordRevisionRepository {
+	return &recordRevision{
+		repository: r.repository.With(ctx, db),
+	}
+}
+
+func (r recordRevision) table() string {
+	return "compose_record_revision"
+}
+
+func (r recordRevision) columns() []string {
+	return []string{
+		"rr.id",
+		"rr.rel_namespace",
+		"rr.rel_module",
+		"rr.rel_record",
+		"rr.revision",
+		"rr.operation",
+		"rr.changes",
+		"rr.snapshot",
+		"rr.created_at",
+		"rr.created_by",
+	}
+}
+
+func (r recordRevision) query() squirrel.SelectBuilder {
+	return squirrel.
+		Select(r.columns()...).
+		From(r.table() + " AS rr")
+}
+
+func (r recordRevision) FindByRevision(namespaceID, recordID uint64, revision uint) (*types.RecordRevision, error) {
+	var (
+		rev = &types.RecordRevision{}
+
+		q = r.query().
+			Where(squirrel.Eq{
+				"rr.rel_namespace": namespaceID,
+				"rr.rel_record":    recordID,
+				"rr.revision":      revision,
+			})
+
+		err = rh.FetchOne(r.db(), q, rev)
+	)
+
+	if err != nil {
+		return nil, err
+	} else if rev.ID == 0 {
+		return nil, ErrRecordRevisionNotFound
+	}
+
+	return rev, nil
+}
+
+// Find returns revisions of a record, latest first
+func (r recordRevision) Find(filter types.RecordRevisionFilter) (set types.RecordRevisionSet, f types.RecordRevisionFilter, err error) {
+	f = filter
+
+	query := r.query().
+		Where(squirrel.Eq{
+			"rr.rel_namespace": f.NamespaceID,
+			"rr.rel_record":    f.RecordID,
+		})
+
+	if f.ModuleID > 0 {
+		query = query.Where(squirrel.Eq{"rr.rel_module": f.ModuleID})
+	}
+
+	if f.Count, err = rh.Count(r.db(), query); err != nil || f.Count == 0 {
+		return
+	}
+
+	query = query.OrderBy("rr.revision DESC")
+
+	return set, f, rh.FetchPaged(r.db(), query, f.Page, f.PerPage, &set)
+}
+
+// Create stores new revision and assigns it the next revision number of the record
+//
+// Should be called inside the same transaction as the change of the record
+// itself; the unique index on (rel_record, revision) guards against concurrent writes
+func (r recordRevision) Create(mod *types.RecordRevision) (*types.RecordRevision, error) {
+	var (
+		last uint
+
+		q = squirrel.
+			Select("COALESCE(MAX(revision), 0)").
+			From(r.table()).
+			Where(squirrel.Eq{"rel_record": mod.RecordID})
+	)
+
+	if sql, args, err := q.ToSql(); err != nil {
+		return nil, err
+	} else if err = r.db().Get(&last, sql, args...); err != nil {
+		return nil, errors.Wrap(err, "could not determine last record revision")
+	}
+
+	mod.ID = factory.Sonyflake.NextID()
+	mod.Revision = last + 1
+	mod.CreatedAt = time.Now()
+
+	if mod.Snapshot == nil {
+		mod.Snapshot = types.RecordValueSet{}
+	}
+
+	if err := rh.Insert(r.db(), r.table(), mod); err != nil {
+		return nil, errors.Wrap(err, "could not create record revision")
+	}
+
+	return mod, nil
+}
@@ -5,9 +5,12 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/permissions"
+	"github.com/cortezaproject/corteza-server/pkg/rh"
 )
 
 func TestRecordFinder(t *testing.T) {
@@ -18,9 +21,24 @@ func TestRecordFinder(t *testing.T) {
 		Fields: types.ModuleFieldSet{
 			&types.ModuleField{Name: "foo"},
 			&types.ModuleField{Name: "bar"},
+			&types.ModuleField{Name: "account", Kind: "Record", Options: types.ModuleFieldOptions{"moduleID": "789"}},
+			&types.ModuleField{Name: "manager", Kind: "User"},
 		},
 	}
 
+	ff := func(moduleIDs ...uint64) (types.ModuleFieldSet, error) {
+		return types.ModuleFieldSet{
+			&types.ModuleField{ModuleID: 789, Name: "name"},
+			&types.ModuleField{ModuleID: 789, Name: "revenue", Kind: "Number"},
+		}, nil
+	}
+
+	isRefReadable := (&permissions.ResourceFilter{}).
+		Build("rr_account.id").
+		Fallback(squirrel.Eq{"rr_account.owned_by": 42})
+
+	_, isRefReadableArgs, _ := isRefReadable.ToSql()
+
 	ttc := []struct {
 		f     types.RecordFilter
 		match []string
@@ -45,10 +63,60 @@ func TestRecordFinder(t *testing.T) {
 			},
 			args: []interface{}{"bar"},
 		},
+		{
+			f: types.RecordFilter{Deleted: rh.FilterStateExclusive, Filter: "foo = 7"},
+			match: []string{
+				"WHERE r.deleted_at IS NOT NULL AND r.module_id = ?",
+				"LEFT JOIN compose_record_value AS rv_foo ON (rv_foo.record_id = r.id AND rv_foo.name = ?) ",
+			},
+			args: []interface{}{"foo"},
+		},
+		{
+			f: types.RecordFilter{Filter: "account.revenue > 1000", Sort: "account.name, account.revenue DESC"},
+			match: []string{
+				"LEFT JOIN compose_record_value AS rv_account ON (rv_account.record_id = r.id AND rv_account.name = ? AND rv_account.deleted_at IS NULL) ",
+				"LEFT JOIN compose_record AS rr_account ON (rr_account.id = rv_account.ref AND rr_account.deleted_at IS NULL) ",
+				"LEFT JOIN compose_record_value AS rrv_account_revenue ON (rrv_account_revenue.record_id = rr_account.id AND rrv_account_revenue.name = ? AND rrv_account_revenue.deleted_at IS NULL) ",
+				"rrv_account_revenue.value > 1000",
+				"ORDER BY rrv_account_name.value ",
+			},
+			args: []interface{}{"account", "revenue", "name"},
+		},
+		{
+			f: types.RecordFilter{
+				Filter:        "account.revenue > 1000",
+				IsRefReadable: map[string]*permissions.ResourceFilter{"account": isRefReadable},
+			},
+			match: []string{
+				"LEFT JOIN compose_record AS rr_account ON (rr_account.id = rv_account.ref AND rr_account.deleted_at IS NULL AND (COALESCE(",
+				"resource = CONCAT(?, rr_account.id)",
+				"rr_account.owned_by = ?))) LEFT JOIN compose_record_value AS rrv_account_revenue ",
+			},
+			args: append(append([]interface{}{"account"}, isRefReadableArgs...), "revenue"),
+		},
+		{
+			f: types.RecordFilter{
+				Sort: "ownedBy.email, manager.name DESC",
+				UserValues: map[string]map[uint64]string{
+					"ownedBy.email": {2: "b@example.tld", 1: "a@example.tld"},
+				},
+			},
+			match: []string{
+				"LEFT JOIN (SELECT 1 AS id, ? AS value UNION ALL SELECT 2 AS id, ? AS value) AS ru_ownedBy_email ON (ru_ownedBy_email.id = r.owned_by) ",
+				"LEFT JOIN (SELECT 0 AS id, NULL AS value) AS ru_manager_name ON (ru_manager_name.id = rv_manager.value) ",
+				"ORDER BY ru_ownedBy_email.value ASC, ru_manager_name.value DESC, r.id ASC",
+			},
+			args: []interface{}{"a@example.tld", "b@example.tld", "manager"},
+		},
+		{
+			f:     types.RecordFilter{Deleted: rh.FilterStateInclusive},
+			match: []string{"FROM compose_record AS r WHERE r.module_id = ?"},
+		},
 	}
 
 	for _, tc := range ttc {
-		sb, err := r.buildQuery(m, tc.f)
+		sb, ks, err := r.buildQuery(m, tc.f, ff, nil)
+		sb = sb.OrderBy(ks.OrderBy(r.dialect(), false)...)
 
 		if tc.err != nil {
 			require.True(t, tc.err.Error() == fmt.Sprintf("%v", err), "buildQuery(%+v) did not return an expected error %q but %q", tc.f, tc.err, err)
@@ -70,3 +138,169 @@ func TestRecordFinder(t *testing.T) {
 				"     do not match expected %+v", args, tc.args)
 	}
 }
+
+func TestRecordFinderPathErrors(t *testing.T) {
+	var (
+		r = record{}
+		m = &types.Module{
+			Fields: types.ModuleFieldSet{
+				&types.ModuleField{Name: "foo"},
+				&types.ModuleField{Name: "accounts", Kind: "Record", Multi: true},
+				&types.ModuleField{Name: "account", Kind: "Record"},
+			},
+		}
+
+		ff = func(moduleIDs ...uint64) (types.ModuleFieldSet, error) {
+			return types.ModuleFieldSet{&types.ModuleField{Name: "name"}}, nil
+		}
+
+		tc = map[string]string{
+			"foo.name":         `field "foo" is not a reference`,
+			"createdAt.name":   `"createdAt" is not a reference`,
+			"missing.name":     `unknown field "missing"`,
+			"accounts.name":    `can not use multi-value field "accounts" in path "accounts.name"`,
+			"account.missing":  `unknown field "missing" in path "account.missing"`,
+			"ownedBy.password": `unknown user field "password" in path "ownedBy.password"`,
+		}
+	)
+
+	for path, msg := range tc {
+		_, _, err := r.buildQuery(m, types.RecordFilter{Filter: path + " = 1"}, ff, nil)
+		require.EqualError(t, err, msg)
+
+		_, _, err = r.buildQuery(m, types.RecordFilter{Sort: path}, ff, nil)
+		require.EqualError(t, err, msg)
+	}
+}
+
+func TestRecordFinderJoinedUsers(t *testing.T) {
+	var (
+		r = record{}
+		m = &types.Module{
+			Fields: types.ModuleFieldSet{&types.ModuleField{Name: "manager", Kind: "User"}},
+		}
+	)
+
+	defer func(fn func() bool) { systemUserJoinable = fn }(systemUserJoinable)
+	systemUserJoinable = func() bool { return true }
+
+	sb, ks, err := r.buildQuery(m, types.RecordFilter{Filter: "manager.email = 'a@example.tld'", Sort: "ownedBy.name"}, nil, nil)
+	require.NoError(t, err)
+
+	sql, args, err := sb.OrderBy(ks.OrderBy(r.dialect(), false)...).ToSql()
+	require.NoError(t, err)
+	require.Contains(t, sql, "LEFT JOIN sys_user AS ru_manager_email ON (ru_manager_email.id = rv_manager.value) ")
+	require.Contains(t, sql, "LEFT JOIN sys_user AS ru_ownedBy_name ON (ru_ownedBy_name.id = r.owned_by) ")
+	require.Contains(t, sql, "(ru_manager_email.email = ?)")
+	require.Contains(t, sql, "ORDER BY ru_ownedBy_name.name ASC")
+	require.NotContains(t, sql, "UNION ALL")
+	require.Equal(t, "a@example.tld", args[len(args)-1])
+}
+
+func TestRecordDuplicateQuery(t *testing.T) {
+	var (
+		r  = record{}
+		v  = &types.RecordValue{Name: "vat", Value: "SI123"}
+		tc = []struct {
+			uc    types.ModuleFieldUniqueConstraint
+			scope string
+			match string
+			args  []interface{}
+		}{
+			{
+				match: "rv.value = ?",
+				args:  []interface{}{uint64(1), "vat", uint64(2), "SI123"},
+			},
+			{
+				uc:    types.ModuleFieldUniqueConstraint{IgnoreCase: true},
+				match: "LOWER(rv.value) = LOWER(?)",
+				args:  []interface{}{uint64(1), "vat", uint64(2), "SI123"},
+			},
+			{
+				uc:    types.ModuleFieldUniqueConstraint{Scope: "country"},
+				scope: "SI",
+				match: "AND EXISTS (SELECT 1 FROM compose_record_value AS sv WHERE sv.record_id = rv.record_id AND sv.name = ? AND sv.deleted_at IS NULL AND sv.value = ?)",
+				args:  []interface{}{uint64(1), "vat", uint64(2), "SI123", "country", "SI"},
+			},
+			{
+				uc:    types.ModuleFieldUniqueConstraint{Scope: "country"},
+				match: "AND NOT EXISTS (",
+				args:  []interface{}{uint64(1), "vat", uint64(2), "SI123", "country"},
+			},
+		}
+	)
+
+	for _, c := range tc {
+		sql, args, err := r.duplicateQuery(1, 2, v, c.uc, c.scope).ToSql()
+		require.NoError(t, err)
+		require.Contains(t, sql, c.match)
+		require.Equal(t, c.args, args)
+	}
+}
+
+func TestRecordKeysQuery(t *testing.T) {
+	var (
+		r    = record{}
+		keys = types.RecordValueSet{
+			{Name: "erpID", Value: "E-42"},
+			{Name: "country", Value: "SI"},
+		}
+	)
+
+	sql, args, err := r.keysQuery(1, keys).ToSql()
+	require.NoError(t, err)
+	require.Contains(t, sql, "WHERE r.module_id = ? AND r.deleted_at IS NULL AND EXISTS (SELECT 1 FROM compose_record_value AS kv")
+	require.Contains(t, sql, "ORDER BY r.id LIMIT 2")
+	require.Equal(t, 2, strings.Count(sql, "EXISTS ("))
+	require.Equal(t, []interface{}{uint64(1), "erpID", "E-42", "country", "SI"}, args)
+}
+
+func TestRecordRefsQuery(t *testing.T) {
+	var r = record{}
+
+	sql, args, err := r.refsQuery(1, "account", 2, nil).ToSql()
+	require.NoError(t, err)
+	require.Contains(t, sql, "WHERE r.module_id = ? AND r.deleted_at IS NULL AND EXISTS (SELECT 1 FROM compose_record_value AS rv WHERE rv.record_id = r.id AND rv.name = ? AND rv.ref = ?")
+	require.Contains(t, sql, "ORDER BY r.id")
+	require.Equal(t, []interface{}{uint64(1), "account", uint64(2)}, args)
+}
+
+func TestRecordMaxValueCountQuery(t *testing.T) {
+	var r = record{}
+
+	q, err := r.maxValueCountQuery(r.query().Where("r.module_id = ?", 1), "tags")
+	require.NoError(t, err)
+
+	sql, args, err := q.ToSql()
+	require.NoError(t, err)
+	require.Equal(t, "SELECT COALESCE(MAX(c.c), 0) FROM "+
+		"(SELECT COUNT(*) AS c FROM compose_record_value AS rv WHERE rv.deleted_at IS NULL AND rv.name = ? "+
+		"AND rv.record_id IN (SELECT r.id FROM compose_record AS r WHERE r.module_id = ?) GROUP BY rv.record_id) AS c", sql)
+	require.Equal(t, []interface{}{"tags", 1}, args)
+}
+
+func TestRecordReadableIDsQuery(t *testing.T) {
+	var r = record{}
+
+	sql, args, err := r.readableIDsQuery(1, []uint64{2, 3}, nil).ToSql()
+	require.NoError(t, err)
+	require.Equal(t, "SELECT r.id FROM compose_record AS r WHERE r.id IN (?,?) AND r.module_id = ? AND r.deleted_at IS NULL", sql)
+	require.Equal(t, []interface{}{uint64(2), uint64(3), uint64(1)}, args)
+}
+
+func TestRecordPurgeRelatedQueries(t *testing.T) {
+	var (
+		req   = require.New(t)
+		qq    = record{}.purgeRelatedQueries()
+		names = make([]string, len(qq))
+	)
+
+	for i, q := range qq {
+		names[i] = q.name
+		req.Equal(1, strings.Count(q.query, "?"), q.name)
+		req.Contains(q.query, "FROM compose_record WHERE deleted_at IS NOT NULL AND deleted_at < ?)", q.name)
+	}
+
+	req.Equal([]string{"values", "revisions", "permission rules", "script runs"}, names)
+	req.Contains(qq[2].query, "resource IN (SELECT CONCAT('compose:record:', id) FROM compose_record")
+}
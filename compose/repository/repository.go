@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/titpetric/factory"
+
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
 )
 
 type (
@@ -15,7 +17,7 @@ type (
 
 // DB produces a contextual DB handle
 func DB(ctx context.Context) *factory.DB {
-	return factory.Database.MustGet("compose").With(ctx)
+	return dbx.MustGet("compose").With(ctx)
 }
 
 // With updates repository and database contexts
@@ -38,3 +40,12 @@ func (r *repository) db() *factory.DB {
 	}
 	return DB(r.ctx)
 }
+
+// dialect returns SQL dialect of the current db handle
+func (r *repository) dialect() dbx.Dialect {
+	if r == nil || r.dbh == nil {
+		return dbx.DialectFor("compose")
+	}
+
+	return dbx.DialectOf(r.dbh)
+}
@@ -50,6 +50,21 @@ type (
 		Record *types.Record `json:"record,omitempty"`
 	}
 
+	automationScriptRunSetPayload struct {
+		Filter automation.RunFilter `json:"filter"`
+		Set    automation.RunSet    `json:"set"`
+	}
+
+	automationScriptClaimSetPayload struct {
+		Filter automation.ScheduleClaimFilter `json:"filter"`
+		Set    automation.ScheduleClaimSet    `json:"set"`
+	}
+
+	automationScriptQueuePayload struct {
+		Filter automation.ExecutionFilter `json:"filter"`
+		Set    automation.ExecutionSet    `json:"set"`
+	}
+
 	AutomationScript struct {
 		scripts automationScriptService
 		runner  automationScriptRunner
@@ -66,6 +81,13 @@ type (
 		Create(context.Context, uint64, *automation.Script) error
 		Update(context.Context, uint64, *automation.Script) error
 		Delete(context.Context, uint64, uint64) error
+
+		FindRuns(context.Context, uint64, automation.RunFilter) (automation.RunSet, automation.RunFilter, error)
+		FindScheduleClaims(context.Context, uint64, automation.ScheduleClaimFilter) (automation.ScheduleClaimSet, automation.ScheduleClaimFilter, error)
+
+		FindExecutions(context.Context, uint64, automation.ExecutionFilter) (automation.ExecutionSet, automation.ExecutionFilter, error)
+		RequeueExecution(context.Context, uint64, uint64, uint64) error
+		DeleteExecution(context.Context, uint64, uint64, uint64) error
 	}
 
 	automationScriptRunner interface {
@@ -111,16 +133,18 @@ func (ctrl AutomationScript) List(ctx context.Context, r *request.AutomationScri
 func (ctrl AutomationScript) Create(ctx context.Context, r *request.AutomationScriptCreate) (interface{}, error) {
 	var (
 		script = &automation.Script{
-			NamespaceID: r.NamespaceID,
-			Name:        r.Name,
-			SourceRef:   r.SourceRef,
-			Source:      r.Source,
-			Async:       r.Async,
-			RunAs:       r.RunAs,
-			RunInUA:     r.RunInUA,
-			Timeout:     r.Timeout,
-			Critical:    r.Critical,
-			Enabled:     r.Enabled,
+			NamespaceID:  r.NamespaceID,
+			Name:         r.Name,
+			SourceRef:    r.SourceRef,
+			Source:       r.Source,
+			Async:        r.Async,
+			RunAs:        r.RunAs,
+			RunInUA:      r.RunInUA,
+			Timeout:      r.Timeout,
+			Critical:     r.Critical,
+			MaxAttempts:  r.MaxAttempts,
+			RetryBackoff: r.RetryBackoff,
+			Enabled:      r.Enabled,
 		}
 	)
 
@@ -136,17 +160,19 @@ func (ctrl AutomationScript) Read(ctx context.Context, r *request.AutomationScri
 
 func (ctrl AutomationScript) Update(ctx context.Context, r *request.AutomationScriptUpdate) (interface{}, error) {
 	mod := &automation.Script{
-		ID:          r.ScriptID,
-		NamespaceID: r.NamespaceID,
-		Name:        r.Name,
-		SourceRef:   r.SourceRef,
-		Source:      r.Source,
-		Async:       r.Async,
-		RunAs:       r.RunAs,
-		RunInUA:     r.RunInUA,
-		Timeout:     r.Timeout,
-		Critical:    r.Critical,
-		Enabled:     r.Enabled,
+		ID:           r.ScriptID,
+		NamespaceID:  r.NamespaceID,
+		Name:         r.Name,
+		SourceRef:    r.SourceRef,
+		Source:       r.Source,
+		Async:        r.Async,
+		RunAs:        r.RunAs,
+		RunInUA:      r.RunInUA,
+		Timeout:      r.Timeout,
+		Critical:     r.Critical,
+		MaxAttempts:  r.MaxAttempts,
+		RetryBackoff: r.RetryBackoff,
+		Enabled:      r.Enabled,
 	}
 
 	mod.AddTrigger(automation.STMS_UPDATE, r.Triggers...)
@@ -251,6 +277,59 @@ func (ctrl AutomationScript) Test(ctx context.Context, r *request.AutomationScri
 	return rval, err
 }
 
+func (ctrl AutomationScript) Runs(ctx context.Context, r *request.AutomationScriptRuns) (interface{}, error) {
+	set, filter, err := ctrl.scripts.FindRuns(ctx, r.NamespaceID, automation.RunFilter{
+		ScriptID: r.ScriptID,
+		RecordID: r.RecordID,
+		Outcome:  automation.RunOutcome(r.Outcome),
+
+		PageFilter: rh.Paging(r.Page, r.PerPage),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &automationScriptRunSetPayload{Filter: filter, Set: set}, nil
+}
+
+func (ctrl AutomationScript) Claims(ctx context.Context, r *request.AutomationScriptClaims) (interface{}, error) {
+	set, filter, err := ctrl.scripts.FindScheduleClaims(ctx, r.NamespaceID, automation.ScheduleClaimFilter{
+		ScriptID: r.ScriptID,
+
+		PageFilter: rh.Paging(r.Page, r.PerPage),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &automationScriptClaimSetPayload{Filter: filter, Set: set}, nil
+}
+
+func (ctrl AutomationScript) Queue(ctx context.Context, r *request.AutomationScriptQueue) (interface{}, error) {
+	set, filter, err := ctrl.scripts.FindExecutions(ctx, r.NamespaceID, automation.ExecutionFilter{
+		ScriptID:   r.ScriptID,
+		DeadLetter: r.DeadLetter,
+
+		PageFilter: rh.Paging(r.Page, r.PerPage),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &automationScriptQueuePayload{Filter: filter, Set: set}, nil
+}
+
+func (ctrl AutomationScript) Requeue(ctx context.Context, r *request.AutomationScriptRequeue) (interface{}, error) {
+	return resputil.OK(), ctrl.scripts.RequeueExecution(ctx, r.NamespaceID, r.ScriptID, r.ExecutionID)
+}
+
+func (ctrl AutomationScript) Dequeue(ctx context.Context, r *request.AutomationScriptDequeue) (interface{}, error) {
+	return resputil.OK(), ctrl.scripts.DeleteExecution(ctx, r.NamespaceID, r.ScriptID, r.ExecutionID)
+}
+
 func (ctrl AutomationScript) loadRecordScriptRunningCombo(ctx context.Context, namespaceID, moduleID, recordID uint64, record json.RawMessage) (ns *types.Namespace, m *types.Module, r *types.Record, err error) {
 	r = &types.Record{}
 
@@ -82,11 +82,12 @@ func (ctrl AutomationTrigger) Create(ctx context.Context, r *request.AutomationT
 
 	var (
 		t = &automation.Trigger{
-			Event:     r.Event,
-			Resource:  r.Resource,
-			Condition: r.Condition,
-			ScriptID:  s.ID,
-			Enabled:   r.Enabled,
+			Event:      r.Event,
+			Resource:   r.Resource,
+			Condition:  r.Condition,
+			Expression: r.Expression,
+			ScriptID:   s.ID,
+			Enabled:    r.Enabled,
 		}
 	)
 
@@ -111,6 +112,7 @@ func (ctrl AutomationTrigger) Update(ctx context.Context, r *request.AutomationT
 	t.Event = r.Event
 	t.Resource = r.Resource
 	t.Condition = r.Condition
+	t.Expression = r.Expression
 	t.ScriptID = r.ScriptID
 	t.Enabled = r.Enabled
 
@@ -30,8 +30,9 @@ type (
 	}
 
 	Chart struct {
-		chart service.ChartService
-		ac    chartAccessController
+		chart  service.ChartService
+		record service.RecordService
+		ac     chartAccessController
 	}
 
 	chartAccessController interface {
@@ -44,8 +45,9 @@ type (
 
 func (Chart) New() *Chart {
 	return &Chart{
-		chart: service.DefaultChart,
-		ac:    service.DefaultAccessControl,
+		chart:  service.DefaultChart,
+		record: service.DefaultRecord,
+		ac:     service.DefaultAccessControl,
 	}
 }
 
@@ -118,6 +120,23 @@ func (ctrl Chart) Delete(ctx context.Context, r *request.ChartDelete) (interface
 	return resputil.OK(), ctrl.chart.With(ctx).DeleteByID(r.NamespaceID, r.ChartID)
 }
 
+// Report returns rows of all chart's reports (in the same order as reports)
+func (ctrl Chart) Report(ctx context.Context, r *request.ChartReport) (interface{}, error) {
+	c, err := ctrl.chart.With(ctx).FindByID(r.NamespaceID, r.ChartID)
+	if err != nil {
+		return nil, err
+	}
+
+	var out = make([]interface{}, len(c.Config.Reports))
+	for i, report := range c.Config.Reports {
+		if out[i], err = ctrl.record.With(ctx).ChartReport(r.NamespaceID, report); err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
+
 func (ctrl Chart) makePayload(ctx context.Context, c *types.Chart, err error) (*chartPayload, error) {
 	if err != nil || c == nil {
 		return nil, err
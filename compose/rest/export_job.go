@@ -0,0 +1,143 @@
+package rest
+
+import (
+	"context"
+	"crypto/hmac"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/titpetric/factory/resputil"
+
+	"github.com/cortezaproject/corteza-server/compose/rest/request"
+	"github.com/cortezaproject/corteza-server/compose/service"
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+
+	"github.com/pkg/errors"
+)
+
+var _ = errors.Wrap
+
+type (
+	ExportJob struct {
+		exportJob service.ExportJobService
+		module    service.ModuleService
+	}
+)
+
+func (ExportJob) New() *ExportJob {
+	return &ExportJob{
+		exportJob: service.DefaultExportJob,
+		module:    service.DefaultModule,
+	}
+}
+
+func (ctrl ExportJob) Create(ctx context.Context, r *request.ExportJobCreate) (interface{}, error) {
+	if !auth.GetIdentityFromContext(ctx).Valid() {
+		return nil, errors.New("Unauthorized")
+	}
+
+	// Access control.
+	m, err := ctrl.module.With(ctx).FindByID(r.NamespaceID, r.ModuleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Fields, err = exportFields(m, r.Fields); err != nil {
+		return nil, err
+	}
+
+	if r.Filename == "" {
+		r.Filename = m.Handle
+	}
+
+	if r.Filename == "" {
+		r.Filename = "export"
+	}
+
+	job := &types.RecordExportJob{
+		NamespaceID:         r.NamespaceID,
+		ModuleID:            r.ModuleID,
+		Filename:            r.Filename,
+		Format:              r.Format,
+		Filter:              r.Filter,
+		Sort:                r.Sort,
+		Deleted:             r.Deleted,
+		Fields:              r.Fields,
+		MultiValue:          r.MultiValue,
+		MultiValueDelimiter: r.MultiValueDelimiter,
+		Labels:              r.Labels,
+	}
+
+	job, err = ctrl.exportJob.CreateRecord(ctx, job)
+	return makeExportJobPayload(ctx, job, err)
+}
+
+func (ctrl ExportJob) Read(ctx context.Context, r *request.ExportJobRead) (interface{}, error) {
+	if !auth.GetIdentityFromContext(ctx).Valid() {
+		return nil, errors.New("Unauthorized")
+	}
+
+	job, err := ctrl.exportJob.FindRecordByID(ctx, r.JobID)
+	return makeExportJobPayload(ctx, job, err)
+}
+
+func (ctrl ExportJob) Delete(ctx context.Context, r *request.ExportJobDelete) (interface{}, error) {
+	if !auth.GetIdentityFromContext(ctx).Valid() {
+		return nil, errors.New("Unauthorized")
+	}
+
+	return resputil.OK(), ctrl.exportJob.DeleteRecordByID(ctx, r.JobID)
+}
+
+// Download serves the exported file
+//
+// Request is not authenticated; user that downloads the file is identified by the signed URL
+func (ctrl ExportJob) Download(ctx context.Context, r *request.ExportJobDownload) (interface{}, error) {
+	if r.UserID == 0 || r.JobID == 0 {
+		return nil, errors.New("missing or invalid user or job ID")
+	}
+
+	if !hmac.Equal([]byte(r.Sign), []byte(signExportJob(r.UserID, r.NamespaceID, r.JobID))) {
+		return nil, errors.New("missing or invalid signature")
+	}
+
+	job, f, err := ctrl.exportJob.OpenRecord(ctx, r.JobID, r.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		name := url.QueryEscape(job.Filename + "." + job.Format)
+
+		w.Header().Add("Content-Disposition", "attachment; filename="+name)
+		http.ServeContent(w, req, name, *job.FinishedAt, f)
+	}, nil
+}
+
+func signExportJob(userID, namespaceID, jobID uint64) string {
+	return auth.DefaultSigner.Sign(userID, namespaceID, jobID)
+}
+
+// makeExportJobPayload sets download URL of the finished export, signed for the current user
+func makeExportJobPayload(ctx context.Context, job *types.RecordExportJob, err error) (*types.RecordExportJob, error) {
+	if err != nil || job == nil {
+		return nil, err
+	}
+
+	if job.IsDone() {
+		userID := auth.GetIdentityFromContext(ctx).Identity()
+
+		job.DownloadUrl = fmt.Sprintf(
+			"/namespace/%d/module/%d/record/export/%d/download?sign=%s&userID=%d",
+			job.NamespaceID,
+			job.ModuleID,
+			job.ID,
+			signExportJob(userID, job.NamespaceID, job.ID),
+			userID,
+		)
+	}
+
+	return job, nil
+}
@@ -37,6 +37,11 @@ type AutomationScriptAPI interface {
 	Runnable(context.Context, *request.AutomationScriptRunnable) (interface{}, error)
 	Run(context.Context, *request.AutomationScriptRun) (interface{}, error)
 	Test(context.Context, *request.AutomationScriptTest) (interface{}, error)
+	Runs(context.Context, *request.AutomationScriptRuns) (interface{}, error)
+	Claims(context.Context, *request.AutomationScriptClaims) (interface{}, error)
+	Queue(context.Context, *request.AutomationScriptQueue) (interface{}, error)
+	Requeue(context.Context, *request.AutomationScriptRequeue) (interface{}, error)
+	Dequeue(context.Context, *request.AutomationScriptDequeue) (interface{}, error)
 }
 
 // HTTP API interface
@@ -49,6 +54,11 @@ type AutomationScript struct {
 	Runnable func(http.ResponseWriter, *http.Request)
 	Run      func(http.ResponseWriter, *http.Request)
 	Test     func(http.ResponseWriter, *http.Request)
+	Runs     func(http.ResponseWriter, *http.Request)
+	Claims   func(http.ResponseWriter, *http.Request)
+	Queue    func(http.ResponseWriter, *http.Request)
+	Requeue  func(http.ResponseWriter, *http.Request)
+	Dequeue  func(http.ResponseWriter, *http.Request)
 }
 
 func NewAutomationScript(h AutomationScriptAPI) *AutomationScript {
@@ -213,6 +223,106 @@ func NewAutomationScript(h AutomationScriptAPI) *AutomationScript {
 				resputil.JSON(w, value)
 			}
 		},
+		Runs: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewAutomationScriptRuns()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("AutomationScript.Runs", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.Runs(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("AutomationScript.Runs", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("AutomationScript.Runs", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
+		Claims: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewAutomationScriptClaims()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("AutomationScript.Claims", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.Claims(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("AutomationScript.Claims", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("AutomationScript.Claims", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
+		Queue: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewAutomationScriptQueue()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("AutomationScript.Queue", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.Queue(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("AutomationScript.Queue", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("AutomationScript.Queue", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
+		Requeue: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewAutomationScriptRequeue()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("AutomationScript.Requeue", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.Requeue(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("AutomationScript.Requeue", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("AutomationScript.Requeue", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
+		Dequeue: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewAutomationScriptDequeue()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("AutomationScript.Dequeue", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.Dequeue(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("AutomationScript.Dequeue", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("AutomationScript.Dequeue", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
 	}
 }
 
@@ -227,5 +337,10 @@ func (h AutomationScript) MountRoutes(r chi.Router, middlewares ...func(http.Han
 		r.Get("/namespace/{namespaceID}/automation/script/runnable", h.Runnable)
 		r.Post("/namespace/{namespaceID}/automation/script/{scriptID}/run", h.Run)
 		r.Post("/namespace/{namespaceID}/automation/script/test", h.Test)
+		r.Get("/namespace/{namespaceID}/automation/script/{scriptID}/runs", h.Runs)
+		r.Get("/namespace/{namespaceID}/automation/script/{scriptID}/claims", h.Claims)
+		r.Get("/namespace/{namespaceID}/automation/script/{scriptID}/queue", h.Queue)
+		r.Post("/namespace/{namespaceID}/automation/script/{scriptID}/queue/{executionID}/requeue", h.Requeue)
+		r.Delete("/namespace/{namespaceID}/automation/script/{scriptID}/queue/{executionID}", h.Dequeue)
 	})
 }
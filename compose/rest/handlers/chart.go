@@ -34,6 +34,7 @@ type ChartAPI interface {
 	Read(context.Context, *request.ChartRead) (interface{}, error)
 	Update(context.Context, *request.ChartUpdate) (interface{}, error)
 	Delete(context.Context, *request.ChartDelete) (interface{}, error)
+	Report(context.Context, *request.ChartReport) (interface{}, error)
 }
 
 // HTTP API interface
@@ -43,6 +44,7 @@ type Chart struct {
 	Read   func(http.ResponseWriter, *http.Request)
 	Update func(http.ResponseWriter, *http.Request)
 	Delete func(http.ResponseWriter, *http.Request)
+	Report func(http.ResponseWriter, *http.Request)
 }
 
 func NewChart(h ChartAPI) *Chart {
@@ -147,6 +149,26 @@ func NewChart(h ChartAPI) *Chart {
 				resputil.JSON(w, value)
 			}
 		},
+		Report: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewChartReport()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("Chart.Report", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.Report(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("Chart.Report", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("Chart.Report", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
 	}
 }
 
@@ -158,5 +180,6 @@ func (h Chart) MountRoutes(r chi.Router, middlewares ...func(http.Handler) http.
 		r.Get("/namespace/{namespaceID}/chart/{chartID}", h.Read)
 		r.Post("/namespace/{namespaceID}/chart/{chartID}", h.Update)
 		r.Delete("/namespace/{namespaceID}/chart/{chartID}", h.Delete)
+		r.Get("/namespace/{namespaceID}/chart/{chartID}/report", h.Report)
 	})
 }
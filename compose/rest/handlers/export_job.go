@@ -0,0 +1,139 @@
+package handlers
+
+/*
+	Hello! This file is auto-generated from `docs/src/spec.json`.
+
+	For development:
+	In order to update the generated files, edit this file under the location,
+	add your struct fields, imports, API definitions and whatever you want, and:
+
+	1. run [spec](https://github.com/titpetric/spec) in the same folder,
+	2. run `./_gen.php` in this folder.
+
+	You may edit `export_job.go`, `export_job.util.go` or `export_job_test.go` to
+	implement your API calls, helper functions and tests. The file `export_job.go`
+	is only generated the first time, and will not be overwritten if it exists.
+*/
+
+import (
+	"context"
+
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/titpetric/factory/resputil"
+
+	"github.com/cortezaproject/corteza-server/compose/rest/request"
+	"github.com/cortezaproject/corteza-server/pkg/logger"
+)
+
+// Internal API interface
+type ExportJobAPI interface {
+	Create(context.Context, *request.ExportJobCreate) (interface{}, error)
+	Read(context.Context, *request.ExportJobRead) (interface{}, error)
+	Delete(context.Context, *request.ExportJobDelete) (interface{}, error)
+	Download(context.Context, *request.ExportJobDownload) (interface{}, error)
+}
+
+// HTTP API interface
+type ExportJob struct {
+	Create   func(http.ResponseWriter, *http.Request)
+	Read     func(http.ResponseWriter, *http.Request)
+	Delete   func(http.ResponseWriter, *http.Request)
+	Download func(http.ResponseWriter, *http.Request)
+}
+
+func NewExportJob(h ExportJobAPI) *ExportJob {
+	return &ExportJob{
+		Create: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewExportJobCreate()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("ExportJob.Create", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.Create(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("ExportJob.Create", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("ExportJob.Create", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
+		Read: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewExportJobRead()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("ExportJob.Read", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.Read(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("ExportJob.Read", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("ExportJob.Read", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
+		Delete: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewExportJobDelete()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("ExportJob.Delete", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.Delete(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("ExportJob.Delete", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("ExportJob.Delete", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
+		Download: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewExportJobDownload()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("ExportJob.Download", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.Download(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("ExportJob.Download", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("ExportJob.Download", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
+	}
+}
+
+func (h ExportJob) MountRoutes(r chi.Router, middlewares ...func(http.Handler) http.Handler) {
+	r.Group(func(r chi.Router) {
+		r.Use(middlewares...)
+		r.Post("/namespace/{namespaceID}/module/{moduleID}/record/export/", h.Create)
+		r.Get("/namespace/{namespaceID}/module/{moduleID}/record/export/{jobID}", h.Read)
+		r.Delete("/namespace/{namespaceID}/module/{moduleID}/record/export/{jobID}", h.Delete)
+		r.Get("/namespace/{namespaceID}/module/{moduleID}/record/export/{jobID}/download", h.Download)
+	})
+}
@@ -33,16 +33,18 @@ type ModuleAPI interface {
 	Create(context.Context, *request.ModuleCreate) (interface{}, error)
 	Read(context.Context, *request.ModuleRead) (interface{}, error)
 	Update(context.Context, *request.ModuleUpdate) (interface{}, error)
+	UpdateDryRun(context.Context, *request.ModuleUpdateDryRun) (interface{}, error)
 	Delete(context.Context, *request.ModuleDelete) (interface{}, error)
 }
 
 // HTTP API interface
 type Module struct {
-	List   func(http.ResponseWriter, *http.Request)
-	Create func(http.ResponseWriter, *http.Request)
-	Read   func(http.ResponseWriter, *http.Request)
-	Update func(http.ResponseWriter, *http.Request)
-	Delete func(http.ResponseWriter, *http.Request)
+	List         func(http.ResponseWriter, *http.Request)
+	Create       func(http.ResponseWriter, *http.Request)
+	Read         func(http.ResponseWriter, *http.Request)
+	Update       func(http.ResponseWriter, *http.Request)
+	UpdateDryRun func(http.ResponseWriter, *http.Request)
+	Delete       func(http.ResponseWriter, *http.Request)
 }
 
 func NewModule(h ModuleAPI) *Module {
@@ -127,6 +129,26 @@ func NewModule(h ModuleAPI) *Module {
 				resputil.JSON(w, value)
 			}
 		},
+		UpdateDryRun: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewModuleUpdateDryRun()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("Module.UpdateDryRun", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.UpdateDryRun(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("Module.UpdateDryRun", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("Module.UpdateDryRun", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
 		Delete: func(w http.ResponseWriter, r *http.Request) {
 			defer r.Body.Close()
 			params := request.NewModuleDelete()
@@ -157,6 +179,7 @@ func (h Module) MountRoutes(r chi.Router, middlewares ...func(http.Handler) http
 		r.Post("/namespace/{namespaceID}/module/", h.Create)
 		r.Get("/namespace/{namespaceID}/module/{moduleID}", h.Read)
 		r.Post("/namespace/{namespaceID}/module/{moduleID}", h.Update)
+		r.Post("/namespace/{namespaceID}/module/{moduleID}/dry-run", h.UpdateDryRun)
 		r.Delete("/namespace/{namespaceID}/module/{moduleID}", h.Delete)
 	})
 }
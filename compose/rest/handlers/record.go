@@ -34,29 +34,45 @@ type RecordAPI interface {
 	ImportInit(context.Context, *request.RecordImportInit) (interface{}, error)
 	ImportRun(context.Context, *request.RecordImportRun) (interface{}, error)
 	ImportProgress(context.Context, *request.RecordImportProgress) (interface{}, error)
+	ImportCancel(context.Context, *request.RecordImportCancel) (interface{}, error)
+	ImportErrors(context.Context, *request.RecordImportErrors) (interface{}, error)
 	Export(context.Context, *request.RecordExport) (interface{}, error)
 	Exec(context.Context, *request.RecordExec) (interface{}, error)
 	Create(context.Context, *request.RecordCreate) (interface{}, error)
 	Read(context.Context, *request.RecordRead) (interface{}, error)
 	Update(context.Context, *request.RecordUpdate) (interface{}, error)
 	Delete(context.Context, *request.RecordDelete) (interface{}, error)
+	Upsert(context.Context, *request.RecordUpsert) (interface{}, error)
+	Bulk(context.Context, *request.RecordBulk) (interface{}, error)
+	Undelete(context.Context, *request.RecordUndelete) (interface{}, error)
+	Revisions(context.Context, *request.RecordRevisions) (interface{}, error)
+	RestoreRevision(context.Context, *request.RecordRestoreRevision) (interface{}, error)
+	References(context.Context, *request.RecordReferences) (interface{}, error)
 	Upload(context.Context, *request.RecordUpload) (interface{}, error)
 }
 
 // HTTP API interface
 type Record struct {
-	Report         func(http.ResponseWriter, *http.Request)
-	List           func(http.ResponseWriter, *http.Request)
-	ImportInit     func(http.ResponseWriter, *http.Request)
-	ImportRun      func(http.ResponseWriter, *http.Request)
-	ImportProgress func(http.ResponseWriter, *http.Request)
-	Export         func(http.ResponseWriter, *http.Request)
-	Exec           func(http.ResponseWriter, *http.Request)
-	Create         func(http.ResponseWriter, *http.Request)
-	Read           func(http.ResponseWriter, *http.Request)
-	Update         func(http.ResponseWriter, *http.Request)
-	Delete         func(http.ResponseWriter, *http.Request)
-	Upload         func(http.ResponseWriter, *http.Request)
+	Report          func(http.ResponseWriter, *http.Request)
+	List            func(http.ResponseWriter, *http.Request)
+	ImportInit      func(http.ResponseWriter, *http.Request)
+	ImportRun       func(http.ResponseWriter, *http.Request)
+	ImportProgress  func(http.ResponseWriter, *http.Request)
+	ImportCancel    func(http.ResponseWriter, *http.Request)
+	ImportErrors    func(http.ResponseWriter, *http.Request)
+	Export          func(http.ResponseWriter, *http.Request)
+	Exec            func(http.ResponseWriter, *http.Request)
+	Create          func(http.ResponseWriter, *http.Request)
+	Read            func(http.ResponseWriter, *http.Request)
+	Update          func(http.ResponseWriter, *http.Request)
+	Delete          func(http.ResponseWriter, *http.Request)
+	Upsert          func(http.ResponseWriter, *http.Request)
+	Bulk            func(http.ResponseWriter, *http.Request)
+	Undelete        func(http.ResponseWriter, *http.Request)
+	Revisions       func(http.ResponseWriter, *http.Request)
+	RestoreRevision func(http.ResponseWriter, *http.Request)
+	References      func(http.ResponseWriter, *http.Request)
+	Upload          func(http.ResponseWriter, *http.Request)
 }
 
 func NewRecord(h RecordAPI) *Record {
@@ -161,6 +177,46 @@ func NewRecord(h RecordAPI) *Record {
 				resputil.JSON(w, value)
 			}
 		},
+		ImportCancel: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewRecordImportCancel()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("Record.ImportCancel", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.ImportCancel(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("Record.ImportCancel", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("Record.ImportCancel", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
+		ImportErrors: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewRecordImportErrors()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("Record.ImportErrors", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.ImportErrors(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("Record.ImportErrors", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("Record.ImportErrors", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
 		Export: func(w http.ResponseWriter, r *http.Request) {
 			defer r.Body.Close()
 			params := request.NewRecordExport()
@@ -281,6 +337,126 @@ func NewRecord(h RecordAPI) *Record {
 				resputil.JSON(w, value)
 			}
 		},
+		Upsert: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewRecordUpsert()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("Record.Upsert", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.Upsert(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("Record.Upsert", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("Record.Upsert", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
+		Bulk: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewRecordBulk()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("Record.Bulk", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.Bulk(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("Record.Bulk", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("Record.Bulk", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
+		Undelete: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewRecordUndelete()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("Record.Undelete", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.Undelete(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("Record.Undelete", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("Record.Undelete", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
+		Revisions: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewRecordRevisions()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("Record.Revisions", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.Revisions(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("Record.Revisions", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("Record.Revisions", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
+		RestoreRevision: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewRecordRestoreRevision()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("Record.RestoreRevision", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.RestoreRevision(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("Record.RestoreRevision", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("Record.RestoreRevision", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
+		References: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewRecordReferences()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("Record.References", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.References(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("Record.References", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("Record.References", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
 		Upload: func(w http.ResponseWriter, r *http.Request) {
 			defer r.Body.Close()
 			params := request.NewRecordUpload()
@@ -312,12 +488,20 @@ func (h Record) MountRoutes(r chi.Router, middlewares ...func(http.Handler) http
 		r.Post("/namespace/{namespaceID}/module/{moduleID}/record/import", h.ImportInit)
 		r.Patch("/namespace/{namespaceID}/module/{moduleID}/record/import/{sessionID}", h.ImportRun)
 		r.Get("/namespace/{namespaceID}/module/{moduleID}/record/import/{sessionID}", h.ImportProgress)
+		r.Delete("/namespace/{namespaceID}/module/{moduleID}/record/import/{sessionID}", h.ImportCancel)
+		r.Get("/namespace/{namespaceID}/module/{moduleID}/record/import/{sessionID}/errors", h.ImportErrors)
 		r.Get("/namespace/{namespaceID}/module/{moduleID}/record/export{filename}.{ext}", h.Export)
 		r.Post("/namespace/{namespaceID}/module/{moduleID}/record/exec/{procedure}", h.Exec)
 		r.Post("/namespace/{namespaceID}/module/{moduleID}/record/", h.Create)
 		r.Get("/namespace/{namespaceID}/module/{moduleID}/record/{recordID}", h.Read)
 		r.Post("/namespace/{namespaceID}/module/{moduleID}/record/{recordID}", h.Update)
 		r.Delete("/namespace/{namespaceID}/module/{moduleID}/record/{recordID}", h.Delete)
+		r.Post("/namespace/{namespaceID}/module/{moduleID}/record/upsert", h.Upsert)
+		r.Post("/namespace/{namespaceID}/module/{moduleID}/record/bulk", h.Bulk)
+		r.Post("/namespace/{namespaceID}/module/{moduleID}/record/{recordID}/undelete", h.Undelete)
+		r.Get("/namespace/{namespaceID}/module/{moduleID}/record/{recordID}/revisions", h.Revisions)
+		r.Post("/namespace/{namespaceID}/module/{moduleID}/record/{recordID}/revisions/{revision}/restore", h.RestoreRevision)
+		r.Get("/namespace/{namespaceID}/module/{moduleID}/record/{recordID}/references", h.References)
 		r.Post("/namespace/{namespaceID}/module/{moduleID}/record/attachment", h.Upload)
 	})
 }
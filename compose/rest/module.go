@@ -22,13 +22,18 @@ type (
 
 		Fields []*moduleFieldPayload `json:"fields"`
 
-		CanGrant        bool `json:"canGrant"`
-		CanUpdateModule bool `json:"canUpdateModule"`
-		CanDeleteModule bool `json:"canDeleteModule"`
-		CanCreateRecord bool `json:"canCreateRecord"`
-		CanReadRecord   bool `json:"canReadRecord"`
-		CanUpdateRecord bool `json:"canUpdateRecord"`
-		CanDeleteRecord bool `json:"canDeleteRecord"`
+		CanGrant          bool `json:"canGrant"`
+		CanUpdateModule   bool `json:"canUpdateModule"`
+		CanDeleteModule   bool `json:"canDeleteModule"`
+		CanCreateRecord   bool `json:"canCreateRecord"`
+		CanReadRecord     bool `json:"canReadRecord"`
+		CanUpdateRecord   bool `json:"canUpdateRecord"`
+		CanDeleteRecord   bool `json:"canDeleteRecord"`
+		CanUndeleteRecord bool `json:"canUndeleteRecord"`
+
+		CanReadOwnRecord   bool `json:"canReadOwnRecord"`
+		CanUpdateOwnRecord bool `json:"canUpdateOwnRecord"`
+		CanDeleteOwnRecord bool `json:"canDeleteOwnRecord"`
 
 		CanManageAutomationTriggers bool `json:"canManageAutomationTriggers"`
 	}
@@ -54,6 +59,10 @@ type (
 		CanReadRecord(context.Context, *types.Module) bool
 		CanUpdateRecord(context.Context, *types.Module) bool
 		CanDeleteRecord(context.Context, *types.Module) bool
+		CanUndeleteRecord(context.Context, *types.Module) bool
+		CanReadOwnRecord(context.Context, *types.Module) bool
+		CanUpdateOwnRecord(context.Context, *types.Module) bool
+		CanDeleteOwnRecord(context.Context, *types.Module) bool
 
 		CanReadRecordValue(context.Context, *types.ModuleField) bool
 		CanUpdateRecordValue(context.Context, *types.ModuleField) bool
@@ -123,6 +132,22 @@ func (ctrl *Module) Update(ctx context.Context, r *request.ModuleUpdate) (interf
 	return ctrl.makePayload(ctx, mod, err)
 }
 
+func (ctrl *Module) UpdateDryRun(ctx context.Context, r *request.ModuleUpdateDryRun) (interface{}, error) {
+	var (
+		mod = &types.Module{
+			ID:          r.ModuleID,
+			NamespaceID: r.NamespaceID,
+			Name:        r.Name,
+			Handle:      r.Handle,
+			Fields:      r.Fields,
+			Meta:        r.Meta,
+			UpdatedAt:   r.UpdatedAt,
+		}
+	)
+
+	return ctrl.module.With(ctx).UpdateDryRun(mod)
+}
+
 func (ctrl *Module) Delete(ctx context.Context, r *request.ModuleDelete) (interface{}, error) {
 	_, err := ctrl.module.With(ctx).FindByID(r.NamespaceID, r.ModuleID)
 	if err != nil {
@@ -149,12 +174,17 @@ func (ctrl Module) makePayload(ctx context.Context, m *types.Module, err error)
 
 		CanGrant: ctrl.ac.CanGrant(ctx),
 
-		CanUpdateModule: ctrl.ac.CanUpdateModule(ctx, m),
-		CanDeleteModule: ctrl.ac.CanDeleteModule(ctx, m),
-		CanCreateRecord: ctrl.ac.CanCreateRecord(ctx, m),
-		CanReadRecord:   ctrl.ac.CanReadRecord(ctx, m),
-		CanUpdateRecord: ctrl.ac.CanUpdateRecord(ctx, m),
-		CanDeleteRecord: ctrl.ac.CanDeleteRecord(ctx, m),
+		CanUpdateModule:   ctrl.ac.CanUpdateModule(ctx, m),
+		CanDeleteModule:   ctrl.ac.CanDeleteModule(ctx, m),
+		CanCreateRecord:   ctrl.ac.CanCreateRecord(ctx, m),
+		CanReadRecord:     ctrl.ac.CanReadRecord(ctx, m),
+		CanUpdateRecord:   ctrl.ac.CanUpdateRecord(ctx, m),
+		CanDeleteRecord:   ctrl.ac.CanDeleteRecord(ctx, m),
+		CanUndeleteRecord: ctrl.ac.CanUndeleteRecord(ctx, m),
+
+		CanReadOwnRecord:   ctrl.ac.CanReadOwnRecord(ctx, m),
+		CanUpdateOwnRecord: ctrl.ac.CanUpdateOwnRecord(ctx, m),
+		CanDeleteOwnRecord: ctrl.ac.CanDeleteOwnRecord(ctx, m),
 
 		CanManageAutomationTriggers: ctrl.ac.CanManageAutomationTriggersOnModule(ctx, m),
 	}, nil
@@ -6,13 +6,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"path/filepath"
 	"strings"
 
 	"github.com/titpetric/factory/resputil"
 
 	"github.com/pkg/errors"
 
-	"github.com/cortezaproject/corteza-server/compose/decoder"
 	"github.com/cortezaproject/corteza-server/compose/encoder"
 	"github.com/cortezaproject/corteza-server/compose/repository"
 	"github.com/cortezaproject/corteza-server/compose/rest/request"
@@ -37,6 +37,16 @@ type (
 		Set    []*recordPayload   `json:"set"`
 	}
 
+	recordBulkPayload struct {
+		Failed bool                      `json:"failed"`
+		Set    types.RecordBulkResultSet `json:"set"`
+	}
+
+	recordRevisionSetPayload struct {
+		Filter types.RecordRevisionFilter `json:"filter"`
+		Set    types.RecordRevisionSet    `json:"set"`
+	}
+
 	Record struct {
 		importSession service.ImportSessionService
 		record        service.RecordService
@@ -46,8 +56,8 @@ type (
 	}
 
 	recordAccessController interface {
-		CanUpdateRecord(context.Context, *types.Module) bool
-		CanDeleteRecord(context.Context, *types.Module) bool
+		CanUpdateRecordInstance(context.Context, *types.Module, *types.Record) bool
+		CanDeleteRecordInstance(context.Context, *types.Module, *types.Record) bool
 	}
 )
 
@@ -62,7 +72,7 @@ func (Record) New() *Record {
 }
 
 func (ctrl *Record) Report(ctx context.Context, r *request.RecordReport) (interface{}, error) {
-	return ctrl.record.With(ctx).Report(r.NamespaceID, r.ModuleID, r.Metrics, r.Dimensions, r.Filter)
+	return ctrl.record.With(ctx).Report(r.NamespaceID, r.ModuleID, r.Metrics, r.Dimensions, r.Filter, r.Limit)
 }
 
 func (ctrl *Record) List(ctx context.Context, r *request.RecordList) (interface{}, error) {
@@ -75,14 +85,22 @@ func (ctrl *Record) List(ctx context.Context, r *request.RecordList) (interface{
 		return nil, err
 	}
 
-	rr, filter, err := ctrl.record.With(ctx).Find(types.RecordFilter{
+	f := types.RecordFilter{
 		NamespaceID: r.NamespaceID,
 		ModuleID:    r.ModuleID,
 		Filter:      r.Filter,
 		Sort:        r.Sort,
+		Deleted:     rh.FilterState(r.Deleted),
 
 		PageFilter: rh.Paging(r.Page, r.PerPage),
-	})
+	}
+
+	f.SkipCount = r.SkipCount
+	if f.PageCursor, err = rh.ParsePagingCursor(r.PageCursor); err != nil {
+		return nil, err
+	}
+
+	rr, filter, err := ctrl.record.With(ctx).Find(f)
 
 	return ctrl.makeFilterPayload(ctx, m, rr, filter, err)
 }
@@ -123,6 +141,10 @@ func (ctrl *Record) Create(ctx context.Context, r *request.RecordCreate) (interf
 		Values:      r.Values,
 	})
 
+	if rves, ok := err.(*types.RecordValueErrorSet); ok {
+		return ctrl.handleValidationError(rves), nil
+	}
+
 	return ctrl.makePayload(ctx, m, record, err)
 }
 
@@ -143,6 +165,10 @@ func (ctrl *Record) Update(ctx context.Context, r *request.RecordUpdate) (interf
 		Values:      r.Values,
 	})
 
+	if rves, ok := err.(*types.RecordValueErrorSet); ok {
+		return ctrl.handleValidationError(rves), nil
+	}
+
 	return ctrl.makePayload(ctx, m, record, err)
 }
 
@@ -150,6 +176,87 @@ func (ctrl *Record) Delete(ctx context.Context, r *request.RecordDelete) (interf
 	return resputil.OK(), ctrl.record.With(ctx).DeleteByID(r.NamespaceID, r.RecordID)
 }
 
+func (ctrl *Record) Upsert(ctx context.Context, r *request.RecordUpsert) (interface{}, error) {
+	var (
+		m   *types.Module
+		err error
+	)
+
+	if m, err = ctrl.module.With(ctx).FindByID(r.NamespaceID, r.ModuleID); err != nil {
+		return nil, err
+	}
+
+	record, err := ctrl.record.With(ctx).Upsert(&types.Record{
+		NamespaceID: r.NamespaceID,
+		ModuleID:    r.ModuleID,
+		Values:      r.Values,
+	}, r.Keys...)
+
+	if rves, ok := err.(*types.RecordValueErrorSet); ok {
+		return ctrl.handleValidationError(rves), nil
+	}
+
+	return ctrl.makePayload(ctx, m, record, err)
+}
+
+func (ctrl *Record) Bulk(ctx context.Context, r *request.RecordBulk) (interface{}, error) {
+	rr, err := ctrl.record.With(ctx).Bulk(r.NamespaceID, r.ModuleID, r.OnError, r.Records)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recordBulkPayload{Failed: rr.Failed(), Set: rr}, nil
+}
+
+func (ctrl *Record) Undelete(ctx context.Context, r *request.RecordUndelete) (interface{}, error) {
+	err := ctrl.record.With(ctx).UndeleteByID(r.NamespaceID, r.RecordID)
+
+	if rves, ok := err.(*types.RecordValueErrorSet); ok {
+		return ctrl.handleValidationError(rves), nil
+	}
+
+	return resputil.OK(), err
+}
+
+func (ctrl *Record) Revisions(ctx context.Context, r *request.RecordRevisions) (interface{}, error) {
+	set, filter, err := ctrl.record.With(ctx).Revisions(types.RecordRevisionFilter{
+		NamespaceID: r.NamespaceID,
+		ModuleID:    r.ModuleID,
+		RecordID:    r.RecordID,
+
+		PageFilter: rh.Paging(r.Page, r.PerPage),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &recordRevisionSetPayload{Filter: filter, Set: set}, nil
+}
+
+func (ctrl *Record) RestoreRevision(ctx context.Context, r *request.RecordRestoreRevision) (interface{}, error) {
+	var (
+		m   *types.Module
+		err error
+	)
+
+	if m, err = ctrl.module.With(ctx).FindByID(r.NamespaceID, r.ModuleID); err != nil {
+		return nil, err
+	}
+
+	record, err := ctrl.record.With(ctx).RestoreRevision(r.NamespaceID, r.RecordID, r.Revision)
+
+	if rves, ok := err.(*types.RecordValueErrorSet); ok {
+		return ctrl.handleValidationError(rves), nil
+	}
+
+	return ctrl.makePayload(ctx, m, record, err)
+}
+
+func (ctrl *Record) References(ctx context.Context, r *request.RecordReferences) (interface{}, error) {
+	return ctrl.record.With(ctx).References(r.NamespaceID, r.RecordID)
+}
+
 func (ctrl *Record) Upload(ctx context.Context, r *request.RecordUpload) (interface{}, error) {
 	file, err := r.Upload.Open()
 	if err != nil {
@@ -173,9 +280,7 @@ func (ctrl *Record) Upload(ctx context.Context, r *request.RecordUpload) (interf
 
 func (ctrl *Record) ImportInit(ctx context.Context, r *request.RecordImportInit) (interface{}, error) {
 	var (
-		err           error
-		recordDecoder service.Decoder
-		entryCount    uint64
+		err error
 	)
 
 	// Access control.
@@ -202,42 +307,30 @@ func (ctrl *Record) ImportInit(ctx context.Context, r *request.RecordImportInit)
 		}
 	}
 
-	// determine decoder
-	switch strings.ToLower(ext) {
-	case "json", "jsonl", "ldjson", "ndjson":
-		recordDecoder = decoder.NewStructuredDecoder(json.NewDecoder(f), f)
-
-	case "csv":
-		recordDecoder = decoder.NewFlatReader(csv.NewReader(f), f)
-
-	default:
-		return nil, service.ErrRecordImportFormatNotSupported
-
-	}
-	entryCount, err = recordDecoder.EntryCount()
-	if err != nil {
-		return nil, err
+	if ext == "zip" {
+		// Spreadsheets that could not be detected by their content
+		switch e := strings.ToLower(strings.TrimPrefix(filepath.Ext(r.Upload.Filename), ".")); e {
+		case "xlsx", "ods":
+			ext = e
+		}
 	}
 
-	header := recordDecoder.Header()
-	hh := make(map[string]string)
-	for _, h := range header {
-		hh[h] = ""
+	ses := &types.RecordImportSession{
+		NamespaceID: r.NamespaceID,
+		ModuleID:    r.ModuleID,
+		Name:        r.Upload.Filename,
+		Format:      ext,
+		Sheet:       r.Sheet,
+		HeaderRow:   r.HeaderRow,
 	}
 
-	return ctrl.importSession.SetRecordByID(
-		ctx,
-		0,
-		r.NamespaceID,
-		r.ModuleID,
-		hh,
-		&service.RecordImportProgress{EntryCount: entryCount},
-		recordDecoder)
+	return ctrl.importSession.CreateRecord(ctx, ses, f)
 }
 
 func (ctrl *Record) ImportRun(ctx context.Context, r *request.RecordImportRun) (interface{}, error) {
 	var (
-		err error
+		err    error
+		fields = make(map[string]string)
 	)
 
 	// Access control.
@@ -245,95 +338,93 @@ func (ctrl *Record) ImportRun(ctx context.Context, r *request.RecordImportRun) (
 		return nil, err
 	}
 
-	// Check if session ok
-	ses, err := ctrl.importSession.FindRecordByID(ctx, r.SessionID)
-	if err != nil {
+	if err = json.Unmarshal(r.Fields, &fields); err != nil {
 		return nil, err
 	}
 
-	if ses.Progress.StartedAt != nil {
-		return nil, service.ErrRecordImportSessionAlreadyStarted
-	}
+	return ctrl.importSession.RunRecord(ctx, r.SessionID, fields, r.OnError, r.UpsertKeys)
+}
+
+func (ctrl *Record) ImportProgress(ctx context.Context, r *request.RecordImportProgress) (interface{}, error) {
+	return ctrl.importSession.FindRecordByID(ctx, r.SessionID)
+}
 
-	ses.Fields = make(map[string]string)
-	err = json.Unmarshal(r.Fields, &ses.Fields)
+func (ctrl *Record) ImportCancel(ctx context.Context, r *request.RecordImportCancel) (interface{}, error) {
+	return ctrl.importSession.CancelRecord(ctx, r.SessionID)
+}
+
+// ImportErrors writes failed entries (one row per value error) as CSV
+func (ctrl *Record) ImportErrors(ctx context.Context, r *request.RecordImportErrors) (interface{}, error) {
+	ff, err := ctrl.importSession.FindRecordFailures(ctx, r.SessionID)
 	if err != nil {
 		return nil, err
 	}
 
-	ses.OnError = r.OnError
+	return func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Add("Content-Type", "text/csv")
+		w.Header().Add("Content-Disposition", fmt.Sprintf("attachment; filename=import-%d-errors.csv", r.SessionID))
 
-	// @todo routine
-	ctrl.record.With(ctx).Import(ses, ctrl.importSession)
+		cw := csv.NewWriter(w)
+		_ = cw.Write([]string{"entry", "field", "kind", "message"})
 
-	return ses, nil
-}
+		_ = ff.Walk(func(f *types.RecordImportFailure) error {
+			entry := fmt.Sprintf("%d", f.Entry)
 
-func (ctrl *Record) ImportProgress(ctx context.Context, r *request.RecordImportProgress) (interface{}, error) {
-	// Get session
-	ses, err := ctrl.importSession.FindRecordByID(ctx, r.SessionID)
-	if err != nil {
-		return nil, err
-	}
+			if len(f.Errors) == 0 {
+				return cw.Write([]string{entry, "", "", f.Reason})
+			}
 
-	return ses, nil
+			for _, e := range f.Errors {
+				if err := cw.Write([]string{entry, e.Field, e.Kind, e.Message}); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+
+		cw.Flush()
+	}, nil
 }
 
 func (ctrl *Record) Export(ctx context.Context, r *request.RecordExport) (interface{}, error) {
-	type (
-		// ad-hoc interface for our encoder
-		Encoder interface {
-			service.Encoder
-			Flush()
-		}
-	)
-
 	var (
+		m   *types.Module
 		err error
 
-		// Record encoder
-		recordEncoder Encoder
-
 		filename = fmt.Sprintf("; filename=%s.%s", r.Filename, r.Ext)
 
 		f = types.RecordFilter{
 			NamespaceID: r.NamespaceID,
 			ModuleID:    r.ModuleID,
 			Filter:      r.Filter,
+			Sort:        r.Sort,
+			Deleted:     rh.FilterState(r.Deleted),
 		}
 
-		contentType string
+		opt = encoder.Options{
+			Delimiter: r.MultiValueDelimiter,
+			Labels:    r.Labels,
+		}
 	)
+
 	// Access control.
-	if _, err = ctrl.module.With(ctx).FindByID(r.NamespaceID, r.ModuleID); err != nil {
+	if m, err = ctrl.module.With(ctx).FindByID(r.NamespaceID, r.ModuleID); err != nil {
 		return nil, err
 	}
 
-	if len(r.Fields) == 1 {
-		r.Fields = strings.Split(r.Fields[0], ",")
+	if r.Fields, err = exportFields(m, r.Fields); err != nil {
+		return nil, err
 	}
 
-	return func(w http.ResponseWriter, req *http.Request) {
-		ff := encoder.MakeFields(r.Fields...)
-
-		if len(ff) == 0 {
-			http.Error(w, "no record value fields provided", http.StatusBadRequest)
-		}
-
-		switch strings.ToLower(r.Ext) {
-		case "json", "jsonl", "ldjson", "ndjson":
-			contentType = "application/jsonl"
-			recordEncoder = encoder.NewStructuredEncoder(json.NewEncoder(w), ff...)
-
-		case "csv":
-			contentType = "text/csv"
-			recordEncoder = encoder.NewFlatWriter(csv.NewWriter(w), true, ff...)
-
-		case "xlsx":
-			contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
-			recordEncoder = encoder.NewExcelizeEncoder(w, true, ff...)
+	var ok bool
+	if opt.MultiValue, ok = encoder.ParseMultiValue(r.MultiValue); !ok {
+		return nil, errors.Errorf("unsupported multi-value encoding %q", r.MultiValue)
+	}
 
-		default:
+	return func(w http.ResponseWriter, req *http.Request) {
+		recordEncoder, contentType, err := service.RecordEncoder(r.Ext, w, opt, r.Fields...)
+		if err != nil {
 			http.Error(w, "unsupported format ("+r.Ext+")", http.StatusBadRequest)
 			return
 		}
@@ -341,6 +432,8 @@ func (ctrl *Record) Export(ctx context.Context, r *request.RecordExport) (interf
 		w.Header().Add("Content-Type", contentType)
 		w.Header().Add("Content-Disposition", "attachment"+filename)
 
+		// Records are streamed; when export fails after the first
+		// records were written, response is left incomplete
 		if err = ctrl.record.With(ctx).Export(f, recordEncoder); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
@@ -350,6 +443,27 @@ func (ctrl *Record) Export(ctx context.Context, r *request.RecordExport) (interf
 	}, nil
 }
 
+// exportFields returns names of the exported fields; recordID and all module fields when none are given
+//
+// Fields can be given as one comma separated value
+func exportFields(m *types.Module, ff []string) ([]string, error) {
+	if len(ff) == 1 {
+		ff = strings.Split(ff[0], ",")
+	}
+
+	if len(ff) == 0 {
+		ff = append([]string{"recordID"}, m.Fields.Names()...)
+	}
+
+	for _, name := range ff {
+		if !encoder.IsSystemField(name) && !m.Fields.HasName(name) {
+			return nil, errors.Errorf("unknown field %q", name)
+		}
+	}
+
+	return ff, nil
+}
+
 func (ctrl Record) Exec(ctx context.Context, r *request.RecordExec) (interface{}, error) {
 	aa := request.ProcedureArgs(r.Args)
 
@@ -380,11 +494,31 @@ func (ctrl Record) makePayload(ctx context.Context, m *types.Module, r *types.Re
 	return &recordPayload{
 		Record: r,
 
-		CanUpdateRecord: ctrl.ac.CanUpdateRecord(ctx, m),
-		CanDeleteRecord: ctrl.ac.CanDeleteRecord(ctx, m),
+		CanUpdateRecord: ctrl.ac.CanUpdateRecordInstance(ctx, m, r),
+		CanDeleteRecord: ctrl.ac.CanDeleteRecordInstance(ctx, m, r),
 	}, nil
 }
 
+// handleValidationError writes error response with details about each invalid value
+//
+// Response is compatible with regular error responses (error.message)
+func (ctrl Record) handleValidationError(rves *types.RecordValueErrorSet) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		var payload = struct {
+			Error struct {
+				Message string                   `json:"message"`
+				Details []types.RecordValueError `json:"details"`
+			} `json:"error"`
+		}{}
+
+		payload.Error.Message = rves.Error()
+		payload.Error.Details = rves.Set
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(payload)
+	}
+}
+
 func (ctrl Record) makeFilterPayload(ctx context.Context, m *types.Module, rr types.RecordSet, f types.RecordFilter, err error) (*recordSetPayload, error) {
 	if err != nil {
 		return nil, err
@@ -114,6 +114,7 @@ type AutomationTriggerCreate struct {
 	Resource    string
 	Event       string
 	Condition   string
+	Expression  string
 	Enabled     bool
 	NamespaceID uint64 `json:",string"`
 	ScriptID    uint64 `json:",string"`
@@ -129,6 +130,7 @@ func (r AutomationTriggerCreate) Auditable() map[string]interface{} {
 	out["resource"] = r.Resource
 	out["event"] = r.Event
 	out["condition"] = r.Condition
+	out["expression"] = r.Expression
 	out["enabled"] = r.Enabled
 	out["namespaceID"] = r.NamespaceID
 	out["scriptID"] = r.ScriptID
@@ -172,6 +174,9 @@ func (r *AutomationTriggerCreate) Fill(req *http.Request) (err error) {
 	if val, ok := post["condition"]; ok {
 		r.Condition = val
 	}
+	if val, ok := post["expression"]; ok {
+		r.Expression = val
+	}
 	if val, ok := post["enabled"]; ok {
 		r.Enabled = parseBool(val)
 	}
@@ -248,6 +253,7 @@ type AutomationTriggerUpdate struct {
 	Resource    string
 	Event       string
 	Condition   string
+	Expression  string
 	Enabled     bool
 }
 
@@ -264,6 +270,7 @@ func (r AutomationTriggerUpdate) Auditable() map[string]interface{} {
 	out["resource"] = r.Resource
 	out["event"] = r.Event
 	out["condition"] = r.Condition
+	out["expression"] = r.Expression
 	out["enabled"] = r.Enabled
 
 	return out
@@ -308,6 +315,9 @@ func (r *AutomationTriggerUpdate) Fill(req *http.Request) (err error) {
 	if val, ok := post["condition"]; ok {
 		r.Condition = val
 	}
+	if val, ok := post["expression"]; ok {
+		r.Expression = val
+	}
 	if val, ok := post["enabled"]; ok {
 		r.Enabled = parseBool(val)
 	}
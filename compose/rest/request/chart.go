@@ -365,3 +365,57 @@ func (r *ChartDelete) Fill(req *http.Request) (err error) {
 }
 
 var _ RequestFiller = NewChartDelete()
+
+// Chart report request parameters
+type ChartReport struct {
+	ChartID     uint64 `json:",string"`
+	NamespaceID uint64 `json:",string"`
+}
+
+func NewChartReport() *ChartReport {
+	return &ChartReport{}
+}
+
+func (r ChartReport) Auditable() map[string]interface{} {
+	var out = map[string]interface{}{}
+
+	out["chartID"] = r.ChartID
+	out["namespaceID"] = r.NamespaceID
+
+	return out
+}
+
+func (r *ChartReport) Fill(req *http.Request) (err error) {
+	if strings.ToLower(req.Header.Get("content-type")) == "application/json" {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return errors.Wrap(err, "error parsing http request body")
+		}
+	}
+
+	if err = req.ParseForm(); err != nil {
+		return err
+	}
+
+	get := map[string]string{}
+	post := map[string]string{}
+	urlQuery := req.URL.Query()
+	for name, param := range urlQuery {
+		get[name] = string(param[0])
+	}
+	postVars := req.Form
+	for name, param := range postVars {
+		post[name] = string(param[0])
+	}
+
+	r.ChartID = parseUInt64(chi.URLParam(req, "chartID"))
+	r.NamespaceID = parseUInt64(chi.URLParam(req, "namespaceID"))
+
+	return err
+}
+
+var _ RequestFiller = NewChartReport()
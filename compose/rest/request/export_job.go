@@ -0,0 +1,313 @@
+package request
+
+/*
+	Hello! This file is auto-generated from `docs/src/spec.json`.
+
+	For development:
+	In order to update the generated files, edit this file under the location,
+	add your struct fields, imports, API definitions and whatever you want, and:
+
+	1. run [spec](https://github.com/titpetric/spec) in the same folder,
+	2. run `./_gen.php` in this folder.
+
+	You may edit `export_job.go`, `export_job.util.go` or `export_job_test.go` to
+	implement your API calls, helper functions and tests. The file `export_job.go`
+	is only generated the first time, and will not be overwritten if it exists.
+*/
+
+import (
+	"io"
+	"strings"
+
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/pkg/errors"
+)
+
+var _ = chi.URLParam
+var _ = multipart.FileHeader{}
+
+// ExportJob create request parameters
+type ExportJobCreate struct {
+	Format              string
+	Filename            string
+	Filter              string
+	Sort                string
+	Deleted             uint
+	Fields              []string
+	MultiValue          string
+	MultiValueDelimiter string
+	Labels              bool
+	NamespaceID         uint64 `json:",string"`
+	ModuleID            uint64 `json:",string"`
+}
+
+func NewExportJobCreate() *ExportJobCreate {
+	return &ExportJobCreate{}
+}
+
+func (r ExportJobCreate) Auditable() map[string]interface{} {
+	var out = map[string]interface{}{}
+
+	out["format"] = r.Format
+	out["filename"] = r.Filename
+	out["filter"] = r.Filter
+	out["sort"] = r.Sort
+	out["deleted"] = r.Deleted
+	out["fields"] = r.Fields
+	out["multiValue"] = r.MultiValue
+	out["multiValueDelimiter"] = r.MultiValueDelimiter
+	out["labels"] = r.Labels
+	out["namespaceID"] = r.NamespaceID
+	out["moduleID"] = r.ModuleID
+
+	return out
+}
+
+func (r *ExportJobCreate) Fill(req *http.Request) (err error) {
+	if strings.ToLower(req.Header.Get("content-type")) == "application/json" {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return errors.Wrap(err, "error parsing http request body")
+		}
+	}
+
+	if err = req.ParseForm(); err != nil {
+		return err
+	}
+
+	get := map[string]string{}
+	post := map[string]string{}
+	urlQuery := req.URL.Query()
+	for name, param := range urlQuery {
+		get[name] = string(param[0])
+	}
+	postVars := req.Form
+	for name, param := range postVars {
+		post[name] = string(param[0])
+	}
+
+	if val, ok := post["format"]; ok {
+		r.Format = val
+	}
+	if val, ok := post["filename"]; ok {
+		r.Filename = val
+	}
+	if val, ok := post["filter"]; ok {
+		r.Filter = val
+	}
+	if val, ok := post["sort"]; ok {
+		r.Sort = val
+	}
+	if val, ok := post["deleted"]; ok {
+		r.Deleted = parseUint(val)
+	}
+
+	if val, ok := req.Form["fields"]; ok {
+		r.Fields = parseStrings(val)
+	}
+
+	if val, ok := post["multiValue"]; ok {
+		r.MultiValue = val
+	}
+	if val, ok := post["multiValueDelimiter"]; ok {
+		r.MultiValueDelimiter = val
+	}
+	if val, ok := post["labels"]; ok {
+		r.Labels = parseBool(val)
+	}
+	r.NamespaceID = parseUInt64(chi.URLParam(req, "namespaceID"))
+	r.ModuleID = parseUInt64(chi.URLParam(req, "moduleID"))
+
+	return err
+}
+
+var _ RequestFiller = NewExportJobCreate()
+
+// ExportJob read request parameters
+type ExportJobRead struct {
+	JobID       uint64 `json:",string"`
+	NamespaceID uint64 `json:",string"`
+	ModuleID    uint64 `json:",string"`
+}
+
+func NewExportJobRead() *ExportJobRead {
+	return &ExportJobRead{}
+}
+
+func (r ExportJobRead) Auditable() map[string]interface{} {
+	var out = map[string]interface{}{}
+
+	out["jobID"] = r.JobID
+	out["namespaceID"] = r.NamespaceID
+	out["moduleID"] = r.ModuleID
+
+	return out
+}
+
+func (r *ExportJobRead) Fill(req *http.Request) (err error) {
+	if strings.ToLower(req.Header.Get("content-type")) == "application/json" {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return errors.Wrap(err, "error parsing http request body")
+		}
+	}
+
+	if err = req.ParseForm(); err != nil {
+		return err
+	}
+
+	get := map[string]string{}
+	post := map[string]string{}
+	urlQuery := req.URL.Query()
+	for name, param := range urlQuery {
+		get[name] = string(param[0])
+	}
+	postVars := req.Form
+	for name, param := range postVars {
+		post[name] = string(param[0])
+	}
+
+	r.JobID = parseUInt64(chi.URLParam(req, "jobID"))
+	r.NamespaceID = parseUInt64(chi.URLParam(req, "namespaceID"))
+	r.ModuleID = parseUInt64(chi.URLParam(req, "moduleID"))
+
+	return err
+}
+
+var _ RequestFiller = NewExportJobRead()
+
+// ExportJob delete request parameters
+type ExportJobDelete struct {
+	JobID       uint64 `json:",string"`
+	NamespaceID uint64 `json:",string"`
+	ModuleID    uint64 `json:",string"`
+}
+
+func NewExportJobDelete() *ExportJobDelete {
+	return &ExportJobDelete{}
+}
+
+func (r ExportJobDelete) Auditable() map[string]interface{} {
+	var out = map[string]interface{}{}
+
+	out["jobID"] = r.JobID
+	out["namespaceID"] = r.NamespaceID
+	out["moduleID"] = r.ModuleID
+
+	return out
+}
+
+func (r *ExportJobDelete) Fill(req *http.Request) (err error) {
+	if strings.ToLower(req.Header.Get("content-type")) == "application/json" {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return errors.Wrap(err, "error parsing http request body")
+		}
+	}
+
+	if err = req.ParseForm(); err != nil {
+		return err
+	}
+
+	get := map[string]string{}
+	post := map[string]string{}
+	urlQuery := req.URL.Query()
+	for name, param := range urlQuery {
+		get[name] = string(param[0])
+	}
+	postVars := req.Form
+	for name, param := range postVars {
+		post[name] = string(param[0])
+	}
+
+	r.JobID = parseUInt64(chi.URLParam(req, "jobID"))
+	r.NamespaceID = parseUInt64(chi.URLParam(req, "namespaceID"))
+	r.ModuleID = parseUInt64(chi.URLParam(req, "moduleID"))
+
+	return err
+}
+
+var _ RequestFiller = NewExportJobDelete()
+
+// ExportJob download request parameters
+type ExportJobDownload struct {
+	Sign        string
+	UserID      uint64 `json:",string"`
+	JobID       uint64 `json:",string"`
+	NamespaceID uint64 `json:",string"`
+	ModuleID    uint64 `json:",string"`
+}
+
+func NewExportJobDownload() *ExportJobDownload {
+	return &ExportJobDownload{}
+}
+
+func (r ExportJobDownload) Auditable() map[string]interface{} {
+	var out = map[string]interface{}{}
+
+	out["sign"] = r.Sign
+	out["userID"] = r.UserID
+	out["jobID"] = r.JobID
+	out["namespaceID"] = r.NamespaceID
+	out["moduleID"] = r.ModuleID
+
+	return out
+}
+
+func (r *ExportJobDownload) Fill(req *http.Request) (err error) {
+	if strings.ToLower(req.Header.Get("content-type")) == "application/json" {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return errors.Wrap(err, "error parsing http request body")
+		}
+	}
+
+	if err = req.ParseForm(); err != nil {
+		return err
+	}
+
+	get := map[string]string{}
+	post := map[string]string{}
+	urlQuery := req.URL.Query()
+	for name, param := range urlQuery {
+		get[name] = string(param[0])
+	}
+	postVars := req.Form
+	for name, param := range postVars {
+		post[name] = string(param[0])
+	}
+
+	if val, ok := get["sign"]; ok {
+		r.Sign = val
+	}
+	if val, ok := get["userID"]; ok {
+		r.UserID = parseUInt64(val)
+	}
+	r.JobID = parseUInt64(chi.URLParam(req, "jobID"))
+	r.NamespaceID = parseUInt64(chi.URLParam(req, "namespaceID"))
+	r.ModuleID = parseUInt64(chi.URLParam(req, "moduleID"))
+
+	return err
+}
+
+var _ RequestFiller = NewExportJobDownload()
@@ -322,6 +322,88 @@ func (r *ModuleUpdate) Fill(req *http.Request) (err error) {
 
 var _ RequestFiller = NewModuleUpdate()
 
+// Module updateDryRun request parameters
+type ModuleUpdateDryRun struct {
+	ModuleID    uint64 `json:",string"`
+	NamespaceID uint64 `json:",string"`
+	Name        string
+	Handle      string
+	Fields      types.ModuleFieldSet
+	Meta        sqlxTypes.JSONText
+	UpdatedAt   *time.Time
+}
+
+func NewModuleUpdateDryRun() *ModuleUpdateDryRun {
+	return &ModuleUpdateDryRun{}
+}
+
+func (r ModuleUpdateDryRun) Auditable() map[string]interface{} {
+	var out = map[string]interface{}{}
+
+	out["moduleID"] = r.ModuleID
+	out["namespaceID"] = r.NamespaceID
+	out["name"] = r.Name
+	out["handle"] = r.Handle
+	out["fields"] = r.Fields
+	out["meta"] = r.Meta
+	out["updatedAt"] = r.UpdatedAt
+
+	return out
+}
+
+func (r *ModuleUpdateDryRun) Fill(req *http.Request) (err error) {
+	if strings.ToLower(req.Header.Get("content-type")) == "application/json" {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return errors.Wrap(err, "error parsing http request body")
+		}
+	}
+
+	if err = req.ParseForm(); err != nil {
+		return err
+	}
+
+	get := map[string]string{}
+	post := map[string]string{}
+	urlQuery := req.URL.Query()
+	for name, param := range urlQuery {
+		get[name] = string(param[0])
+	}
+	postVars := req.Form
+	for name, param := range postVars {
+		post[name] = string(param[0])
+	}
+
+	r.ModuleID = parseUInt64(chi.URLParam(req, "moduleID"))
+	r.NamespaceID = parseUInt64(chi.URLParam(req, "namespaceID"))
+	if val, ok := post["name"]; ok {
+		r.Name = val
+	}
+	if val, ok := post["handle"]; ok {
+		r.Handle = val
+	}
+	if val, ok := post["meta"]; ok {
+
+		if r.Meta, err = parseJSONTextWithErr(val); err != nil {
+			return err
+		}
+	}
+	if val, ok := post["updatedAt"]; ok {
+
+		if r.UpdatedAt, err = parseISODatePtrWithErr(val); err != nil {
+			return err
+		}
+	}
+
+	return err
+}
+
+var _ RequestFiller = NewModuleUpdateDryRun()
+
 // Module delete request parameters
 type ModuleDelete struct {
 	ModuleID    uint64 `json:",string"`
@@ -37,6 +37,7 @@ type RecordReport struct {
 	Metrics     string
 	Dimensions  string
 	Filter      string
+	Limit       uint
 	NamespaceID uint64 `json:",string"`
 	ModuleID    uint64 `json:",string"`
 }
@@ -51,6 +52,7 @@ func (r RecordReport) Auditable() map[string]interface{} {
 	out["metrics"] = r.Metrics
 	out["dimensions"] = r.Dimensions
 	out["filter"] = r.Filter
+	out["limit"] = r.Limit
 	out["namespaceID"] = r.NamespaceID
 	out["moduleID"] = r.ModuleID
 
@@ -93,6 +95,9 @@ func (r *RecordReport) Fill(req *http.Request) (err error) {
 	if val, ok := get["filter"]; ok {
 		r.Filter = val
 	}
+	if val, ok := get["limit"]; ok {
+		r.Limit = parseUint(val)
+	}
 	r.NamespaceID = parseUInt64(chi.URLParam(req, "namespaceID"))
 	r.ModuleID = parseUInt64(chi.URLParam(req, "moduleID"))
 
@@ -107,6 +112,9 @@ type RecordList struct {
 	Page        uint
 	PerPage     uint
 	Sort        string
+	Deleted     uint
+	PageCursor  string
+	SkipCount   bool
 	NamespaceID uint64 `json:",string"`
 	ModuleID    uint64 `json:",string"`
 }
@@ -122,6 +130,9 @@ func (r RecordList) Auditable() map[string]interface{} {
 	out["page"] = r.Page
 	out["perPage"] = r.PerPage
 	out["sort"] = r.Sort
+	out["deleted"] = r.Deleted
+	out["pageCursor"] = r.PageCursor
+	out["skipCount"] = r.SkipCount
 	out["namespaceID"] = r.NamespaceID
 	out["moduleID"] = r.ModuleID
 
@@ -167,6 +178,15 @@ func (r *RecordList) Fill(req *http.Request) (err error) {
 	if val, ok := get["sort"]; ok {
 		r.Sort = val
 	}
+	if val, ok := get["deleted"]; ok {
+		r.Deleted = parseUint(val)
+	}
+	if val, ok := get["pageCursor"]; ok {
+		r.PageCursor = val
+	}
+	if val, ok := get["skipCount"]; ok {
+		r.SkipCount = parseBool(val)
+	}
 	r.NamespaceID = parseUInt64(chi.URLParam(req, "namespaceID"))
 	r.ModuleID = parseUInt64(chi.URLParam(req, "moduleID"))
 
@@ -178,6 +198,8 @@ var _ RequestFiller = NewRecordList()
 // Record importInit request parameters
 type RecordImportInit struct {
 	Upload      *multipart.FileHeader
+	Sheet       string
+	HeaderRow   uint
 	NamespaceID uint64 `json:",string"`
 	ModuleID    uint64 `json:",string"`
 }
@@ -192,6 +214,8 @@ func (r RecordImportInit) Auditable() map[string]interface{} {
 	out["upload.size"] = r.Upload.Size
 	out["upload.filename"] = r.Upload.Filename
 
+	out["sheet"] = r.Sheet
+	out["headerRow"] = r.HeaderRow
 	out["namespaceID"] = r.NamespaceID
 	out["moduleID"] = r.ModuleID
 
@@ -229,6 +253,12 @@ func (r *RecordImportInit) Fill(req *http.Request) (err error) {
 		return errors.Wrap(err, "error procesing uploaded file")
 	}
 
+	if val, ok := post["sheet"]; ok {
+		r.Sheet = val
+	}
+	if val, ok := post["headerRow"]; ok {
+		r.HeaderRow = parseUint(val)
+	}
 	r.NamespaceID = parseUInt64(chi.URLParam(req, "namespaceID"))
 	r.ModuleID = parseUInt64(chi.URLParam(req, "moduleID"))
 
@@ -244,6 +274,7 @@ type RecordImportRun struct {
 	ModuleID    uint64 `json:",string"`
 	Fields      json.RawMessage
 	OnError     string
+	UpsertKeys  []string
 }
 
 func NewRecordImportRun() *RecordImportRun {
@@ -258,6 +289,7 @@ func (r RecordImportRun) Auditable() map[string]interface{} {
 	out["moduleID"] = r.ModuleID
 	out["fields"] = r.Fields
 	out["onError"] = r.OnError
+	out["upsertKeys"] = r.UpsertKeys
 
 	return out
 }
@@ -299,6 +331,10 @@ func (r *RecordImportRun) Fill(req *http.Request) (err error) {
 		r.OnError = val
 	}
 
+	if val, ok := req.Form["upsertKeys"]; ok {
+		r.UpsertKeys = parseStrings(val)
+	}
+
 	return err
 }
 
@@ -361,16 +397,135 @@ func (r *RecordImportProgress) Fill(req *http.Request) (err error) {
 
 var _ RequestFiller = NewRecordImportProgress()
 
-// Record export request parameters
-type RecordExport struct {
-	Filter      string
-	Fields      []string
-	Filename    string
-	Ext         string
+// Record importCancel request parameters
+type RecordImportCancel struct {
+	SessionID   uint64 `json:",string"`
+	NamespaceID uint64 `json:",string"`
+	ModuleID    uint64 `json:",string"`
+}
+
+func NewRecordImportCancel() *RecordImportCancel {
+	return &RecordImportCancel{}
+}
+
+func (r RecordImportCancel) Auditable() map[string]interface{} {
+	var out = map[string]interface{}{}
+
+	out["sessionID"] = r.SessionID
+	out["namespaceID"] = r.NamespaceID
+	out["moduleID"] = r.ModuleID
+
+	return out
+}
+
+func (r *RecordImportCancel) Fill(req *http.Request) (err error) {
+	if strings.ToLower(req.Header.Get("content-type")) == "application/json" {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return errors.Wrap(err, "error parsing http request body")
+		}
+	}
+
+	if err = req.ParseForm(); err != nil {
+		return err
+	}
+
+	get := map[string]string{}
+	post := map[string]string{}
+	urlQuery := req.URL.Query()
+	for name, param := range urlQuery {
+		get[name] = string(param[0])
+	}
+	postVars := req.Form
+	for name, param := range postVars {
+		post[name] = string(param[0])
+	}
+
+	r.SessionID = parseUInt64(chi.URLParam(req, "sessionID"))
+	r.NamespaceID = parseUInt64(chi.URLParam(req, "namespaceID"))
+	r.ModuleID = parseUInt64(chi.URLParam(req, "moduleID"))
+
+	return err
+}
+
+var _ RequestFiller = NewRecordImportCancel()
+
+// Record importErrors request parameters
+type RecordImportErrors struct {
+	SessionID   uint64 `json:",string"`
 	NamespaceID uint64 `json:",string"`
 	ModuleID    uint64 `json:",string"`
 }
 
+func NewRecordImportErrors() *RecordImportErrors {
+	return &RecordImportErrors{}
+}
+
+func (r RecordImportErrors) Auditable() map[string]interface{} {
+	var out = map[string]interface{}{}
+
+	out["sessionID"] = r.SessionID
+	out["namespaceID"] = r.NamespaceID
+	out["moduleID"] = r.ModuleID
+
+	return out
+}
+
+func (r *RecordImportErrors) Fill(req *http.Request) (err error) {
+	if strings.ToLower(req.Header.Get("content-type")) == "application/json" {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return errors.Wrap(err, "error parsing http request body")
+		}
+	}
+
+	if err = req.ParseForm(); err != nil {
+		return err
+	}
+
+	get := map[string]string{}
+	post := map[string]string{}
+	urlQuery := req.URL.Query()
+	for name, param := range urlQuery {
+		get[name] = string(param[0])
+	}
+	postVars := req.Form
+	for name, param := range postVars {
+		post[name] = string(param[0])
+	}
+
+	r.SessionID = parseUInt64(chi.URLParam(req, "sessionID"))
+	r.NamespaceID = parseUInt64(chi.URLParam(req, "namespaceID"))
+	r.ModuleID = parseUInt64(chi.URLParam(req, "moduleID"))
+
+	return err
+}
+
+var _ RequestFiller = NewRecordImportErrors()
+
+// Record export request parameters
+type RecordExport struct {
+	Filter              string
+	Sort                string
+	Deleted             uint
+	Fields              []string
+	MultiValue          string
+	MultiValueDelimiter string
+	Labels              bool
+	Filename            string
+	Ext                 string
+	NamespaceID         uint64 `json:",string"`
+	ModuleID            uint64 `json:",string"`
+}
+
 func NewRecordExport() *RecordExport {
 	return &RecordExport{}
 }
@@ -379,7 +534,12 @@ func (r RecordExport) Auditable() map[string]interface{} {
 	var out = map[string]interface{}{}
 
 	out["filter"] = r.Filter
+	out["sort"] = r.Sort
+	out["deleted"] = r.Deleted
 	out["fields"] = r.Fields
+	out["multiValue"] = r.MultiValue
+	out["multiValueDelimiter"] = r.MultiValueDelimiter
+	out["labels"] = r.Labels
 	out["filename"] = r.Filename
 	out["ext"] = r.Ext
 	out["namespaceID"] = r.NamespaceID
@@ -418,6 +578,12 @@ func (r *RecordExport) Fill(req *http.Request) (err error) {
 	if val, ok := get["filter"]; ok {
 		r.Filter = val
 	}
+	if val, ok := get["sort"]; ok {
+		r.Sort = val
+	}
+	if val, ok := get["deleted"]; ok {
+		r.Deleted = parseUint(val)
+	}
 
 	if val, ok := urlQuery["fields[]"]; ok {
 		r.Fields = parseStrings(val)
@@ -425,6 +591,15 @@ func (r *RecordExport) Fill(req *http.Request) (err error) {
 		r.Fields = parseStrings(val)
 	}
 
+	if val, ok := get["multiValue"]; ok {
+		r.MultiValue = val
+	}
+	if val, ok := get["multiValueDelimiter"]; ok {
+		r.MultiValueDelimiter = val
+	}
+	if val, ok := get["labels"]; ok {
+		r.Labels = parseBool(val)
+	}
 	r.Filename = chi.URLParam(req, "filename")
 	r.Ext = chi.URLParam(req, "ext")
 	r.NamespaceID = parseUInt64(chi.URLParam(req, "namespaceID"))
@@ -723,6 +898,370 @@ func (r *RecordDelete) Fill(req *http.Request) (err error) {
 
 var _ RequestFiller = NewRecordDelete()
 
+// Record upsert request parameters
+type RecordUpsert struct {
+	Keys        []string
+	Values      types.RecordValueSet
+	NamespaceID uint64 `json:",string"`
+	ModuleID    uint64 `json:",string"`
+}
+
+func NewRecordUpsert() *RecordUpsert {
+	return &RecordUpsert{}
+}
+
+func (r RecordUpsert) Auditable() map[string]interface{} {
+	var out = map[string]interface{}{}
+
+	out["keys"] = r.Keys
+	out["values"] = r.Values
+	out["namespaceID"] = r.NamespaceID
+	out["moduleID"] = r.ModuleID
+
+	return out
+}
+
+func (r *RecordUpsert) Fill(req *http.Request) (err error) {
+	if strings.ToLower(req.Header.Get("content-type")) == "application/json" {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return errors.Wrap(err, "error parsing http request body")
+		}
+	}
+
+	if err = req.ParseForm(); err != nil {
+		return err
+	}
+
+	get := map[string]string{}
+	post := map[string]string{}
+	urlQuery := req.URL.Query()
+	for name, param := range urlQuery {
+		get[name] = string(param[0])
+	}
+	postVars := req.Form
+	for name, param := range postVars {
+		post[name] = string(param[0])
+	}
+
+	if val, ok := req.Form["keys"]; ok {
+		r.Keys = parseStrings(val)
+	}
+
+	r.NamespaceID = parseUInt64(chi.URLParam(req, "namespaceID"))
+	r.ModuleID = parseUInt64(chi.URLParam(req, "moduleID"))
+
+	return err
+}
+
+var _ RequestFiller = NewRecordUpsert()
+
+// Record bulk request parameters
+type RecordBulk struct {
+	Records     types.RecordBulkOperationSet
+	OnError     string
+	NamespaceID uint64 `json:",string"`
+	ModuleID    uint64 `json:",string"`
+}
+
+func NewRecordBulk() *RecordBulk {
+	return &RecordBulk{}
+}
+
+func (r RecordBulk) Auditable() map[string]interface{} {
+	var out = map[string]interface{}{}
+
+	out["records"] = r.Records
+	out["onError"] = r.OnError
+	out["namespaceID"] = r.NamespaceID
+	out["moduleID"] = r.ModuleID
+
+	return out
+}
+
+func (r *RecordBulk) Fill(req *http.Request) (err error) {
+	if strings.ToLower(req.Header.Get("content-type")) == "application/json" {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return errors.Wrap(err, "error parsing http request body")
+		}
+	}
+
+	if err = req.ParseForm(); err != nil {
+		return err
+	}
+
+	get := map[string]string{}
+	post := map[string]string{}
+	urlQuery := req.URL.Query()
+	for name, param := range urlQuery {
+		get[name] = string(param[0])
+	}
+	postVars := req.Form
+	for name, param := range postVars {
+		post[name] = string(param[0])
+	}
+
+	if val, ok := post["onError"]; ok {
+		r.OnError = val
+	}
+	r.NamespaceID = parseUInt64(chi.URLParam(req, "namespaceID"))
+	r.ModuleID = parseUInt64(chi.URLParam(req, "moduleID"))
+
+	return err
+}
+
+var _ RequestFiller = NewRecordBulk()
+
+// Record undelete request parameters
+type RecordUndelete struct {
+	RecordID    uint64 `json:",string"`
+	NamespaceID uint64 `json:",string"`
+	ModuleID    uint64 `json:",string"`
+}
+
+func NewRecordUndelete() *RecordUndelete {
+	return &RecordUndelete{}
+}
+
+func (r RecordUndelete) Auditable() map[string]interface{} {
+	var out = map[string]interface{}{}
+
+	out["recordID"] = r.RecordID
+	out["namespaceID"] = r.NamespaceID
+	out["moduleID"] = r.ModuleID
+
+	return out
+}
+
+func (r *RecordUndelete) Fill(req *http.Request) (err error) {
+	if strings.ToLower(req.Header.Get("content-type")) == "application/json" {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return errors.Wrap(err, "error parsing http request body")
+		}
+	}
+
+	if err = req.ParseForm(); err != nil {
+		return err
+	}
+
+	get := map[string]string{}
+	post := map[string]string{}
+	urlQuery := req.URL.Query()
+	for name, param := range urlQuery {
+		get[name] = string(param[0])
+	}
+	postVars := req.Form
+	for name, param := range postVars {
+		post[name] = string(param[0])
+	}
+
+	r.RecordID = parseUInt64(chi.URLParam(req, "recordID"))
+	r.NamespaceID = parseUInt64(chi.URLParam(req, "namespaceID"))
+	r.ModuleID = parseUInt64(chi.URLParam(req, "moduleID"))
+
+	return err
+}
+
+var _ RequestFiller = NewRecordUndelete()
+
+// Record revisions request parameters
+type RecordRevisions struct {
+	Page        uint
+	PerPage     uint
+	RecordID    uint64 `json:",string"`
+	NamespaceID uint64 `json:",string"`
+	ModuleID    uint64 `json:",string"`
+}
+
+func NewRecordRevisions() *RecordRevisions {
+	return &RecordRevisions{}
+}
+
+func (r RecordRevisions) Auditable() map[string]interface{} {
+	var out = map[string]interface{}{}
+
+	out["page"] = r.Page
+	out["perPage"] = r.PerPage
+	out["recordID"] = r.RecordID
+	out["namespaceID"] = r.NamespaceID
+	out["moduleID"] = r.ModuleID
+
+	return out
+}
+
+func (r *RecordRevisions) Fill(req *http.Request) (err error) {
+	if strings.ToLower(req.Header.Get("content-type")) == "application/json" {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return errors.Wrap(err, "error parsing http request body")
+		}
+	}
+
+	if err = req.ParseForm(); err != nil {
+		return err
+	}
+
+	get := map[string]string{}
+	post := map[string]string{}
+	urlQuery := req.URL.Query()
+	for name, param := range urlQuery {
+		get[name] = string(param[0])
+	}
+	postVars := req.Form
+	for name, param := range postVars {
+		post[name] = string(param[0])
+	}
+
+	if val, ok := get["page"]; ok {
+		r.Page = parseUint(val)
+	}
+	if val, ok := get["perPage"]; ok {
+		r.PerPage = parseUint(val)
+	}
+	r.RecordID = parseUInt64(chi.URLParam(req, "recordID"))
+	r.NamespaceID = parseUInt64(chi.URLParam(req, "namespaceID"))
+	r.ModuleID = parseUInt64(chi.URLParam(req, "moduleID"))
+
+	return err
+}
+
+var _ RequestFiller = NewRecordRevisions()
+
+// Record restoreRevision request parameters
+type RecordRestoreRevision struct {
+	RecordID    uint64 `json:",string"`
+	Revision    uint
+	NamespaceID uint64 `json:",string"`
+	ModuleID    uint64 `json:",string"`
+}
+
+func NewRecordRestoreRevision() *RecordRestoreRevision {
+	return &RecordRestoreRevision{}
+}
+
+func (r RecordRestoreRevision) Auditable() map[string]interface{} {
+	var out = map[string]interface{}{}
+
+	out["recordID"] = r.RecordID
+	out["revision"] = r.Revision
+	out["namespaceID"] = r.NamespaceID
+	out["moduleID"] = r.ModuleID
+
+	return out
+}
+
+func (r *RecordRestoreRevision) Fill(req *http.Request) (err error) {
+	if strings.ToLower(req.Header.Get("content-type")) == "application/json" {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return errors.Wrap(err, "error parsing http request body")
+		}
+	}
+
+	if err = req.ParseForm(); err != nil {
+		return err
+	}
+
+	get := map[string]string{}
+	post := map[string]string{}
+	urlQuery := req.URL.Query()
+	for name, param := range urlQuery {
+		get[name] = string(param[0])
+	}
+	postVars := req.Form
+	for name, param := range postVars {
+		post[name] = string(param[0])
+	}
+
+	r.RecordID = parseUInt64(chi.URLParam(req, "recordID"))
+	r.Revision = parseUint(chi.URLParam(req, "revision"))
+	r.NamespaceID = parseUInt64(chi.URLParam(req, "namespaceID"))
+	r.ModuleID = parseUInt64(chi.URLParam(req, "moduleID"))
+
+	return err
+}
+
+var _ RequestFiller = NewRecordRestoreRevision()
+
+// Record references request parameters
+type RecordReferences struct {
+	RecordID    uint64 `json:",string"`
+	NamespaceID uint64 `json:",string"`
+	ModuleID    uint64 `json:",string"`
+}
+
+func NewRecordReferences() *RecordReferences {
+	return &RecordReferences{}
+}
+
+func (r RecordReferences) Auditable() map[string]interface{} {
+	var out = map[string]interface{}{}
+
+	out["recordID"] = r.RecordID
+	out["namespaceID"] = r.NamespaceID
+	out["moduleID"] = r.ModuleID
+
+	return out
+}
+
+func (r *RecordReferences) Fill(req *http.Request) (err error) {
+	if strings.ToLower(req.Header.Get("content-type")) == "application/json" {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return errors.Wrap(err, "error parsing http request body")
+		}
+	}
+
+	if err = req.ParseForm(); err != nil {
+		return err
+	}
+
+	get := map[string]string{}
+	post := map[string]string{}
+	urlQuery := req.URL.Query()
+	for name, param := range urlQuery {
+		get[name] = string(param[0])
+	}
+	postVars := req.Form
+	for name, param := range postVars {
+		post[name] = string(param[0])
+	}
+
+	r.RecordID = parseUInt64(chi.URLParam(req, "recordID"))
+	r.NamespaceID = parseUInt64(chi.URLParam(req, "namespaceID"))
+	r.ModuleID = parseUInt64(chi.URLParam(req, "moduleID"))
+
+	return err
+}
+
+var _ RequestFiller = NewRecordReferences()
+
 // Record upload request parameters
 type RecordUpload struct {
 	RecordID    uint64 `json:",string"`
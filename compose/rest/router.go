@@ -16,6 +16,7 @@ func MountRoutes(r chi.Router) {
 		chart        = Chart{}.New()
 		notification = Notification{}.New()
 		attachment   = Attachment{}.New()
+		exportJob    = ExportJob{}.New()
 
 		automationScript  = AutomationScript{}.New()
 		automationTrigger = AutomationTrigger{}.New()
@@ -25,6 +26,9 @@ func MountRoutes(r chi.Router) {
 	r.Group(func(r chi.Router) {
 		// Use alternative handlers that support file serving
 		handlers.NewAttachment(attachment).MountRoutes(r)
+
+		// Exported files are downloaded with signed URLs
+		handlers.NewExportJob(exportJob).MountRoutes(r)
 	})
 
 	// Protect all _private_ routes
@@ -3,7 +3,10 @@ package service
 import (
 	"context"
 
+	"github.com/Masterminds/squirrel"
+
 	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
 	"github.com/cortezaproject/corteza-server/pkg/automation"
 	"github.com/cortezaproject/corteza-server/pkg/permissions"
 )
@@ -132,6 +135,77 @@ func (svc accessControl) CanDeleteRecord(ctx context.Context, r *types.Module) b
 	return svc.can(ctx, r, "record.delete")
 }
 
+func (svc accessControl) CanUndeleteRecord(ctx context.Context, r *types.Module) bool {
+	return svc.can(ctx, r, "record.undelete")
+}
+
+func (svc accessControl) CanReadOwnRecord(ctx context.Context, r *types.Module) bool {
+	return svc.can(ctx, r, "record.read.own")
+}
+
+func (svc accessControl) CanUpdateOwnRecord(ctx context.Context, r *types.Module) bool {
+	return svc.can(ctx, r, "record.update.own")
+}
+
+func (svc accessControl) CanDeleteOwnRecord(ctx context.Context, r *types.Module) bool {
+	return svc.can(ctx, r, "record.delete.own")
+}
+
+// CanReadRecordInstance checks if user can read this specific record
+//
+// Record-level rules (records shared with user's roles) are checked first,
+// then module-level permissions for all records or for own records
+func (svc accessControl) CanReadRecordInstance(ctx context.Context, m *types.Module, r *types.Record) bool {
+	return svc.canRecord(ctx, m, r, "read")
+}
+
+// CanUpdateRecordInstance checks if user can update this specific record, see CanReadRecordInstance
+func (svc accessControl) CanUpdateRecordInstance(ctx context.Context, m *types.Module, r *types.Record) bool {
+	return svc.canRecord(ctx, m, r, "update")
+}
+
+// CanDeleteRecordInstance checks if user can delete this specific record, see CanReadRecordInstance
+func (svc accessControl) CanDeleteRecordInstance(ctx context.Context, m *types.Module, r *types.Record) bool {
+	return svc.canRecord(ctx, m, r, "delete")
+}
+
+// FilterReadableRecords limits records of the module to the ones user can read
+//
+// Filter reflects checks in CanReadRecordInstance
+func (svc accessControl) FilterReadableRecords(ctx context.Context, m *types.Module) *permissions.ResourceFilter {
+	return svc.FilterReadableRecordsAs(ctx, m, "r")
+}
+
+// FilterReadableRecordsAs is FilterReadableRecords for records under the given table alias
+func (svc accessControl) FilterReadableRecordsAs(ctx context.Context, m *types.Module, alias string) *permissions.ResourceFilter {
+	var fallback squirrel.Sqlizer = squirrel.Expr("FALSE")
+
+	if svc.CanReadRecord(ctx, m) {
+		fallback = squirrel.Expr("TRUE")
+	} else if svc.CanReadOwnRecord(ctx, m) {
+		fallback = squirrel.Eq{alias + ".owned_by": auth.GetIdentityFromContext(ctx).Identity()}
+	}
+
+	return svc.permissions.
+		ResourceFilter(ctx, types.RecordPermissionResource, "read", permissions.Deny).
+		Build(alias + ".id").
+		Fallback(fallback)
+}
+
+func (svc accessControl) canRecord(ctx context.Context, m *types.Module, r *types.Record, op permissions.Operation) bool {
+	return svc.can(ctx, r, op, func() permissions.Access {
+		if svc.can(ctx, m, "record."+op) {
+			return permissions.Allow
+		}
+
+		if r.OwnedBy > 0 && r.OwnedBy == auth.GetIdentityFromContext(ctx).Identity() && svc.can(ctx, m, "record."+op+".own") {
+			return permissions.Allow
+		}
+
+		return permissions.Inherit
+	})
+}
+
 func (svc accessControl) CanManageAutomationTriggersOnModule(ctx context.Context, r *types.Module) bool {
 	return svc.can(ctx, r, "automation-trigger.manage")
 }
@@ -250,9 +324,20 @@ func (svc accessControl) Whitelist() permissions.Whitelist {
 		"record.read",
 		"record.update",
 		"record.delete",
+		"record.undelete",
+		"record.read.own",
+		"record.update.own",
+		"record.delete.own",
 		"automation-trigger.manage",
 	)
 
+	wl.Set(
+		types.RecordPermissionResource,
+		"read",
+		"update",
+		"delete",
+	)
+
 	wl.Set(
 		types.ModuleFieldPermissionResource,
 		"record.value.read",
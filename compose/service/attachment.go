@@ -48,7 +48,7 @@ type (
 
 	attachmentAccessController interface {
 		CanUpdatePage(context.Context, *types.Page) bool
-		CanUpdateRecord(context.Context, *types.Module) bool
+		CanUpdateRecordInstance(context.Context, *types.Module, *types.Record) bool
 		CanCreateRecord(context.Context, *types.Module) bool
 	}
 
@@ -193,9 +193,9 @@ func (svc attachment) CreateRecordAttachment(namespaceID uint64, name string, si
 		//
 		// To allow upload (attachment creation) user must have permissions to
 		// alter that record
-		if _, err := svc.recordSvc.FindByID(namespaceID, recordID); err != nil {
+		if r, err := svc.recordSvc.FindByID(namespaceID, recordID); err != nil {
 			return nil, err
-		} else if !svc.ac.CanUpdateRecord(svc.ctx, m) {
+		} else if !svc.ac.CanUpdateRecordInstance(svc.ctx, m, r) {
 			return nil, ErrNoUpdatePermissions.withStack()
 		}
 	} else {
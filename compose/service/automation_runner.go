@@ -2,19 +2,26 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/titpetric/factory"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/cortezaproject/corteza-server/compose/proto"
+	"github.com/cortezaproject/corteza-server/compose/repository"
 	"github.com/cortezaproject/corteza-server/compose/types"
 	"github.com/cortezaproject/corteza-server/pkg/auth"
 	"github.com/cortezaproject/corteza-server/pkg/automation"
 	"github.com/cortezaproject/corteza-server/pkg/automation/corredor"
+	"github.com/cortezaproject/corteza-server/pkg/ql"
 	"github.com/cortezaproject/corteza-server/pkg/sentry"
 )
 
@@ -31,7 +38,10 @@ type (
 	automationScriptsFinder interface {
 		Watch(ctx context.Context)
 		WatchScheduled(ctx context.Context, runner automation.DeferredAutomationRunner)
+		WatchQueue(ctx context.Context, x automation.QueueExecutor)
 		FindRunnableScripts(resource, event string, cc ...automation.TriggerConditionChecker) automation.ScriptSet
+		LogRun(ctx context.Context, run *automation.Run) error
+		Enqueue(ctx context.Context, db *factory.DB, e *automation.Execution) error
 	}
 
 	automationRunnerAccessControler interface {
@@ -42,11 +52,24 @@ type (
 		ApiBaseURLSystem    string
 		ApiBaseURLMessaging string
 		ApiBaseURLCompose   string
+
+		// Timeout of scripts without their own timeout
+		Timeout time.Duration
+	}
+
+	// automationRecordPayload is a payload of queued record script execution
+	automationRecordPayload struct {
+		Namespace *types.Namespace `json:"namespace,omitempty"`
+		Module    *types.Module    `json:"module,omitempty"`
+		Record    *types.Record    `json:"record,omitempty"`
 	}
 )
 
 const (
 	AutomationResourceRecord = "compose:record"
+
+	// Format of date field values in record filter of relative deferred triggers
+	relativeConditionDateFormat = "2006-01-02 15:04:05"
 )
 
 func AutomationRunner(opt AutomationRunnerOpt, f automationScriptsFinder, r corredor.ScriptRunnerClient) automationRunner {
@@ -68,14 +91,15 @@ func AutomationRunner(opt AutomationRunnerOpt, f automationScriptsFinder, r corr
 func (svc automationRunner) Watch(ctx context.Context) {
 	svc.scriptFinder.Watch(ctx)
 	svc.scriptFinder.WatchScheduled(ctx, svc)
+	svc.scriptFinder.WatchQueue(ctx, svc)
 }
 
 // BeforeRecordCreate - run scripts before record is created
 //
 // This is implicitly called, no extra security check is needed
 func (svc automationRunner) BeforeRecordCreate(ctx context.Context, ns *types.Namespace, m *types.Module, r *types.Record) (err error) {
-	return svc.findRecordScripts("beforeCreate", m.ID).Walk(
-		svc.makeRecordScriptRunner(ctx, ns, m, r, false),
+	return svc.matchRecordScripts("beforeCreate", m, r, nil).Walk(
+		svc.makeRecordScriptRunner(ctx, "beforeCreate", ns, m, r, false),
 	)
 }
 
@@ -83,26 +107,26 @@ func (svc automationRunner) BeforeRecordCreate(ctx context.Context, ns *types.Na
 //
 // This is implicitly called, no extra security check is needed
 func (svc automationRunner) AfterRecordCreate(ctx context.Context, ns *types.Namespace, m *types.Module, r *types.Record) (err error) {
-	return svc.findRecordScripts("afterCreate", m.ID).Walk(
-		svc.makeRecordScriptRunner(ctx, ns, m, r, true),
+	return svc.matchRecordScripts("afterCreate", m, r, nil).Walk(
+		svc.makeRecordScriptRunner(ctx, "afterCreate", ns, m, r, true),
 	)
 }
 
 // BeforeRecordUpdate - run scripts before record is updated
 //
 // This is implicitly called, no extra security check is needed
-func (svc automationRunner) BeforeRecordUpdate(ctx context.Context, ns *types.Namespace, m *types.Module, r *types.Record) (err error) {
-	return svc.findRecordScripts("beforeUpdate", m.ID).Walk(
-		svc.makeRecordScriptRunner(ctx, ns, m, r, false),
+func (svc automationRunner) BeforeRecordUpdate(ctx context.Context, ns *types.Namespace, m *types.Module, r *types.Record, old types.RecordValueSet) (err error) {
+	return svc.matchRecordScripts("beforeUpdate", m, r, old).Walk(
+		svc.makeRecordScriptRunner(ctx, "beforeUpdate", ns, m, r, false),
 	)
 }
 
 // AfterRecordUpdate - run scripts before record is updated
 //
 // This is implicitly called, no extra security check is needed
-func (svc automationRunner) AfterRecordUpdate(ctx context.Context, ns *types.Namespace, m *types.Module, r *types.Record) (err error) {
-	return svc.findRecordScripts("afterUpdate", m.ID).Walk(
-		svc.makeRecordScriptRunner(ctx, ns, m, r, true),
+func (svc automationRunner) AfterRecordUpdate(ctx context.Context, ns *types.Namespace, m *types.Module, r *types.Record, old types.RecordValueSet) (err error) {
+	return svc.matchRecordScripts("afterUpdate", m, r, old).Walk(
+		svc.makeRecordScriptRunner(ctx, "afterUpdate", ns, m, r, true),
 	)
 }
 
@@ -110,8 +134,8 @@ func (svc automationRunner) AfterRecordUpdate(ctx context.Context, ns *types.Nam
 //
 // This is implicitly called, no extra security check is needed
 func (svc automationRunner) BeforeRecordDelete(ctx context.Context, ns *types.Namespace, m *types.Module, r *types.Record) (err error) {
-	return svc.findRecordScripts("beforeDelete", m.ID).Walk(
-		svc.makeRecordScriptRunner(ctx, ns, m, r, false),
+	return svc.matchRecordScripts("beforeDelete", m, r, nil).Walk(
+		svc.makeRecordScriptRunner(ctx, "beforeDelete", ns, m, r, false),
 	)
 }
 
@@ -119,8 +143,8 @@ func (svc automationRunner) BeforeRecordDelete(ctx context.Context, ns *types.Na
 //
 // This is implicitly called, no extra security check is needed
 func (svc automationRunner) AfterRecordDelete(ctx context.Context, ns *types.Namespace, m *types.Module, r *types.Record) (err error) {
-	return svc.findRecordScripts("afterDelete", m.ID).Walk(
-		svc.makeRecordScriptRunner(ctx, ns, m, r, true),
+	return svc.matchRecordScripts("afterDelete", m, r, nil).Walk(
+		svc.makeRecordScriptRunner(ctx, "afterDelete", ns, m, r, true),
 	)
 }
 
@@ -135,6 +159,53 @@ func (svc automationRunner) findRecordScripts(event string, moduleID uint64) aut
 	return ss
 }
 
+// Finds scripts with triggers that match the record
+//
+// Trigger's expression is evaluated with values of the record (and
+// values before the update) so that scripts are not called needlessly
+func (svc automationRunner) matchRecordScripts(event string, m *types.Module, r *types.Record, old types.RecordValueSet) automation.ScriptSet {
+	ss, _ := svc.findRecordScripts(event, m.ID).Filter(func(script *automation.Script) (bool, error) {
+		for _, t := range script.Triggers() {
+			if !t.IsValid() || t.Resource != AutomationResourceRecord || t.Event != event || t.Uint64Condition() != m.ID {
+				continue
+			}
+
+			if svc.recordTriggerMatches(t, m, r, old) {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	})
+
+	return ss
+}
+
+// recordTriggerMatches evaluates trigger's expression; triggers without expressions always match
+//
+// Triggers with expressions that can not be evaluated do not match
+func (svc automationRunner) recordTriggerMatches(t *automation.Trigger, m *types.Module, r *types.Record, old types.RecordValueSet) bool {
+	if strings.TrimSpace(t.Expression) == "" {
+		return true
+	}
+
+	var values types.RecordValueSet
+	if r != nil {
+		values = r.Values
+	}
+
+	n, err := parseRecordTriggerExpression(m, t.Expression)
+	if err == nil {
+		var match bool
+		if match, err = ql.EvaluateCondition(n, recordTriggerResolver(m, values, old)); err == nil {
+			return match
+		}
+	}
+
+	svc.logger.Warn("could not evaluate trigger expression", zap.Uint64("triggerID", t.ID), zap.Error(err))
+	return false
+}
+
 // UserScripts - collect all scripts runnable by users, appends compatible triggers
 //
 // So, either in their browser (RunInUA) or by running backend scripts explicitly (event:manual)
@@ -189,7 +260,7 @@ func (svc automationRunner) RecordManual(ctx context.Context, scriptID uint64, n
 	}
 
 	// Make record script runner and
-	runner := svc.makeRecordScriptRunner(ctx, ns, m, r, false)
+	runner := svc.makeRecordScriptRunner(ctx, "manual", ns, m, r, false)
 
 	// Run it with a script
 	//
@@ -214,7 +285,7 @@ func (svc automationRunner) RecordDeferred(ctx context.Context, script *automati
 	}
 
 	// Make record script runner and
-	runner := svc.makeRecordScriptRunner(ctx, ns, m, r, true)
+	runner := svc.makeRecordScriptRunner(ctx, automation.EVENT_TYPE_DEFERRED, ns, m, r, true)
 
 	// Run it with a script
 	//
@@ -222,9 +293,76 @@ func (svc automationRunner) RecordDeferred(ctx context.Context, script *automati
 	return runner(script)
 }
 
+// RecordDeferredRelative - Deferred trigger run for records with date field values in the given interval
+//
+// Records are looked up with their current values on every run, so records with
+// changed date fields are re-planned and deleted records are skipped
+func (svc automationRunner) RecordDeferredRelative(ctx context.Context, script *automation.Script, c automation.RelativeCondition, from, to time.Time) (err error) {
+	if script == nil {
+		return errors.New("can not find compatible script")
+	}
+
+	var (
+		db = repository.DB(ctx)
+		rr = repository.Record(ctx, db)
+		ns *types.Namespace
+		m  *types.Module
+	)
+
+	if ns, err = repository.Namespace(ctx, db).FindByID(script.NamespaceID); err != nil {
+		return
+	}
+
+	if m, err = repository.Module(ctx, db).FindByID(ns.ID, c.ModuleID); err != nil {
+		return
+	}
+
+	if m.Fields, err = repository.Module(ctx, db).FindFields(m.ID); err != nil {
+		return
+	}
+
+	if f := m.Fields.FindByName(c.Field); f == nil || !f.IsDateTime() {
+		return errors.Errorf("field %q is not a date field", c.Field)
+	}
+
+	filter := types.RecordFilter{
+		NamespaceID: ns.ID,
+		ModuleID:    m.ID,
+		Filter: fmt.Sprintf(
+			"%s >= '%s' AND %s < '%s'",
+			c.Field, from.UTC().Format(relativeConditionDateFormat),
+			c.Field, to.UTC().Format(relativeConditionDateFormat),
+		),
+	}
+
+	var computed = computedFields(m)
+
+	return rr.Export(m, filter, func(set types.RecordSet) error {
+		rvs, err := rr.LoadValues(m.Fields.Names(), set.IDs())
+		if err != nil {
+			return err
+		}
+
+		return set.Walk(func(r *types.Record) error {
+			r.Values = computed.apply(m, rvs.FilterByRecordID(r.ID))
+
+			if err := svc.RecordDeferred(ctx, script, ns, m, r); err != nil {
+				svc.logger.Warn(
+					"could not run deferred script for record",
+					zap.Uint64("scriptID", script.ID),
+					zap.Uint64("recordID", r.ID),
+					zap.Error(err),
+				)
+			}
+
+			return nil
+		})
+	})
+}
+
 func (svc automationRunner) RecordScriptTester(ctx context.Context, source string, ns *types.Namespace, m *types.Module, r *types.Record) (err error) {
 	// Make record script runner and
-	runner := svc.makeRecordScriptRunner(ctx, ns, m, r, false)
+	runner := svc.makeRecordScriptRunner(ctx, "test", ns, m, r, false)
 
 	return runner(&automation.Script{
 		ID:        0,
@@ -245,115 +383,230 @@ func (svc automationRunner) RecordScriptTester(ctx context.Context, source strin
 // We set-up script-running environment: security (definer / invoker), async, critical
 // and copying values from the run to the given Record
 //
-func (svc automationRunner) makeRecordScriptRunner(ctx context.Context, ns *types.Namespace, m *types.Module, r *types.Record, discard bool) func(script *automation.Script) error {
-	// Static request params (record gets updated
-	var req = &corredor.RunRecordRequest{
-		Namespace: proto.FromNamespace(ns),
-		Module:    proto.FromModule(m),
-		Record:    proto.FromRecord(r),
-	}
-
+// Async scripts and scripts with discarded results (after-triggers, deferred) are
+// queued and executed in the background (see ExecuteQueued)
+//
+func (svc automationRunner) makeRecordScriptRunner(ctx context.Context, event string, ns *types.Namespace, m *types.Module, r *types.Record, discard bool) func(script *automation.Script) error {
 	return func(script *automation.Script) error {
-		if svc.runner == nil {
-			return errors.New("can not run corredor script: not connected")
+		if svc.runner != nil && script.ID > 0 && (script.Async || discard) {
+			return svc.enqueueRecordScript(ctx, script, event, ns, m, r)
+		}
+
+		if discard {
+			// Results are not used; script is run when the record transaction is committed
+			runAfterCommit(ctx, func() { _, _ = svc.runRecordScript(ctx, event, ns, m, r, discard, script) })
+			return nil
 		}
 
-		// This could be executed in a goroutine (by *after triggers,
-		// so we need to rewire the sentry panic recovery
-		defer sentry.Recover()
+		failed, err := svc.runRecordScript(ctx, event, ns, m, r, discard, script)
+		if failed && !script.Critical {
+			// This was not a critical call and we do not care about
+			// errors from script running service.
+			return nil
+		}
+
+		return err
+	}
+}
+
+// enqueueRecordScript adds script execution with snapshot of the record (and module, namespace)
+// and invoker's identity to the queue
+//
+// When the record is modified inside a transaction, execution is queued through the
+// transaction's handle: it is stored (and later executed) only when the transaction is
+// committed and failing to queue it fails the transaction (transactional outbox)
+func (svc automationRunner) enqueueRecordScript(ctx context.Context, script *automation.Script, event string, ns *types.Namespace, m *types.Module, r *types.Record) (err error) {
+	var (
+		e = &automation.Execution{
+			ScriptID: script.ID,
+			Resource: AutomationResourceRecord,
+			Event:    event,
+		}
+	)
 
-		ctx, cancelFn := context.WithTimeout(ctx, time.Second*5)
-		defer cancelFn()
+	e.SetIdentity(auth.GetIdentityFromContext(ctx))
 
-		// Add invoker's or defined credentials/jwt
-		req.Config = map[string]string{
-			"api.jwt": svc.getJWT(ctx, script),
+	// Payload is a snapshot of the current state, encode it right away
+	e.Payload, err = json.Marshal(automationRecordPayload{Namespace: ns, Module: m, Record: r})
+	if err != nil {
+		svc.logger.Error("could not queue script execution", zap.Uint64("scriptID", script.ID), zap.Error(err))
 
-			// Let the script know where the API is
-			"api.baseURL.system":    svc.opt.ApiBaseURLSystem,
-			"api.baseURL.compose":   svc.opt.ApiBaseURLCompose,
-			"api.baseURL.messaging": svc.opt.ApiBaseURLMessaging,
+		if !script.Critical {
+			return nil
 		}
 
-		// Add script info
-		req.Script = corredor.FromScript(script)
+		return err
+	}
 
-		rsp, err := svc.runner.Record(ctx, req, grpc.WaitForReady(script.Critical))
+	if err = svc.scriptFinder.Enqueue(ctx, transactionDB(ctx), e); err != nil {
+		svc.logger.Error("could not queue script execution", zap.Uint64("scriptID", script.ID), zap.Error(err))
+		return err
+	}
 
-		if err != nil {
-			s, ok := status.FromError(err)
-			if !ok {
-				svc.logger.Error("unexpected error type", zap.Error(err))
-				return err
-			}
+	return nil
+}
 
-			switch s.Code() {
-			case codes.FailedPrecondition:
-				// Sent on syntax errors:
-				err = errors.New(s.Message())
-			case codes.Aborted:
-				err = errors.New(s.Message())
-			case codes.InvalidArgument:
-				err = errors.New("invalid argument")
-			case codes.Internal:
-				err = errors.New("internal corredor error")
-			default:
-			}
+// ExecuteQueued executes queued record script with invoker's identity
+//
+// Transient corredor errors are retryable
+func (svc automationRunner) ExecuteQueued(ctx context.Context, script *automation.Script, e *automation.Execution) error {
+	var p = automationRecordPayload{}
 
-			svc.logger.Info("script executed with errors", zap.Error(err))
+	if e.Resource != AutomationResourceRecord {
+		return errors.Errorf("unsupported resource %q", e.Resource)
+	}
 
-			if !script.Critical {
-				// This was not a critical call and we do not care about
-				// errors from script running service.
-				return nil
-			}
+	if err := json.Unmarshal(e.Payload, &p); err != nil {
+		return errors.Wrap(err, "could not decode queued execution payload")
+	}
 
-			return err
-		}
+	ctx = auth.SetIdentityToContext(ctx, e.Identity())
 
-		if script.Async || discard {
-			// Discard returned values (in case of async call or when forced)
-			//
-			// Backend is still returning, so we do not
-			// need to handle multiple gRPC endpoints
-			return nil
-		}
+	_, err := svc.runRecordScript(ctx, e.Event, p.Namespace, p.Module, p.Record, true, script)
+	if corredor.IsTransient(err) {
+		return automation.Retryable(err)
+	}
+
+	return err
+}
 
-		if rsp.Record == nil {
-			// Script did not return any results
-			// This means we should stop with the execution
-			return errors.New("aborted")
+// runRecordScript runs record script on corredor and copies results to the given record
+//
+// Every run of a stored script is logged (see automation.Run); failed is set
+// when script could not be run by corredor
+func (svc automationRunner) runRecordScript(ctx context.Context, event string, ns *types.Namespace, m *types.Module, r *types.Record, discard bool, script *automation.Script) (failed bool, err error) {
+	var (
+		run     = script.Start(AutomationResourceRecord, event)
+		outcome = automation.RunOutcomeSuccess
+		md      metadata.MD
+
+		req = &corredor.RunRecordRequest{
+			Namespace: proto.FromNamespace(ns),
+			Module:    proto.FromModule(m),
+			Record:    proto.FromRecord(r),
 		}
+	)
 
-		// Convert from proto and copy record owner & values from the result
-		result := proto.ToRecord(rsp.Record)
-		r.OwnedBy, r.Values = result.OwnedBy, result.Values
+	run.UserID = auth.GetIdentityFromContext(ctx).Identity()
+	if r != nil {
+		run.RecordID = r.ID
+	}
 
-		// Let's copy module, namespace and other values if they are missing
-		if m != nil {
-			if r.ModuleID == 0 {
-				r.ModuleID = m.ID
-				r.NamespaceID = m.NamespaceID
-			}
-		}
+	defer func() {
+		// Errors of non-critical scripts are logged even when they are not returned
+		run.Finish(outcome, err)
+		run.Output = corredor.Output(md)
+		svc.logRun(run)
+	}()
+
+	if svc.runner == nil {
+		return false, errors.New("can not run corredor script: not connected")
+	}
+
+	// This could be executed in a goroutine (by *after triggers,
+	// so we need to rewire the sentry panic recovery
+	defer sentry.Recover()
+
+	ctx, cancelFn := context.WithTimeout(ctx, script.RunTimeout(svc.opt.Timeout))
+	defer cancelFn()
+
+	// Add invoker's or defined credentials/jwt
+	req.Config = map[string]string{
+		"api.jwt": svc.getJWT(ctx, script),
+
+		// Let the script know where the API is
+		"api.baseURL.system":    svc.opt.ApiBaseURLSystem,
+		"api.baseURL.compose":   svc.opt.ApiBaseURLCompose,
+		"api.baseURL.messaging": svc.opt.ApiBaseURLMessaging,
+	}
 
-		// Who modified/created/owns the Record
-		var currentUserID = auth.GetIdentityFromContext(ctx).Identity()
-		currentUserID = script.RunAs
+	// Add script info
+	req.Script = corredor.FromScript(script)
 
-		if r.OwnedBy == 0 {
-			r.OwnedBy = currentUserID
+	rsp, err := svc.runner.Record(ctx, req, grpc.WaitForReady(script.Critical), grpc.Trailer(&md))
+
+	if err != nil {
+		s, ok := status.FromError(err)
+		if !ok {
+			svc.logger.Error("unexpected error type", zap.Error(err))
+			return false, err
 		}
 
-		if r.CreatedAt.IsZero() {
-			r.CreatedAt = time.Now()
+		switch s.Code() {
+		case codes.FailedPrecondition:
+			// Sent on syntax errors:
+			err = errors.New(s.Message())
+		case codes.Aborted:
+			err = errors.New(s.Message())
+		case codes.InvalidArgument:
+			err = errors.New("invalid argument")
+		case codes.Internal:
+			err = errors.New("internal corredor error")
+		default:
 		}
 
-		if r.CreatedBy == 0 {
-			r.CreatedBy = currentUserID
+		svc.logger.Info("script executed with errors", zap.Error(err))
+
+		return true, err
+	}
+
+	if script.Async || discard {
+		// Discard returned values (in case of async call or when forced)
+		//
+		// Backend is still returning, so we do not
+		// need to handle multiple gRPC endpoints
+		return false, nil
+	}
+
+	if rsp.Record == nil {
+		// Script did not return any results
+		// This means we should stop with the execution
+		outcome = automation.RunOutcomeAborted
+		return false, errors.New("aborted")
+	}
+
+	// Convert from proto and copy record owner & values from the result
+	result := proto.ToRecord(rsp.Record)
+	r.OwnedBy, r.Values = result.OwnedBy, result.Values
+
+	// Let's copy module, namespace and other values if they are missing
+	if m != nil {
+		if r.ModuleID == 0 {
+			r.ModuleID = m.ID
+			r.NamespaceID = m.NamespaceID
 		}
+	}
 
-		return nil
+	// Who modified/created/owns the Record
+	var currentUserID = auth.GetIdentityFromContext(ctx).Identity()
+	currentUserID = script.RunAs
+
+	if r.OwnedBy == 0 {
+		r.OwnedBy = currentUserID
+	}
+
+	if r.CreatedAt.IsZero() {
+		r.CreatedAt = time.Now()
+	}
+
+	if r.CreatedBy == 0 {
+		r.CreatedBy = currentUserID
+	}
+
+	return false, nil
+}
+
+// logRun stores run into the script run log
+//
+// Runs of unsaved (test) scripts are not logged
+func (svc automationRunner) logRun(run *automation.Run) {
+	if run.ScriptID == 0 {
+		return
+	}
+
+	// Run is logged even when (request) context is already done
+	if err := svc.scriptFinder.LogRun(context.Background(), run); err != nil {
+		svc.logger.Error("could not log script run", zap.Uint64("scriptID", run.ScriptID), zap.Error(err))
 	}
 }
 
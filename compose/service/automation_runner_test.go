@@ -6,11 +6,30 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"github.com/titpetric/factory"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	service_mocks "github.com/cortezaproject/corteza-server/compose/service/mocks"
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
 	"github.com/cortezaproject/corteza-server/pkg/automation"
+	"github.com/cortezaproject/corteza-server/pkg/automation/corredor"
 )
 
+type (
+	// unavailableCorredor fails all record script runs as unavailable
+	unavailableCorredor struct {
+		corredor.ScriptRunnerClient
+	}
+)
+
+func (unavailableCorredor) Record(context.Context, *corredor.RunRecordRequest, ...grpc.CallOption) (*corredor.RunRecordResponse, error) {
+	return nil, status.Error(codes.Unavailable, "corredor unavailable")
+}
+
 func Test_automationRunner_findImplicitScripts(t *testing.T) {
 	var (
 		// Should be a part of users-scripts, with only one (manual) trigger
@@ -106,3 +125,125 @@ func Test_automationRunner_UserScripts(t *testing.T) {
 	require.True(t, len(runnables) == 3, "Expected runnable scriptSet to be intact")
 	require.True(t, len(runnables.FindByID(1000).Triggers()) == 3, "Expected runnable scriptSet (triggers from first script) to be intact")
 }
+
+func Test_automationRunner_queuedScripts(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		ctx = auth.SetIdentityToContext(context.Background(), auth.NewIdentity(42, 1, 2))
+		s   = &automation.Script{ID: 1000, Enabled: true, RunAs: 1}
+		m   = &types.Module{ID: 2000, NamespaceID: 3000}
+		r   = &types.Record{ID: 4000, ModuleID: 2000}
+
+		queued   *automation.Execution
+		queuedTo *factory.DB
+		logged   *automation.Run
+	)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	sfMock := service_mocks.NewMockautomationScriptsFinder(mockCtrl)
+	sfMock.EXPECT().
+		Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, db *factory.DB, e *automation.Execution) error {
+			queuedTo, queued = db, e
+			return nil
+		}).
+		Times(2)
+	sfMock.EXPECT().
+		LogRun(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, run *automation.Run) error { logged = run; return nil })
+
+	runner := automationRunner{
+		logger:       zap.NewNop(),
+		runner:       unavailableCorredor{},
+		scriptFinder: sfMock,
+	}
+
+	// After-trigger scripts are queued with record snapshot and invoker
+	req.NoError(runner.makeRecordScriptRunner(ctx, "afterUpdate", &types.Namespace{ID: 3000}, m, r, true)(s))
+	req.NotNil(queued)
+	req.Equal(s.ID, queued.ScriptID)
+	req.Equal("afterUpdate", queued.Event)
+	req.Equal(uint64(42), queued.Identity().Identity())
+	req.Equal([]uint64{1, 2}, queued.Identity().Roles())
+	req.Nil(queuedTo)
+
+	// Inside record transaction, execution is queued through its handle
+	tctx, ac := withAfterCommit(ctx)
+	ac.db = &factory.DB{}
+	req.NoError(runner.makeRecordScriptRunner(tctx, "afterUpdate", &types.Namespace{ID: 3000}, m, r, true)(s))
+	req.True(ac.db == queuedTo)
+	req.Empty(ac.fns, "queued execution should not wait for commit")
+
+	// Queued execution is run with invoker's identity; unavailable corredor can be retried
+	err := runner.ExecuteQueued(context.Background(), s, queued)
+	req.True(automation.IsRetryable(err))
+	req.NotNil(logged)
+	req.Equal(r.ID, logged.RecordID)
+	req.Equal(uint64(42), logged.UserID)
+	req.Equal(automation.RunOutcomeError, logged.Outcome)
+}
+
+func Test_automationRunner_matchRecordScripts(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		m = &types.Module{ID: 5555, Fields: types.ModuleFieldSet{
+			&types.ModuleField{Name: "stage", Kind: "String"},
+			&types.ModuleField{Name: "amount", Kind: "Number"},
+		}}
+
+		// No expression, always matches
+		s1 = &automation.Script{ID: 1000, Enabled: true}
+
+		// Stage changed to won
+		s2 = &automation.Script{ID: 2000, Enabled: true}
+
+		// Large amounts only
+		s3 = &automation.Script{ID: 3000, Enabled: true}
+
+		// Invalid expression, never matches
+		s4 = &automation.Script{ID: 4000, Enabled: true}
+
+		old = types.RecordValueSet{{Name: "stage", Value: "Lead"}, {Name: "amount", Value: "500"}}
+		r   = &types.Record{ModuleID: m.ID, Values: types.RecordValueSet{{Name: "stage", Value: "Won"}, {Name: "amount", Value: "500"}}}
+	)
+
+	s1.AddTrigger(automation.STMS_REPLACE,
+		&automation.Trigger{ID: 1001, Enabled: true, Resource: AutomationResourceRecord, Condition: "5555", Event: "afterUpdate"})
+	s2.AddTrigger(automation.STMS_REPLACE,
+		&automation.Trigger{ID: 2001, Enabled: true, Resource: AutomationResourceRecord, Condition: "5555", Event: "afterUpdate",
+			Expression: "stage = 'Won' AND old.stage IS NOT stage"})
+	s3.AddTrigger(automation.STMS_REPLACE,
+		&automation.Trigger{ID: 3001, Enabled: true, Resource: AutomationResourceRecord, Condition: "5555", Event: "afterUpdate",
+			Expression: "amount > 1000"})
+	s4.AddTrigger(automation.STMS_REPLACE,
+		&automation.Trigger{ID: 4001, Enabled: true, Resource: AutomationResourceRecord, Condition: "5555", Event: "afterUpdate",
+			Expression: "stage AND amount"})
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	sfMock := service_mocks.NewMockautomationScriptsFinder(mockCtrl)
+	sfMock.EXPECT().
+		FindRunnableScripts(gomock.Eq(AutomationResourceRecord), gomock.Eq("afterUpdate"), gomock.Any()).
+		Return(automation.ScriptSet{s1, s2, s3, s4}).
+		Times(2)
+
+	runner := automationRunner{
+		logger:       zap.NewNop(),
+		scriptFinder: sfMock,
+	}
+
+	ss := runner.matchRecordScripts("afterUpdate", m, r, old)
+	req.Len(ss, 2)
+	req.NotNil(ss.FindByID(1000))
+	req.NotNil(ss.FindByID(2000))
+
+	// Stage did not change
+	ss = runner.matchRecordScripts("afterUpdate", m, r, r.Values)
+	req.Len(ss, 1)
+	req.NotNil(ss.FindByID(1000))
+}
@@ -26,6 +26,14 @@ type (
 		CreateScript(context.Context, *automation.Script) error
 		UpdateScript(context.Context, *automation.Script) error
 		DeleteScript(context.Context, *automation.Script) error
+
+		FindRuns(context.Context, automation.RunFilter) (automation.RunSet, automation.RunFilter, error)
+		FindScheduleClaims(context.Context, automation.ScheduleClaimFilter) (automation.ScheduleClaimSet, automation.ScheduleClaimFilter, error)
+
+		FindExecutionByID(context.Context, uint64) (*automation.Execution, error)
+		FindExecutions(context.Context, automation.ExecutionFilter) (automation.ExecutionSet, automation.ExecutionFilter, error)
+		RequeueExecution(context.Context, *automation.Execution) error
+		DeleteExecution(context.Context, *automation.Execution) error
 	}
 
 	automationScriptAccessController interface {
@@ -130,6 +138,8 @@ func (svc automationScript) Update(ctx context.Context, namespaceID uint64, mod
 	s.RunInUA = mod.RunInUA
 	s.Timeout = mod.Timeout
 	s.Critical = mod.Critical
+	s.MaxAttempts = mod.MaxAttempts
+	s.RetryBackoff = mod.RetryBackoff
 	s.Enabled = mod.Enabled
 
 	err = mod.Triggers().Walk(func(t *automation.Trigger) error {
@@ -155,6 +165,88 @@ func (svc automationScript) Delete(ctx context.Context, namespaceID, scriptID ui
 	}
 }
 
+// FindRuns returns logged runs of the script
+//
+// Only users that can update the script can see its runs
+func (svc automationScript) FindRuns(ctx context.Context, namespaceID uint64, f automation.RunFilter) (automation.RunSet, automation.RunFilter, error) {
+	if _, s, err := svc.loadCombo(ctx, namespaceID, f.ScriptID); err != nil {
+		return nil, f, err
+	} else if s == nil {
+		return nil, f, ErrInvalidID.withStack()
+	} else if !svc.ac.CanUpdateAutomationScript(ctx, s) {
+		return nil, f, ErrNoUpdatePermissions.withStack()
+	}
+
+	return svc.scriptManager.FindRuns(ctx, f)
+}
+
+// FindScheduleClaims returns claims of scheduled runs of the script
+//
+// Claims show which instance executed each interval or deferred run;
+// only users that can update the script can see them
+func (svc automationScript) FindScheduleClaims(ctx context.Context, namespaceID uint64, f automation.ScheduleClaimFilter) (automation.ScheduleClaimSet, automation.ScheduleClaimFilter, error) {
+	if err := svc.checkExecutionAccess(ctx, namespaceID, f.ScriptID); err != nil {
+		return nil, f, err
+	}
+
+	return svc.scriptManager.FindScheduleClaims(ctx, f)
+}
+
+// FindExecutions returns queued or dead-lettered executions of the script
+//
+// Only users that can update the script can see its executions
+func (svc automationScript) FindExecutions(ctx context.Context, namespaceID uint64, f automation.ExecutionFilter) (automation.ExecutionSet, automation.ExecutionFilter, error) {
+	if err := svc.checkExecutionAccess(ctx, namespaceID, f.ScriptID); err != nil {
+		return nil, f, err
+	}
+
+	return svc.scriptManager.FindExecutions(ctx, f)
+}
+
+// RequeueExecution moves dead-lettered execution of the script back to the queue
+func (svc automationScript) RequeueExecution(ctx context.Context, namespaceID, scriptID, executionID uint64) error {
+	if e, err := svc.loadExecution(ctx, namespaceID, scriptID, executionID); err != nil {
+		return err
+	} else {
+		return svc.scriptManager.RequeueExecution(ctx, e)
+	}
+}
+
+// DeleteExecution removes execution of the script from the queue or dead-letter list
+func (svc automationScript) DeleteExecution(ctx context.Context, namespaceID, scriptID, executionID uint64) error {
+	if e, err := svc.loadExecution(ctx, namespaceID, scriptID, executionID); err != nil {
+		return err
+	} else {
+		return svc.scriptManager.DeleteExecution(ctx, e)
+	}
+}
+
+func (svc automationScript) loadExecution(ctx context.Context, namespaceID, scriptID, executionID uint64) (*automation.Execution, error) {
+	if err := svc.checkExecutionAccess(ctx, namespaceID, scriptID); err != nil {
+		return nil, err
+	}
+
+	if e, err := svc.scriptManager.FindExecutionByID(ctx, executionID); err != nil {
+		return nil, err
+	} else if e.ScriptID != scriptID {
+		return nil, ErrInvalidID.withStack()
+	} else {
+		return e, nil
+	}
+}
+
+func (svc automationScript) checkExecutionAccess(ctx context.Context, namespaceID, scriptID uint64) error {
+	if _, s, err := svc.loadCombo(ctx, namespaceID, scriptID); err != nil {
+		return err
+	} else if s == nil {
+		return ErrInvalidID.withStack()
+	} else if !svc.ac.CanUpdateAutomationScript(ctx, s) {
+		return ErrNoUpdatePermissions.withStack()
+	}
+
+	return nil
+}
+
 func (svc automationScript) loadCombo(ctx context.Context, namespaceID, scriptID uint64) (ns *types.Namespace, s *automation.Script, err error) {
 	if namespaceID == 0 {
 		err = ErrNamespaceRequired.withStack()
@@ -2,12 +2,19 @@ package service
 
 import (
 	"context"
+	"strings"
 
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
 	"github.com/cortezaproject/corteza-server/compose/types"
 	"github.com/cortezaproject/corteza-server/pkg/automation"
+	"github.com/cortezaproject/corteza-server/pkg/ql"
+)
+
+const (
+	// Prefix of fields in record trigger expression that resolve to values before the update
+	recordTriggerOldPrefix = "old."
 )
 
 type (
@@ -107,6 +114,10 @@ func (svc automationTrigger) isValid(ctx context.Context, s *automation.Script,
 		return errors.WithStack(automation.ErrAutomationTriggerInvalidResource)
 	}
 
+	if t.IsRelative() {
+		return svc.isValidRelative(ctx, s, t)
+	}
+
 	if t.IsDeferred() {
 		// @todo validate condition for deferred triggers
 		return nil
@@ -122,11 +133,17 @@ func (svc automationTrigger) isValid(ctx context.Context, s *automation.Script,
 			return errors.WithStack(automation.ErrAutomationTriggerInvalidCondition)
 		}
 
+		if t.Event == "manual" && t.Expression != "" {
+			return errors.Wrap(automation.ErrAutomationTriggerInvalidCondition, "manual triggers can not have expressions")
+		}
+
 		if moduleID > 0 {
 			if m, err := svc.mod.With(ctx).FindByID(s.NamespaceID, moduleID); err != nil {
 				return err
 			} else if !svc.ac.CanManageAutomationTriggersOnModule(ctx, m) {
 				return errors.WithStack(ErrNoTriggerManagementPermissions)
+			} else if err = checkRecordTriggerExpression(m, t.Expression); err != nil {
+				return errors.Wrap(automation.ErrAutomationTriggerInvalidCondition, err.Error())
 			}
 		}
 
@@ -136,9 +153,95 @@ func (svc automationTrigger) isValid(ctx context.Context, s *automation.Script,
 			return errors.WithStack(automation.ErrAutomationScriptMissingUser)
 		}
 
+		if t.Expression != "" {
+			return errors.Wrap(automation.ErrAutomationTriggerInvalidCondition, "deferred triggers can not have expressions")
+		}
+
 	default:
 		return errors.WithStack(automation.ErrAutomationTriggerInvalidEvent)
 	}
 
 	return nil
 }
+
+// Validates deferred trigger, relative to a date field of records
+func (svc automationTrigger) isValidRelative(ctx context.Context, s *automation.Script, t *automation.Trigger) error {
+	if s.RunAs == 0 {
+		return errors.WithStack(automation.ErrAutomationScriptMissingUser)
+	}
+
+	if t.Expression != "" {
+		return errors.Wrap(automation.ErrAutomationTriggerInvalidCondition, "deferred triggers can not have expressions")
+	}
+
+	c, err := t.RelativeCondition()
+	if err != nil {
+		return errors.Wrap(automation.ErrAutomationTriggerInvalidCondition, err.Error())
+	}
+
+	m, err := svc.mod.With(ctx).FindByID(s.NamespaceID, c.ModuleID)
+	if err != nil {
+		return err
+	}
+
+	if !svc.ac.CanManageAutomationTriggersOnModule(ctx, m) {
+		return errors.WithStack(ErrNoTriggerManagementPermissions)
+	}
+
+	if f := m.Fields.FindByName(c.Field); f == nil || !f.IsDateTime() {
+		return errors.Wrapf(automation.ErrAutomationTriggerInvalidCondition, "field %q is not a date field", c.Field)
+	}
+
+	return nil
+}
+
+// parseRecordTriggerExpression parses expression of the record trigger
+//
+// Expression can reference fields of the module and (with "old." prefix)
+// their values before the update
+func parseRecordTriggerExpression(m *types.Module, expr string) (ql.ASTNode, error) {
+	var p = ql.NewParser()
+
+	p.OnIdent = func(i ql.Ident) (ql.Ident, error) {
+		if m.Fields.FindByName(strings.TrimPrefix(i.Value, recordTriggerOldPrefix)) == nil {
+			return i, errors.Errorf("unknown field %q", i.Value)
+		}
+
+		return i, nil
+	}
+
+	return p.ParseExpression(expr)
+}
+
+// checkRecordTriggerExpression makes sure expression of the record trigger can be evaluated
+func checkRecordTriggerExpression(m *types.Module, expr string) error {
+	if strings.TrimSpace(expr) == "" {
+		return nil
+	}
+
+	n, err := parseRecordTriggerExpression(m, expr)
+	if err != nil {
+		return errors.Wrap(err, "invalid trigger expression")
+	}
+
+	// Evaluate without values to catch unsupported operators & functions
+	_, err = ql.EvaluateCondition(n, func(string) (interface{}, error) { return nil, nil })
+	return errors.Wrap(err, "invalid trigger expression")
+}
+
+// recordTriggerResolver resolves fields in the trigger expression to values of the record
+// and to values before the update (with "old." prefix)
+func recordTriggerResolver(m *types.Module, values, old types.RecordValueSet) ql.IdentResolver {
+	var (
+		current  = recordValueResolver(m, values)
+		previous = recordValueResolver(m, old)
+	)
+
+	return func(name string) (interface{}, error) {
+		if strings.HasPrefix(name, recordTriggerOldPrefix) {
+			return previous(strings.TrimPrefix(name, recordTriggerOldPrefix))
+		}
+
+		return current(name)
+	}
+}
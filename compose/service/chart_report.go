@@ -0,0 +1,214 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/permissions"
+)
+
+type (
+	// chartReportQuery aggregates metrics of one module
+	//
+	// Metrics of a chart report can be on different modules; one
+	// query is made for each module (and filter) and results are merged
+	chartReportQuery struct {
+		moduleID uint64
+		filter   string
+		metrics  []string
+	}
+)
+
+// ChartReport aggregates records for the chart report configuration
+//
+// Metrics can be on other modules than the report (metric's moduleID and filter);
+// all modules are aggregated over the same dimensions
+func (svc record) ChartReport(namespaceID uint64, cfg *types.ChartConfigReport) (out interface{}, err error) {
+	queries, dimensions, limit, err := chartReportQueries(cfg)
+	if err != nil {
+		return
+	}
+
+	var report *types.RecordReport
+
+	for _, q := range queries {
+		var (
+			m       *types.Module
+			rr      *types.RecordReport
+			metrics = strings.Join(q.metrics, ", ")
+
+			isRefReadable map[string]*permissions.ResourceFilter
+		)
+
+		if m, err = svc.loadModule(namespaceID, q.moduleID); err != nil {
+			return
+		}
+
+		if isRefReadable, err = svc.prepareReportPaths(m, metrics, dimensions, q.filter); err != nil {
+			return
+		}
+
+		rr, err = svc.recordRepo.
+			Report(m, metrics, dimensions, q.filter, svc.ac.FilterReadableRecords(svc.ctx, m), isRefReadable)
+		if err != nil {
+			return
+		}
+
+		if report == nil {
+			report = rr
+		} else {
+			report.Merge(rr)
+		}
+	}
+
+	report.Process(limit)
+	return report.Rows, nil
+}
+
+// chartReportQueries converts chart report configuration to report queries
+//
+// Returns queries (first one is on the report's module), dimensions and
+// top-N limit (see "limit" on the first dimension)
+func chartReportQueries(cfg *types.ChartConfigReport) (qq []*chartReportQuery, dimensions string, limit uint, err error) {
+	if len(cfg.Dimensions) == 0 {
+		return nil, "", 0, errors.New("chart report without dimensions")
+	}
+
+	qq = []*chartReportQuery{{moduleID: cfg.ModuleID, filter: cfg.Filter}}
+
+	for i, m := range cfg.Metrics {
+		var (
+			expr     string
+			moduleID = chartConfigUint(m, "moduleID")
+			filter   = cfg.Filter
+			q        *chartReportQuery
+		)
+
+		if expr, err = chartReportMetric(m); err != nil {
+			return
+		}
+
+		if moduleID == 0 || moduleID == cfg.ModuleID {
+			moduleID = cfg.ModuleID
+		} else {
+			filter = chartConfigString(m, "filter")
+		}
+
+		for _, existing := range qq {
+			if existing.moduleID == moduleID && existing.filter == filter {
+				q = existing
+				break
+			}
+		}
+
+		if q == nil {
+			q = &chartReportQuery{moduleID: moduleID, filter: filter}
+			qq = append(qq, q)
+		}
+
+		q.metrics = append(q.metrics, fmt.Sprintf("%s AS metric_%d", expr, i))
+	}
+
+	var dd = make([]string, len(cfg.Dimensions))
+	for i, d := range cfg.Dimensions {
+		if dd[i], err = chartReportDimension(d); err != nil {
+			return
+		}
+
+		dd[i] = fmt.Sprintf("%s AS dimension_%d", dd[i], i)
+	}
+
+	if len(qq) > 1 && len(qq[0].metrics) == 0 {
+		// All metrics are on other modules
+		qq = qq[1:]
+	}
+
+	return qq, strings.Join(dd, ", "), uint(chartConfigUint(cfg.Dimensions[0], "limit")), nil
+}
+
+// chartReportMetric converts metric configuration (field, aggregate, percentile, cumulative) to ql expression
+func chartReportMetric(m map[string]interface{}) (expr string, err error) {
+	var (
+		field     = chartConfigString(m, "field")
+		aggregate = strings.ToUpper(chartConfigString(m, "aggregate"))
+	)
+
+	switch {
+	case field == "" || field == "count":
+		expr = "COUNT(*)"
+
+	case aggregate == "":
+		expr = fmt.Sprintf("SUM(%s)", field)
+
+	case aggregate == "PERCENTILE":
+		p, ok := m["percentile"].(float64)
+		if !ok {
+			return "", errors.Errorf("percentile of metric %q not set", field)
+		}
+
+		expr = fmt.Sprintf("PERCENTILE(%s, %s)", field, strconv.FormatFloat(p, 'f', -1, 64))
+
+	default:
+		switch aggregate {
+		case "SUM", "MAX", "MIN", "AVG", "STD", "COUNTD", "MEDIAN":
+			expr = fmt.Sprintf("%s(%s)", aggregate, field)
+		default:
+			return "", errors.Errorf("unsupported aggregate %q of metric %q", aggregate, field)
+		}
+	}
+
+	if cumulative, _ := m["cumulative"].(bool); cumulative {
+		expr = fmt.Sprintf("CUMSUM(%s)", expr)
+	}
+
+	return
+}
+
+// chartReportDimension converts dimension configuration (field, modifier) to ql expression
+//
+// Date modifiers group values into time buckets
+func chartReportDimension(d map[string]interface{}) (string, error) {
+	var (
+		field    = chartConfigString(d, "field")
+		modifier = strings.ToUpper(chartConfigString(d, "modifier"))
+	)
+
+	if field == "" {
+		return "", errors.New("dimension field not set")
+	}
+
+	switch modifier {
+	case "", "(NO GROUPING / BUCKETS)":
+		return field, nil
+	case "DATE", "DAY":
+		return fmt.Sprintf("DATE_TRUNC('day', %s)", field), nil
+	case "WEEK", "MONTH", "QUARTER", "YEAR":
+		return fmt.Sprintf("DATE_TRUNC('%s', %s)", strings.ToLower(modifier), field), nil
+	}
+
+	return "", errors.Errorf("unsupported modifier %q of dimension %q", modifier, field)
+}
+
+func chartConfigString(m map[string]interface{}, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
+
+// chartConfigUint returns number from configuration; IDs are encoded as strings
+func chartConfigUint(m map[string]interface{}, key string) uint64 {
+	switch v := m[key].(type) {
+	case string:
+		n, _ := strconv.ParseUint(v, 10, 64)
+		return n
+	case float64:
+		if v > 0 {
+			return uint64(v)
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+)
+
+func TestChartReportQueries(t *testing.T) {
+	var (
+		req = require.New(t)
+		cfg = &types.ChartConfigReport{
+			ModuleID: 1,
+			Filter:   "status = 'won'",
+			Metrics: []map[string]interface{}{
+				{"field": "count"},
+				{"field": "amount", "aggregate": "sum", "cumulative": true},
+				{"field": "amount", "aggregate": "PERCENTILE", "percentile": 0.9},
+				{"field": "amount", "aggregate": "COUNTD", "moduleID": "2", "filter": "amount > 0"},
+			},
+			Dimensions: []map[string]interface{}{
+				{"field": "createdAt", "modifier": "MONTH", "limit": float64(5)},
+				{"field": "account.industry"},
+			},
+		}
+	)
+
+	qq, dimensions, limit, err := chartReportQueries(cfg)
+	req.NoError(err)
+	req.Equal("DATE_TRUNC('month', createdAt) AS dimension_0, account.industry AS dimension_1", dimensions)
+	req.Equal(uint(5), limit)
+	req.Len(qq, 2)
+	req.Equal(&chartReportQuery{
+		moduleID: 1,
+		filter:   "status = 'won'",
+		metrics:  []string{"COUNT(*) AS metric_0", "CUMSUM(SUM(amount)) AS metric_1", "PERCENTILE(amount, 0.9) AS metric_2"},
+	}, qq[0])
+	req.Equal(&chartReportQuery{
+		moduleID: 2,
+		filter:   "amount > 0",
+		metrics:  []string{"COUNTD(amount) AS metric_3"},
+	}, qq[1])
+
+	// All metrics on other module
+	cfg.Metrics = cfg.Metrics[3:]
+	qq, _, _, err = chartReportQueries(cfg)
+	req.NoError(err)
+	req.Len(qq, 1)
+	req.Equal(uint64(2), qq[0].moduleID)
+
+	cfg.Metrics = []map[string]interface{}{{"field": "amount", "aggregate": "FOO"}}
+	_, _, _, err = chartReportQueries(cfg)
+	req.Error(err)
+
+	cfg.Metrics = nil
+	cfg.Dimensions = []map[string]interface{}{{"field": "createdAt", "modifier": "DECADE"}}
+	_, _, _, err = chartReportQueries(cfg)
+	req.Error(err)
+}
@@ -18,6 +18,7 @@ const (
 	ErrNoReadPermissions                 serviceError = "NoReadPermissions"
 	ErrNoUpdatePermissions               serviceError = "NoUpdatePermissions"
 	ErrNoDeletePermissions               serviceError = "NoDeletePermissions"
+	ErrNoUndeletePermissions             serviceError = "NoUndeletePermissions"
 	ErrNoTriggerManagementPermissions    serviceError = "NoTriggerManagementPermissions"
 	ErrNamespaceRequired                 serviceError = "NamespaceRequired"
 	ErrModulePageExists                  serviceError = "ModulePageExists"
@@ -25,6 +26,12 @@ const (
 	ErrRecordImportSessionNotFound       serviceError = "RecordImportSessionNotFound"
 	ErrRecordImportSessionAlreadyStarted serviceError = "RecordImportSessionAlreadyStarted"
 	ErrRecordImportFormatNotSupported    serviceError = "RecordImportFormatNotSupported"
+	ErrRecordExportJobNotFound           serviceError = "RecordExportJobNotFound"
+	ErrRecordExportJobNotFinished        serviceError = "RecordExportJobNotFinished"
+	ErrRecordExportFormatNotSupported    serviceError = "RecordExportFormatNotSupported"
+	ErrModuleFieldConversionFailed       serviceError = "ModuleFieldConversionFailed"
+	ErrRecordReferenced                  serviceError = "RecordReferenced"
+	ErrRecordRevisionModuleMismatch      serviceError = "RecordRevisionModuleMismatch"
 )
 
 func (e serviceError) Error() string {
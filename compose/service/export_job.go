@@ -0,0 +1,527 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/cortezaproject/corteza-server/compose/encoder"
+	"github.com/cortezaproject/corteza-server/compose/repository"
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/rh"
+	"github.com/cortezaproject/corteza-server/pkg/sentry"
+	"github.com/cortezaproject/corteza-server/pkg/store"
+)
+
+const (
+	// How often are queued exports checked
+	exportWatchInterval = time.Second * 10
+
+	// Worker that runs the export signals that it is alive (and checks if the export
+	// was canceled) in this interval; export without heartbeat for
+	// exportHeartbeatTimeout is restarted by another worker
+	exportHeartbeatInterval = time.Second * 30
+	exportHeartbeatTimeout  = time.Minute * 2
+
+	// How often are expired exports removed
+	exportCleanupInterval = time.Hour
+
+	// Exported files are kept for DefaultExportLifetime unless configured otherwise
+	DefaultExportLifetime = time.Hour * 24
+)
+
+type (
+	exportJob struct {
+		logger *zap.Logger
+		store  store.Store
+		record RecordService
+		ac     exportJobAccessController
+
+		jobs    repository.RecordExportJobRepository
+		modules repository.ModuleRepository
+
+		// How long are exported files kept
+		lifetime time.Duration
+
+		// Context with identity (and roles) of the user that runs the export
+		ownerContext func(ctx context.Context, userID uint64) (context.Context, error)
+
+		// Signals queued export
+		queued chan struct{}
+
+		// Cancel functions of exports that run on this instance, by job ID
+		running *sync.Map
+	}
+
+	exportJobAccessController interface {
+		CanReadModule(context.Context, *types.Module) bool
+		CanReadRecord(context.Context, *types.Module) bool
+		CanReadOwnRecord(context.Context, *types.Module) bool
+		CanUndeleteRecord(context.Context, *types.Module) bool
+	}
+
+	ExportJobService interface {
+		FindRecordByID(ctx context.Context, jobID uint64) (*types.RecordExportJob, error)
+		CreateRecord(ctx context.Context, job *types.RecordExportJob) (*types.RecordExportJob, error)
+		OpenRecord(ctx context.Context, jobID, userID uint64) (*types.RecordExportJob, io.ReadSeeker, error)
+		DeleteRecordByID(ctx context.Context, jobID uint64) error
+
+		Watch(ctx context.Context)
+	}
+
+	// ExportEncoder writes records into the exported file
+	ExportEncoder interface {
+		Encoder
+		preparedEncoder
+		Flush()
+	}
+
+	// exportCounter counts exported records and stops the export when its context is done
+	exportCounter struct {
+		ExportEncoder
+		ctx   context.Context
+		count uint64
+	}
+)
+
+func ExportJob(store store.Store, record RecordService, lifetime time.Duration) *exportJob {
+	if lifetime <= 0 {
+		lifetime = DefaultExportLifetime
+	}
+
+	return &exportJob{
+		logger:       DefaultLogger.Named("exportJob"),
+		store:        store,
+		record:       record,
+		ac:           DefaultAccessControl,
+		jobs:         repository.RecordExportJob(context.Background(), nil),
+		modules:      repository.Module(context.Background(), nil),
+		lifetime:     lifetime,
+		ownerContext: jobOwnerContext,
+		queued:       make(chan struct{}, 1),
+		running:      &sync.Map{},
+	}
+}
+
+// RecordEncoder returns encoder for the given export format and its content type
+func RecordEncoder(format string, w io.Writer, opt encoder.Options, ff ...string) (ExportEncoder, string, error) {
+	var fields = encoder.MakeFields(ff...)
+
+	switch strings.ToLower(format) {
+	case "json", "jsonl", "ldjson", "ndjson":
+		return encoder.NewStructuredEncoder(json.NewEncoder(w), opt, fields...), "application/jsonl", nil
+
+	case "csv":
+		return encoder.NewFlatWriter(csv.NewWriter(w), true, opt, fields...), "text/csv", nil
+
+	case "xlsx":
+		return encoder.NewExcelizeEncoder(w, true, opt, fields...), "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", nil
+
+	default:
+		return nil, "", ErrRecordExportFormatNotSupported.withStack()
+	}
+}
+
+func (enc *exportCounter) Record(r *types.Record) error {
+	if enc.ctx != nil && enc.ctx.Err() != nil {
+		return enc.ctx.Err()
+	}
+
+	enc.count++
+	return enc.ExportEncoder.Record(r)
+}
+
+func (svc exportJob) repository(ctx context.Context) repository.RecordExportJobRepository {
+	return svc.jobs.With(ctx, nil)
+}
+
+// findOwned returns export job of the given user
+func (svc exportJob) findOwned(ctx context.Context, jobID, userID uint64) (*types.RecordExportJob, error) {
+	job, err := svc.repository(ctx).FindByID(jobID)
+	if err == repository.ErrRecordExportJobNotFound {
+		return nil, ErrRecordExportJobNotFound.withStack()
+	} else if err != nil {
+		return nil, err
+	}
+
+	if job.UserID != userID {
+		return nil, ErrRecordExportJobNotFound.withStack()
+	}
+
+	return job, nil
+}
+
+func (svc exportJob) FindRecordByID(ctx context.Context, jobID uint64) (*types.RecordExportJob, error) {
+	return svc.findOwned(ctx, jobID, auth.GetIdentityFromContext(ctx).Identity())
+}
+
+// CreateRecord queues the export of module records
+//
+// Expects namespace, module, name and format of the exported file and
+// exported fields to be set on the given job. Export is run in the
+// background by one of the workers, see Watch.
+//
+// User must be able to read records of the module (all or own ones); which
+// records are exported is checked again (as the user) when the export runs
+func (svc exportJob) CreateRecord(ctx context.Context, job *types.RecordExportJob) (*types.RecordExportJob, error) {
+	job.Format = strings.ToLower(job.Format)
+	job.UserID = auth.GetIdentityFromContext(ctx).Identity()
+
+	m, err := svc.modules.With(ctx, nil).FindByID(job.NamespaceID, job.ModuleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !svc.ac.CanReadModule(ctx, m) || !(svc.ac.CanReadRecord(ctx, m) || svc.ac.CanReadOwnRecord(ctx, m)) {
+		return nil, ErrNoReadPermissions.withStack()
+	}
+
+	if rh.FilterState(job.Deleted) != rh.FilterStateExcluded && !svc.ac.CanUndeleteRecord(ctx, m) {
+		// Only users that can restore records can see the trash
+		return nil, ErrNoUndeletePermissions.withStack()
+	}
+
+	if _, _, err := RecordEncoder(job.Format, ioutil.Discard, encoder.Options{}); err != nil {
+		return nil, err
+	}
+
+	if _, ok := encoder.ParseMultiValue(job.MultiValue); !ok {
+		return nil, errors.Errorf("unsupported multi-value encoding %q", job.MultiValue)
+	}
+
+	job, err = svc.repository(ctx).Create(job)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case svc.queued <- struct{}{}:
+	default:
+	}
+
+	return job, nil
+}
+
+// OpenRecord returns finished export job of the given user with the exported file
+func (svc exportJob) OpenRecord(ctx context.Context, jobID, userID uint64) (*types.RecordExportJob, io.ReadSeeker, error) {
+	job, err := svc.findOwned(ctx, jobID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if job.CanceledAt != nil {
+		return nil, nil, ErrRecordExportJobNotFound.withStack()
+	}
+
+	if !job.IsDone() || (job.ExpiresAt != nil && job.ExpiresAt.Before(time.Now())) {
+		return nil, nil, ErrRecordExportJobNotFinished.withStack()
+	}
+
+	f, err := svc.store.Open(job.Url)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not open exported file")
+	}
+
+	return job, f, nil
+}
+
+// DeleteRecordByID removes the job with the exported file
+//
+// Unfinished job is only canceled; the worker that runs it stops the export and
+// removes it (with the exported file) or, if that fails, the cleaner does
+func (svc exportJob) DeleteRecordByID(ctx context.Context, jobID uint64) error {
+	job, err := svc.FindRecordByID(ctx, jobID)
+	if err != nil {
+		return err
+	}
+
+	if job.FinishedAt == nil {
+		if err = svc.repository(ctx).Cancel(job.ID); err != nil {
+			return err
+		}
+
+		// Workers on other instances notice the cancellation with the next heartbeat
+		if cancel, ok := svc.running.Load(job.ID); ok {
+			cancel.(context.CancelFunc)()
+		}
+
+		// Export could finish before it was canceled
+		if job, err = svc.repository(ctx).FindByID(job.ID); err == repository.ErrRecordExportJobNotFound {
+			return nil
+		} else if err != nil {
+			return err
+		} else if job.CanceledAt != nil {
+			return nil
+		}
+	}
+
+	return svc.remove(ctx, job)
+}
+
+// remove deletes the job and its exported file
+func (svc exportJob) remove(ctx context.Context, job *types.RecordExportJob) error {
+	if err := svc.repository(ctx).DeleteByID(job.ID); err != nil {
+		return err
+	}
+
+	svc.removeExport(job)
+	return nil
+}
+
+func (svc exportJob) removeExport(job *types.RecordExportJob) {
+	if job.Url == "" {
+		return
+	}
+
+	if err := svc.store.Remove(job.Url); err != nil {
+		svc.logger.Warn("could not remove exported file", zap.Uint64("jobID", job.ID), zap.Error(err))
+	}
+}
+
+// Watch runs queued exports and removes expired ones
+//
+// Exports that were interrupted (server restart) are restarted
+// when their heartbeat times out
+func (svc exportJob) Watch(ctx context.Context) {
+	go func() {
+		defer sentry.Recover()
+
+		var (
+			ticker  = time.NewTicker(exportWatchInterval)
+			cleanup = time.NewTicker(exportCleanupInterval)
+		)
+
+		defer ticker.Stop()
+		defer cleanup.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				svc.runQueued(ctx)
+			case <-svc.queued:
+				svc.runQueued(ctx)
+			case <-cleanup.C:
+				svc.clean(ctx)
+			}
+		}
+	}()
+
+	svc.logger.Debug("watcher initialized")
+}
+
+// runQueued claims and runs queued exports, one by one
+func (svc exportJob) runQueued(ctx context.Context) {
+	for ctx.Err() == nil {
+		job, err := svc.repository(ctx).Claim(time.Now().Add(-exportHeartbeatTimeout))
+		if err != nil {
+			svc.logger.Error("could not claim record export job", zap.Error(err))
+			return
+		} else if job == nil {
+			return
+		}
+
+		svc.run(ctx, job)
+	}
+}
+
+func (svc exportJob) run(ctx context.Context, job *types.RecordExportJob) {
+	var (
+		log  = svc.logger.With(zap.Uint64("jobID", job.ID))
+		done = make(chan struct{})
+
+		// Canceled when the job is canceled while it runs
+		jctx, cancel = context.WithCancel(ctx)
+	)
+
+	svc.running.Store(job.ID, cancel)
+	defer svc.running.Delete(job.ID)
+	defer cancel()
+	defer close(done)
+
+	go func() {
+		defer sentry.Recover()
+
+		var ticker = time.NewTicker(exportHeartbeatInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := svc.repository(ctx).Heartbeat(job.ID); err != nil {
+					log.Warn("could not update record export heartbeat", zap.Error(err))
+				}
+
+				// Canceled on another instance
+				if cur, err := svc.repository(ctx).FindByID(job.ID); err == repository.ErrRecordExportJobNotFound || (err == nil && cur.CanceledAt != nil) {
+					cancel()
+				}
+			}
+		}
+	}()
+
+	if job.CanceledAt != nil {
+		log.Info("record export canceled")
+		if err := svc.remove(ctx, job); err != nil {
+			log.Error("could not remove canceled record export job", zap.Error(err))
+		}
+
+		return
+	}
+
+	log.Info("running record export")
+
+	var (
+		now = time.Now()
+		err error
+	)
+
+	job.StartedAt = &now
+	job.Exported = 0
+	job.FailReason = ""
+
+	if job, err = svc.repository(ctx).Update(job); err != nil {
+		log.Error("could not start record export job", zap.Error(err))
+		return
+	}
+
+	err = svc.runExport(jctx, job)
+
+	if ctx.Err() != nil {
+		// Worker was stopped (shutdown) before the export could finish;
+		// job is restarted by the next worker
+		if err = svc.repository(context.Background()).Release(job.ID); err != nil {
+			log.Warn("could not release record export job", zap.Error(err))
+		}
+
+		log.Info("record export interrupted")
+		return
+	}
+
+	if jctx.Err() != nil {
+		log.Info("record export canceled")
+		if err = svc.remove(ctx, job); err != nil {
+			log.Error("could not remove canceled record export job", zap.Error(err))
+		}
+
+		return
+	}
+
+	if err != nil {
+		log.Error("record export failed", zap.Error(err))
+		job.FailReason = err.Error()
+	}
+
+	var (
+		fa = time.Now()
+		ea = fa.Add(svc.lifetime)
+	)
+
+	job.FinishedAt = &fa
+	job.ExpiresAt = &ea
+
+	if _, err = svc.repository(ctx).Update(job); err != nil {
+		log.Error("could not finish record export job", zap.Error(err))
+		return
+	}
+
+	log.Info("record export finished", zap.Uint64("exported", job.Exported))
+
+	// Job deleted while running; when canceled after this check,
+	// the job is removed by the cleaner
+	if cur, err := svc.repository(ctx).FindByID(job.ID); err == nil && cur.CanceledAt != nil {
+		log.Info("removing canceled record export")
+		if err = svc.remove(ctx, job); err != nil {
+			log.Error("could not remove canceled record export job", zap.Error(err))
+		}
+	}
+}
+
+// runExport encodes records into temporary file and moves it into the store
+func (svc exportJob) runExport(ctx context.Context, job *types.RecordExportJob) error {
+	octx, err := svc.ownerContext(ctx, job.UserID)
+	if err != nil {
+		return errors.Wrap(err, "could not resolve identity of the export owner")
+	}
+
+	tmp, err := ioutil.TempFile("", "record-export-")
+	if err != nil {
+		return errors.Wrap(err, "could not create temporary file")
+	}
+
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	opt := encoder.Options{
+		Delimiter: job.MultiValueDelimiter,
+		Labels:    job.Labels,
+	}
+
+	opt.MultiValue, _ = encoder.ParseMultiValue(job.MultiValue)
+
+	enc, _, err := RecordEncoder(job.Format, tmp, opt, job.Fields...)
+	if err != nil {
+		return err
+	}
+
+	var (
+		cnt = &exportCounter{ExportEncoder: enc, ctx: ctx}
+
+		f = types.RecordFilter{
+			NamespaceID: job.NamespaceID,
+			ModuleID:    job.ModuleID,
+			Filter:      job.Filter,
+			Sort:        job.Sort,
+			Deleted:     rh.FilterState(job.Deleted),
+		}
+	)
+
+	if err = svc.record.With(octx).Export(f, cnt); err != nil {
+		return err
+	}
+
+	cnt.Flush()
+	job.Exported = cnt.count
+
+	if _, err = tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	job.Url = svc.store.Original(job.ID, job.Format)
+	if err = svc.store.Save(job.Url, tmp); err != nil {
+		job.Url = ""
+		return errors.Wrap(err, "could not store exported file")
+	}
+
+	return nil
+}
+
+// clean removes expired and canceled jobs with their exported files
+func (svc exportJob) clean(ctx context.Context) {
+	set, err := svc.repository(ctx).FindExpired(time.Now())
+	if err != nil {
+		svc.logger.Error("could not find expired record export jobs", zap.Error(err))
+		return
+	}
+
+	_ = set.Walk(func(job *types.RecordExportJob) error {
+		if err := svc.remove(ctx, job); err != nil {
+			svc.logger.Error("could not remove expired record export job", zap.Uint64("jobID", job.ID), zap.Error(err))
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,411 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/titpetric/factory"
+	"go.uber.org/zap"
+
+	"github.com/cortezaproject/corteza-server/compose/encoder"
+	"github.com/cortezaproject/corteza-server/compose/repository"
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/rh"
+)
+
+type (
+	// In-memory record export job repository
+	testExportJobs struct {
+		l    sync.Mutex
+		jobs map[uint64]types.RecordExportJob
+		next uint64
+	}
+
+	testExportModules struct {
+		repository.ModuleRepository
+	}
+
+	testExportAccessControl struct {
+		read, readOwn, undelete bool
+	}
+
+	// Record service that exports one record and calls the hook while exporting
+	testExportRecords struct {
+		RecordService
+		exported int
+		during   func()
+	}
+)
+
+func (r *testExportJobs) With(context.Context, *factory.DB) repository.RecordExportJobRepository {
+	return r
+}
+
+func (r *testExportJobs) FindByID(ID uint64) (*types.RecordExportJob, error) {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	if job, ok := r.jobs[ID]; ok {
+		return &job, nil
+	}
+
+	return nil, repository.ErrRecordExportJobNotFound
+}
+
+func (r *testExportJobs) FindExpired(now time.Time) (set types.RecordExportJobSet, err error) {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	for _, job := range r.jobs {
+		if job.FinishedAt != nil && (job.CanceledAt != nil || job.ExpiresAt.Before(now)) {
+			job := job
+			set = append(set, &job)
+		}
+	}
+
+	return
+}
+
+func (r *testExportJobs) Create(job *types.RecordExportJob) (*types.RecordExportJob, error) {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	r.next++
+	job.ID = r.next
+	job.CreatedAt = time.Now()
+	r.jobs[job.ID] = *job
+	return job, nil
+}
+
+// Update keeps cancel and heartbeat times, same as the database repository
+func (r *testExportJobs) Update(job *types.RecordExportJob) (*types.RecordExportJob, error) {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	old, ok := r.jobs[job.ID]
+	if !ok {
+		return job, nil
+	}
+
+	upd := *job
+	upd.CanceledAt, upd.HeartbeatAt = old.CanceledAt, old.HeartbeatAt
+	r.jobs[job.ID] = upd
+	return job, nil
+}
+
+func (r *testExportJobs) Claim(staleBefore time.Time) (*types.RecordExportJob, error) {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	for ID, job := range r.jobs {
+		if job.FinishedAt != nil || (job.HeartbeatAt != nil && !job.HeartbeatAt.Before(staleBefore)) {
+			continue
+		}
+
+		now := time.Now()
+		job.HeartbeatAt = &now
+		r.jobs[ID] = job
+		return &job, nil
+	}
+
+	return nil, nil
+}
+
+func (r *testExportJobs) Heartbeat(uint64) error {
+	return nil
+}
+
+func (r *testExportJobs) Release(ID uint64) error {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	if job, ok := r.jobs[ID]; ok {
+		job.HeartbeatAt = nil
+		r.jobs[ID] = job
+	}
+
+	return nil
+}
+
+func (r *testExportJobs) Cancel(ID uint64) error {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	if job, ok := r.jobs[ID]; ok && job.FinishedAt == nil && job.CanceledAt == nil {
+		now := time.Now()
+		job.CanceledAt = &now
+		r.jobs[ID] = job
+	}
+
+	return nil
+}
+
+func (r *testExportJobs) DeleteByID(ID uint64) error {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	delete(r.jobs, ID)
+	return nil
+}
+
+func (testExportModules) With(context.Context, *factory.DB) repository.ModuleRepository {
+	return testExportModules{}
+}
+
+func (testExportModules) FindByID(namespaceID, moduleID uint64) (*types.Module, error) {
+	return &types.Module{ID: moduleID, NamespaceID: namespaceID}, nil
+}
+
+func (testExportAccessControl) CanReadModule(context.Context, *types.Module) bool {
+	return true
+}
+
+func (ac testExportAccessControl) CanReadRecord(context.Context, *types.Module) bool {
+	return ac.read
+}
+
+func (ac testExportAccessControl) CanReadOwnRecord(context.Context, *types.Module) bool {
+	return ac.readOwn
+}
+
+func (ac testExportAccessControl) CanUndeleteRecord(context.Context, *types.Module) bool {
+	return ac.undelete
+}
+
+func (svc *testExportRecords) With(context.Context) RecordService {
+	return svc
+}
+
+func (svc *testExportRecords) Export(_ types.RecordFilter, enc Encoder) error {
+	if svc.during != nil {
+		svc.during()
+	}
+
+	svc.exported++
+	return enc.Record(&types.Record{ID: 42})
+}
+
+func testExportJobService(ac testExportAccessControl) (*exportJob, *testExportJobs, testImportStore, *testExportRecords) {
+	DefaultLogger = zap.NewNop()
+
+	var (
+		jobs    = &testExportJobs{jobs: map[uint64]types.RecordExportJob{}}
+		store   = testImportStore{}
+		records = &testExportRecords{}
+		svc     = ExportJob(store, records, 0)
+	)
+
+	svc.ac = ac
+	svc.jobs = jobs
+	svc.modules = testExportModules{}
+	svc.ownerContext = func(ctx context.Context, _ uint64) (context.Context, error) {
+		return ctx, nil
+	}
+
+	return svc, jobs, store, records
+}
+
+func TestRecordEncoder(t *testing.T) {
+	var (
+		req = require.New(t)
+		buf = &bytes.Buffer{}
+	)
+
+	enc, ct, err := RecordEncoder("CSV", buf, encoder.Options{}, "recordID")
+	req.NoError(err)
+	req.Equal("text/csv", ct)
+
+	cnt := &exportCounter{ExportEncoder: enc}
+	req.NoError(cnt.Record(&types.Record{ID: 42}))
+	cnt.Flush()
+	req.Equal(uint64(1), cnt.count)
+	req.Equal("recordID\n42\n", buf.String())
+
+	for _, format := range []string{"json", "jsonl", "ldjson", "ndjson", "xlsx"} {
+		_, _, err = RecordEncoder(format, buf, encoder.Options{})
+		req.NoError(err, format)
+	}
+
+	_, _, err = RecordEncoder("ods", buf, encoder.Options{})
+	req.Error(err)
+	req.Equal(ErrRecordExportFormatNotSupported, errors.Cause(err))
+}
+
+func TestExportJobCreateRecord(t *testing.T) {
+	var (
+		ctx = auth.SetIdentityToContext(context.Background(), auth.NewIdentity(1))
+
+		tcc = []struct {
+			name    string
+			ac      testExportAccessControl
+			deleted rh.FilterState
+			err     error
+		}{
+			{"all records", testExportAccessControl{read: true}, rh.FilterStateExcluded, nil},
+			{"own records", testExportAccessControl{readOwn: true}, rh.FilterStateExcluded, nil},
+			{"no records", testExportAccessControl{}, rh.FilterStateExcluded, ErrNoReadPermissions},
+			{"trash", testExportAccessControl{read: true}, rh.FilterStateInclusive, ErrNoUndeletePermissions},
+			{"trash with undelete", testExportAccessControl{read: true, undelete: true}, rh.FilterStateExclusive, nil},
+		}
+	)
+
+	for _, tc := range tcc {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				req = require.New(t)
+
+				svc, jobs, _, _ = testExportJobService(tc.ac)
+			)
+
+			job, err := svc.CreateRecord(ctx, &types.RecordExportJob{Format: "CSV", Deleted: uint(tc.deleted)})
+			if tc.err != nil {
+				req.Equal(tc.err, errors.Cause(err))
+				req.Empty(jobs.jobs)
+				return
+			}
+
+			req.NoError(err)
+			req.Equal("csv", job.Format)
+			req.Equal(uint64(1), job.UserID)
+			req.Len(jobs.jobs, 1)
+		})
+	}
+}
+
+func TestExportJobDeleteRecordByID(t *testing.T) {
+	var (
+		ctx = auth.SetIdentityToContext(context.Background(), auth.NewIdentity(1))
+		ac  = testExportAccessControl{read: true}
+	)
+
+	t.Run("Finished", func(t *testing.T) {
+		var (
+			req = require.New(t)
+
+			svc, jobs, store, _ = testExportJobService(ac)
+		)
+
+		job, err := svc.CreateRecord(ctx, &types.RecordExportJob{Format: "csv"})
+		req.NoError(err)
+
+		svc.runQueued(ctx)
+		job, err = svc.FindRecordByID(ctx, job.ID)
+		req.NoError(err)
+		req.True(job.IsDone())
+		req.Contains(store, job.Url)
+
+		req.NoError(svc.DeleteRecordByID(ctx, job.ID))
+		req.Empty(jobs.jobs)
+		req.Empty(store)
+	})
+
+	t.Run("Queued", func(t *testing.T) {
+		var (
+			req = require.New(t)
+
+			svc, jobs, store, records = testExportJobService(ac)
+		)
+
+		job, err := svc.CreateRecord(ctx, &types.RecordExportJob{Format: "csv"})
+		req.NoError(err)
+
+		req.NoError(svc.DeleteRecordByID(ctx, job.ID))
+		job, err = svc.FindRecordByID(ctx, job.ID)
+		req.NoError(err)
+		req.NotNil(job.CanceledAt)
+
+		_, _, err = svc.OpenRecord(ctx, job.ID, 1)
+		req.Equal(ErrRecordExportJobNotFound, errors.Cause(err))
+
+		// Canceled job is removed by the worker, without exporting
+		svc.runQueued(ctx)
+		req.Empty(jobs.jobs)
+		req.Empty(store)
+		req.Zero(records.exported)
+	})
+
+	t.Run("Running", func(t *testing.T) {
+		var (
+			req = require.New(t)
+
+			svc, jobs, store, records = testExportJobService(ac)
+		)
+
+		job, err := svc.CreateRecord(ctx, &types.RecordExportJob{Format: "csv"})
+		req.NoError(err)
+
+		records.during = func() {
+			req.NoError(svc.DeleteRecordByID(ctx, job.ID))
+			req.Len(jobs.jobs, 1, "running job should only be canceled")
+		}
+
+		svc.runQueued(ctx)
+		req.Equal(1, records.exported)
+		req.Empty(jobs.jobs)
+		req.Empty(store, "canceled export should not be stored")
+	})
+
+	t.Run("Canceled after finish check", func(t *testing.T) {
+		var (
+			req = require.New(t)
+
+			svc, jobs, store, _ = testExportJobService(ac)
+			now                 = time.Now()
+		)
+
+		// Finished job that was canceled just before it finished
+		job, err := svc.CreateRecord(ctx, &types.RecordExportJob{Format: "csv"})
+		req.NoError(err)
+		job.Url = "original/1.csv"
+		job.FinishedAt, job.ExpiresAt, job.CanceledAt = &now, &now, &now
+		jobs.jobs[job.ID] = *job
+		store[job.Url] = []byte{}
+
+		svc.clean(ctx)
+		req.Empty(jobs.jobs)
+		req.Empty(store)
+	})
+}
+
+func TestExportJobInterrupt(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = auth.SetIdentityToContext(context.Background(), auth.NewIdentity(1))
+
+		svc, jobs, store, records = testExportJobService(testExportAccessControl{read: true})
+
+		wctx, stop = context.WithCancel(ctx)
+	)
+
+	job, err := svc.CreateRecord(ctx, &types.RecordExportJob{Format: "csv"})
+	req.NoError(err)
+
+	// Worker is stopped (shutdown) while exporting
+	records.during = stop
+	svc.runQueued(wctx)
+
+	job, err = svc.FindRecordByID(ctx, job.ID)
+	req.NoError(err)
+	req.Nil(job.FinishedAt, "interrupted export should not be finished")
+	req.Empty(job.FailReason)
+	req.Nil(job.HeartbeatAt, "interrupted export should be released")
+	req.Empty(store)
+
+	// Next worker restarts it
+	records.during = nil
+	svc.runQueued(ctx)
+
+	job, err = svc.FindRecordByID(ctx, job.ID)
+	req.NoError(err)
+	req.True(job.IsDone())
+	req.Empty(job.FailReason)
+	req.Equal(uint64(1), job.Exported)
+	req.Contains(store, job.Url)
+	req.Len(jobs.jobs, 1)
+}
@@ -2,132 +2,483 @@ package service
 
 import (
 	"context"
-	"sync"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/titpetric/factory"
+	"go.uber.org/zap"
 
+	"github.com/cortezaproject/corteza-server/compose/decoder"
+	"github.com/cortezaproject/corteza-server/compose/repository"
+	"github.com/cortezaproject/corteza-server/compose/types"
 	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/sentry"
+	"github.com/cortezaproject/corteza-server/pkg/store"
+)
 
-	"go.uber.org/zap"
+const (
+	// How often are queued imports checked
+	importWatchInterval = time.Second * 10
+
+	// Worker that runs the import signals that it is alive in this interval;
+	// import without heartbeat for importHeartbeatTimeout is taken over by another worker
+	importHeartbeatInterval = time.Second * 30
+	importHeartbeatTimeout  = time.Minute * 2
+
+	// Sessions (and uploaded files) are removed when unchanged for importSessionLifetime
+	importCleanupInterval = time.Hour
+	importSessionLifetime = time.Hour * 24 * 3
 )
 
 type (
-	recordSet []*RecordImportSession
-
 	importSession struct {
-		l      sync.Mutex
 		logger *zap.Logger
+		store  store.Store
+		record RecordService
+
+		sessions repository.RecordImportSessionRepository
+		modules  repository.ModuleRepository
+
+		// Context with identity (and roles) of the user that runs the import
+		ownerContext func(ctx context.Context, userID uint64) (context.Context, error)
 
-		records recordSet
+		// Signals queued import
+		queued chan struct{}
 	}
 
 	ImportSessionService interface {
-		FindRecordByID(ctx context.Context, sessionID uint64) (*RecordImportSession, error)
-		SetRecordByID(ctx context.Context, sessionID, namespaceID, moduleID uint64, fields map[string]string, progress *RecordImportProgress, decoder Decoder) (*RecordImportSession, error)
+		FindRecordByID(ctx context.Context, sessionID uint64) (*types.RecordImportSession, error)
+		FindRecordFailures(ctx context.Context, sessionID uint64) (types.RecordImportFailureSet, error)
+		CreateRecord(ctx context.Context, ses *types.RecordImportSession, upload io.ReadSeeker) (*types.RecordImportSession, error)
+		RunRecord(ctx context.Context, sessionID uint64, fields map[string]string, onError string, upsertKeys []string) (*types.RecordImportSession, error)
+		CancelRecord(ctx context.Context, sessionID uint64) (*types.RecordImportSession, error)
 		DeleteRecordByID(ctx context.Context, sessionID uint64) error
+
+		Watch(ctx context.Context)
 	}
 )
 
-func ImportSession() *importSession {
+func ImportSession(store store.Store, record RecordService) *importSession {
 	return &importSession{
-		logger:  DefaultLogger.Named("importSession"),
-		records: recordSet{},
+		logger:       DefaultLogger.Named("importSession"),
+		store:        store,
+		record:       record,
+		sessions:     repository.RecordImportSession(context.Background(), nil),
+		modules:      repository.Module(context.Background(), nil),
+		ownerContext: jobOwnerContext,
+		queued:       make(chan struct{}, 1),
 	}
 }
 
-func (svc importSession) indexOf(userID, sessionID uint64) int {
-	for i, r := range svc.records {
-		if r.SessionID == sessionID && r.UserID == userID {
-			return i
-		}
+// recordDecoder returns decoder for the imported file format
+//
+// Spreadsheets are read from the session's sheet; cells are converted to
+// the format of the given module fields
+func recordDecoder(ses *types.RecordImportSession, f io.ReadSeeker, ff types.ModuleFieldSet) (Decoder, error) {
+	var opt = decoder.SpreadsheetOptions{
+		Sheet:     ses.Sheet,
+		HeaderRow: int(ses.HeaderRow),
+		Fields:    ff,
+	}
+
+	switch ses.Format {
+	case "json", "jsonl", "ldjson", "ndjson":
+		return decoder.NewStructuredDecoder(json.NewDecoder(f), f), nil
+
+	case "csv":
+		return decoder.NewFlatReader(csv.NewReader(f), f), nil
+
+	case "xlsx":
+		return spreadsheetDecoder(decoder.NewXlsxDecoder(f, opt))
+
+	case "ods":
+		return spreadsheetDecoder(decoder.NewOdsDecoder(f, opt))
+
+	default:
+		return nil, ErrRecordImportFormatNotSupported.withStack()
+	}
+}
+
+func spreadsheetDecoder(dec Decoder, err error) (Decoder, error) {
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read spreadsheet")
 	}
 
-	return -1
+	return dec, nil
 }
 
-func (svc *importSession) FindRecordByID(ctx context.Context, sessionID uint64) (*RecordImportSession, error) {
-	svc.l.Lock()
-	defer svc.l.Unlock()
+// jobOwnerContext returns context with identity of the user
+//
+// Roles of the user are resolved by the system service (same way as for automation scripts)
+func jobOwnerContext(ctx context.Context, userID uint64) (context.Context, error) {
+	token, err := DefaultSystemUser.MakeJWT(auth.SetSuperUserContext(ctx), userID)
+	if err != nil {
+		return nil, err
+	}
 
-	userID := auth.GetIdentityFromContext(ctx).Identity()
-	i := svc.indexOf(userID, sessionID)
-	if i >= 0 {
-		return svc.records[i], nil
+	identity, err := auth.DefaultJwtHandler.Decode(token)
+	if err != nil {
+		return nil, err
 	}
-	return nil, ErrRecordImportSessionNotFound
+
+	return auth.SetJwtToContext(auth.SetIdentityToContext(ctx, identity), token), nil
 }
 
-func (svc *importSession) SetRecordByID(ctx context.Context, sessionID, namespaceID, moduleID uint64, fields map[string]string, progress *RecordImportProgress, decoder Decoder) (*RecordImportSession, error) {
-	svc.l.Lock()
-	defer svc.l.Unlock()
+func (svc importSession) repository(ctx context.Context) repository.RecordImportSessionRepository {
+	return svc.sessions.With(ctx, nil)
+}
 
-	userID := auth.GetIdentityFromContext(ctx).Identity()
-	i := svc.indexOf(userID, sessionID)
-	var ris *RecordImportSession
+// findOwned returns import session of the current user
+func (svc importSession) findOwned(ctx context.Context, sessionID uint64) (*types.RecordImportSession, error) {
+	ses, err := svc.repository(ctx).FindByID(sessionID)
+	if err == repository.ErrRecordImportSessionNotFound {
+		return nil, ErrRecordImportSessionNotFound.withStack()
+	} else if err != nil {
+		return nil, err
+	}
 
-	if i >= 0 {
-		ris = svc.records[i]
-	} else {
-		ris = &RecordImportSession{
-			SessionID: factory.Sonyflake.NextID(),
-			CreatedAt: time.Now(),
+	if ses.UserID != auth.GetIdentityFromContext(ctx).Identity() {
+		return nil, ErrRecordImportSessionNotFound.withStack()
+	}
+
+	return ses, nil
+}
+
+func (svc importSession) FindRecordByID(ctx context.Context, sessionID uint64) (*types.RecordImportSession, error) {
+	ses, err := svc.findOwned(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ses.Failed > 0 {
+		if ses.FailLog, err = svc.repository(ctx).FindFailures(ses.ID, importFailLogLimit); err != nil {
+			return nil, err
 		}
-		svc.records = append(svc.records, ris)
-		ris.UserID = userID
 	}
-	ris.UpdatedAt = time.Now()
 
-	if namespaceID > 0 {
-		ris.NamespaceID = namespaceID
+	return ses, nil
+}
+
+// FindRecordFailures returns all entries that failed to import
+func (svc importSession) FindRecordFailures(ctx context.Context, sessionID uint64) (types.RecordImportFailureSet, error) {
+	ses, err := svc.findOwned(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.repository(ctx).FindFailures(ses.ID, 0)
+}
+
+// CreateRecord stores the uploaded file and prepares new import session
+//
+// Expects namespace, module, name and format of the uploaded file (and for
+// spreadsheets, optional sheet and header row) to be set on the given session.
+//
+// Session's fields are initialized with the header of the uploaded file
+func (svc importSession) CreateRecord(ctx context.Context, ses *types.RecordImportSession, upload io.ReadSeeker) (*types.RecordImportSession, error) {
+	ses.Format = strings.ToLower(ses.Format)
+	ses.UserID = auth.GetIdentityFromContext(ctx).Identity()
+	ses.Fields = types.RecordImportFields{}
+	ses.UpsertKeys = types.RecordImportKeys{}
+
+	dec, err := recordDecoder(ses, upload, nil)
+	if err != nil {
+		return nil, err
 	}
-	if moduleID > 0 {
-		ris.ModuleID = moduleID
+
+	if s, ok := dec.(interface{ Sheets() []string }); ok {
+		ses.Sheets = s.Sheets()
 	}
-	if fields != nil {
-		ris.Fields = fields
+
+	if ses.EntryCount, err = dec.EntryCount(); err != nil {
+		return nil, err
 	}
-	if progress != nil {
-		ris.Progress = *progress
+
+	for _, h := range dec.Header() {
+		ses.Fields[h] = ""
+	}
+
+	if _, err = upload.Seek(0, io.SeekStart); err != nil {
+		return nil, err
 	}
 
-	if ris.Progress.FinishedAt != nil {
-		ris.Decoder = nil
-	} else if decoder != nil {
-		ris.Decoder = decoder
+	ses.Url = svc.store.Original(factory.Sonyflake.NextID(), ses.Format)
+	if err = svc.store.Save(ses.Url, upload); err != nil {
+		return nil, errors.Wrap(err, "could not store imported file")
 	}
 
-	return ris, nil
+	return svc.repository(ctx).Create(ses)
 }
 
-// https://stackoverflow.com/a/37335777
-func remove(s recordSet, i int) recordSet {
-	s[len(s)-1], s[i] = s[i], s[len(s)-1]
-	return s[:len(s)-1]
+// RunRecord queues the import
+//
+// Import is run in the background by one of the workers, see Watch
+func (svc importSession) RunRecord(ctx context.Context, sessionID uint64, fields map[string]string, onError string, upsertKeys []string) (*types.RecordImportSession, error) {
+	ses, err := svc.findOwned(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ses.StartedAt != nil || ses.FinishedAt != nil {
+		// Already running, finished or canceled
+		return nil, ErrRecordImportSessionAlreadyStarted.withStack()
+	}
+
+	sa := time.Now()
+	ses.StartedAt = &sa
+	ses.Fields = fields
+	ses.OnError = strings.ToUpper(onError)
+	ses.UpsertKeys = upsertKeys
+
+	if ses, err = svc.repository(ctx).Update(ses); err != nil {
+		return nil, err
+	}
+
+	select {
+	case svc.queued <- struct{}{}:
+	default:
+	}
+
+	return ses, nil
 }
 
-func (svc *importSession) DeleteRecordByID(ctx context.Context, sessionID uint64) error {
-	svc.l.Lock()
-	defer svc.l.Unlock()
+// CancelRecord cancels the import
+//
+// Running import is stopped (and the session finished) by its worker
+func (svc importSession) CancelRecord(ctx context.Context, sessionID uint64) (*types.RecordImportSession, error) {
+	ses, err := svc.findOwned(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ses.FinishedAt != nil {
+		return ses, nil
+	}
 
-	userID := auth.GetIdentityFromContext(ctx).Identity()
-	i := svc.indexOf(userID, sessionID)
+	if err = svc.repository(ctx).Cancel(ses.ID); err != nil {
+		return nil, err
+	}
+
+	if ses.StartedAt == nil {
+		// Nothing to stop
+		fa := time.Now()
+		ses.FinishedAt = &fa
+		if _, err = svc.repository(ctx).Update(ses); err != nil {
+			return nil, err
+		}
+
+		svc.removeUpload(ses)
+	}
+
+	return svc.FindRecordByID(ctx, ses.ID)
+}
 
-	if i >= 0 {
-		svc.records = remove(svc.records, i)
+// DeleteRecordByID removes the session with the uploaded file and the failure report
+//
+// Running import is stopped
+func (svc importSession) DeleteRecordByID(ctx context.Context, sessionID uint64) error {
+	ses, err := svc.findOwned(ctx, sessionID)
+	if err != nil {
+		return err
 	}
+
+	if err = svc.repository(ctx).DeleteByID(ses.ID); err != nil {
+		return err
+	}
+
+	if ses.FinishedAt != nil || ses.StartedAt == nil {
+		svc.removeUpload(ses)
+	}
+
 	return nil
 }
 
-// @todo run this in some interval
-func (svc *importSession) clean(ctx context.Context) {
-	svc.l.Lock()
-	defer svc.l.Unlock()
+func (svc importSession) removeUpload(ses *types.RecordImportSession) {
+	if err := svc.store.Remove(ses.Url); err != nil {
+		svc.logger.Warn("could not remove imported file", zap.Uint64("sessionID", ses.ID), zap.Error(err))
+	}
+}
+
+// Watch runs queued imports and removes expired sessions
+//
+// Imports interrupted by a shutdown are released and continued by the next
+// worker; imports of workers that died are continued when their heartbeat times out
+func (svc importSession) Watch(ctx context.Context) {
+	go func() {
+		defer sentry.Recover()
+
+		var (
+			ticker  = time.NewTicker(importWatchInterval)
+			cleanup = time.NewTicker(importCleanupInterval)
+		)
+
+		defer ticker.Stop()
+		defer cleanup.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				svc.runQueued(ctx)
+			case <-svc.queued:
+				svc.runQueued(ctx)
+			case <-cleanup.C:
+				svc.clean(ctx)
+			}
+		}
+	}()
+
+	svc.logger.Debug("watcher initialized")
+}
+
+// runQueued claims and runs queued imports, one by one
+func (svc importSession) runQueued(ctx context.Context) {
+	for ctx.Err() == nil {
+		ses, err := svc.repository(ctx).Claim(time.Now().Add(-importHeartbeatTimeout))
+		if err != nil {
+			svc.logger.Error("could not claim record import session", zap.Error(err))
+			return
+		} else if ses == nil {
+			return
+		}
+
+		svc.run(ctx, ses)
+	}
+}
+
+func (svc importSession) run(ctx context.Context, ses *types.RecordImportSession) {
+	var (
+		log  = svc.logger.With(zap.Uint64("sessionID", ses.ID))
+		done = make(chan struct{})
+	)
+
+	defer close(done)
+
+	go func() {
+		defer sentry.Recover()
+
+		var ticker = time.NewTicker(importHeartbeatInterval)
+		defer ticker.Stop()
 
-	for i := len(svc.records) - 1; i >= 0; i-- {
-		r := svc.records[i]
-		if time.Now().After(r.UpdatedAt.Add(time.Hour * 24 * 3)) {
-			svc.records = remove(svc.records, i)
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := svc.repository(ctx).Heartbeat(ses.ID); err != nil {
+					log.Warn("could not update record import heartbeat", zap.Error(err))
+				}
+			}
 		}
+	}()
+
+	log.Info("running record import", zap.Uint64("processed", ses.Processed()))
+
+	err := svc.runImport(ctx, ses)
+
+	if ctx.Err() != nil && ses.FinishedAt == nil {
+		// Worker was stopped (shutdown) before the import could finish;
+		// session (and imported file) is kept and resumed by the next worker
+		svc.release(ses)
+		log.Info("record import interrupted", zap.Uint64("processed", ses.Processed()))
+		return
 	}
+
+	if err != nil {
+		log.Error("record import failed", zap.Error(err))
+
+		if ses.FinishedAt == nil {
+			// Failed before import could finish the session;
+			// it would fail again when resumed
+			fa := time.Now()
+			ses.FinishedAt = &fa
+			ses.FailReason = err.Error()
+
+			if _, err = svc.repository(ctx).Update(ses); err != nil {
+				// Imported file is kept; session is resumed when its heartbeat times out
+				log.Error("could not finish record import session", zap.Error(err))
+				return
+			}
+		}
+	}
+
+	log.Info("record import finished",
+		zap.Uint64("completed", ses.Completed),
+		zap.Uint64("failed", ses.Failed),
+	)
+
+	svc.removeUpload(ses)
+}
+
+// release stores progress of the interrupted import and releases the session
+//
+// Worker's context is already canceled so the session is stored without it
+func (svc importSession) release(ses *types.RecordImportSession) {
+	var (
+		log  = svc.logger.With(zap.Uint64("sessionID", ses.ID))
+		repo = svc.repository(context.Background())
+	)
+
+	if _, err := repo.Update(ses); err != nil {
+		log.Error("could not store progress of interrupted record import", zap.Error(err))
+	}
+
+	if err := repo.Release(ses.ID); err != nil {
+		log.Warn("could not release record import session", zap.Error(err))
+	}
+}
+
+func (svc importSession) runImport(ctx context.Context, ses *types.RecordImportSession) error {
+	octx, err := svc.ownerContext(ctx, ses.UserID)
+	if err != nil {
+		return errors.Wrap(err, "could not resolve identity of the import owner")
+	}
+
+	f, err := svc.store.Open(ses.Url)
+	if err != nil {
+		return errors.Wrap(err, "could not open imported file")
+	}
+
+	if c, ok := f.(io.Closer); ok {
+		defer c.Close()
+	}
+
+	ff, err := svc.modules.With(ctx, nil).FindFields(ses.ModuleID)
+	if err != nil {
+		return err
+	}
+
+	dec, err := recordDecoder(ses, f, ff)
+	if err != nil {
+		return err
+	}
+
+	return svc.record.With(octx).Import(ses, dec)
+}
+
+// clean removes expired sessions with their uploaded files
+func (svc importSession) clean(ctx context.Context) {
+	set, err := svc.repository(ctx).FindExpired(time.Now().Add(-importSessionLifetime))
+	if err != nil {
+		svc.logger.Error("could not find expired record import sessions", zap.Error(err))
+		return
+	}
+
+	_ = set.Walk(func(ses *types.RecordImportSession) error {
+		if err := svc.repository(ctx).DeleteByID(ses.ID); err != nil {
+			svc.logger.Error("could not remove expired record import session", zap.Uint64("sessionID", ses.ID), zap.Error(err))
+			return nil
+		}
+
+		if ses.FinishedAt == nil {
+			svc.removeUpload(ses)
+		}
+
+		return nil
+	})
 }
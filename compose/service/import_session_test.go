@@ -1,110 +1,526 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"github.com/titpetric/factory"
 	"go.uber.org/zap"
+
+	"github.com/cortezaproject/corteza-server/compose/repository"
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
 )
 
-var ctx context.Context = context.WithValue(context.Background(), "testing", true)
+type (
+	// In-memory record import session repository
+	testImportSessions struct {
+		l  sync.Mutex
+		ss map[uint64]types.RecordImportSession
+	}
+
+	// In-memory store with uploaded files
+	testImportStore map[string][]byte
+
+	testImportModules struct {
+		repository.ModuleRepository
+	}
+
+	// Record service that only counts imports
+	testImportRecords struct {
+		RecordService
+		sessions *testImportSessions
+		imported []uint64
+
+		// Called (to stop the worker) after the first imported entry
+		interrupt func()
+	}
+)
+
+func (r *testImportSessions) With(context.Context, *factory.DB) repository.RecordImportSessionRepository {
+	return r
+}
+
+func (r *testImportSessions) FindByID(ID uint64) (*types.RecordImportSession, error) {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	if ses, ok := r.ss[ID]; ok {
+		return &ses, nil
+	}
+
+	return nil, repository.ErrRecordImportSessionNotFound
+}
+
+func (r *testImportSessions) FindExpired(time.Time) (types.RecordImportSessionSet, error) {
+	return nil, nil
+}
+
+func (r *testImportSessions) Create(ses *types.RecordImportSession) (*types.RecordImportSession, error) {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	ses.ID = factory.Sonyflake.NextID()
+	ses.CreatedAt = time.Now()
+	r.ss[ses.ID] = *ses
+	return ses, nil
+}
+
+// Update keeps cancel and heartbeat times, same as the database repository
+func (r *testImportSessions) Update(ses *types.RecordImportSession) (*types.RecordImportSession, error) {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	upd := *ses
+	if old, ok := r.ss[ses.ID]; ok {
+		upd.CanceledAt, upd.HeartbeatAt = old.CanceledAt, old.HeartbeatAt
+	}
+
+	r.ss[ses.ID] = upd
+	return ses, nil
+}
+
+func (r *testImportSessions) Claim(staleBefore time.Time) (*types.RecordImportSession, error) {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	for ID, ses := range r.ss {
+		if !ses.IsQueued() || (ses.HeartbeatAt != nil && !ses.HeartbeatAt.Before(staleBefore)) {
+			continue
+		}
+
+		now := time.Now()
+		ses.HeartbeatAt = &now
+		r.ss[ID] = ses
+		return &ses, nil
+	}
+
+	return nil, nil
+}
+
+func (r *testImportSessions) Heartbeat(uint64) error {
+	return nil
+}
+
+func (r *testImportSessions) Release(ID uint64) error {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	if ses, ok := r.ss[ID]; ok {
+		ses.HeartbeatAt = nil
+		r.ss[ID] = ses
+	}
+
+	return nil
+}
+
+func (r *testImportSessions) Cancel(ID uint64) error {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	if ses, ok := r.ss[ID]; ok && ses.FinishedAt == nil && ses.CanceledAt == nil {
+		now := time.Now()
+		ses.CanceledAt = &now
+		r.ss[ID] = ses
+	}
+
+	return nil
+}
+
+func (r *testImportSessions) DeleteByID(ID uint64) error {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	delete(r.ss, ID)
+	return nil
+}
+
+func (r *testImportSessions) FindFailures(uint64, uint) (types.RecordImportFailureSet, error) {
+	return nil, nil
+}
+
+func (r *testImportSessions) CreateFailure(*types.RecordImportFailure) error {
+	return nil
+}
+
+func (s testImportStore) Original(id uint64, ext string) string {
+	return "original/" + strconv.FormatUint(id, 10) + "." + ext
+}
+
+func (s testImportStore) Preview(id uint64, ext string) string {
+	return "preview/" + strconv.FormatUint(id, 10) + "." + ext
+}
+
+func (s testImportStore) Save(filename string, f io.Reader) (err error) {
+	s[filename], err = ioutil.ReadAll(f)
+	return
+}
+
+func (s testImportStore) Remove(filename string) error {
+	delete(s, filename)
+	return nil
+}
+
+func (s testImportStore) Open(filename string) (io.ReadSeeker, error) {
+	if b, ok := s[filename]; ok {
+		return bytes.NewReader(b), nil
+	}
+
+	return nil, errors.New("file not found")
+}
+
+func (testImportModules) With(context.Context, *factory.DB) repository.ModuleRepository {
+	return testImportModules{}
+}
+
+func (testImportModules) FindFields(...uint64) (types.ModuleFieldSet, error) {
+	return nil, nil
+}
+
+func (svc *testImportRecords) With(context.Context) RecordService {
+	return svc
+}
+
+// Import "imports" remaining entries and finishes the session
+func (svc *testImportRecords) Import(ses *types.RecordImportSession, dec Decoder) error {
+	svc.imported = append(svc.imported, ses.Processed())
+
+	if svc.interrupt != nil {
+		// Progress is stored with the imported entry
+		ses.Completed++
+		if _, err := svc.sessions.Update(ses); err != nil {
+			return err
+		}
+
+		svc.interrupt()
+		return errors.Wrap(context.Canceled, "record import interrupted")
+	}
+
+	fa := time.Now()
+	ses.Completed = ses.EntryCount - ses.Failed
+	ses.FinishedAt = &fa
+
+	_, err := svc.sessions.Update(ses)
+	return err
+}
+
+func testImportSessionService(t *testing.T) (*importSession, *testImportSessions, testImportStore, *testImportRecords) {
+	if factory.Sonyflake.Sonyflake == nil {
+		// Sonyflake could not resolve machine ID (no private IP address)
+		t.Skip("ID generator is not available")
+	}
+
+	DefaultLogger = zap.NewNop()
+
+	var (
+		sessions = &testImportSessions{ss: map[uint64]types.RecordImportSession{}}
+		store    = testImportStore{}
+		records  = &testImportRecords{sessions: sessions}
+		svc      = ImportSession(store, records)
+	)
+
+	svc.sessions = sessions
+	svc.modules = testImportModules{}
+	svc.ownerContext = func(ctx context.Context, _ uint64) (context.Context, error) {
+		return ctx, nil
+	}
+
+	return svc, sessions, store, records
+}
+
+func testImportUpload() io.ReadSeeker {
+	return strings.NewReader("fname,femail\nv1,v2\nv3,v4\n")
+}
 
-func TestFindRecordByID(t *testing.T) {
-	DefaultLogger = zap.New(nil, nil)
-	svc := ImportSession()
-	ss, _ := svc.SetRecordByID(ctx, 1, 0, 0, nil, nil, nil)
-	sid := ss.SessionID
+func TestImportSessionFindRecordByID(t *testing.T) {
+	var (
+		req   = require.New(t)
+		ctx   = auth.SetIdentityToContext(context.Background(), auth.NewIdentity(1))
+		other = auth.SetIdentityToContext(context.Background(), auth.NewIdentity(2))
+
+		svc, _, _, _ = testImportSessionService(t)
+	)
+
+	ses, err := svc.CreateRecord(ctx, &types.RecordImportSession{Format: "csv"}, testImportUpload())
+	req.NoError(err)
 
 	t.Run("Found", func(t *testing.T) {
-		s, err := svc.FindRecordByID(ctx, sid)
-		require.True(t,
-			s != nil,
-			"Session should be found",
-		)
-
-		require.True(t,
-			err == nil,
-			"Returned with error",
-		)
+		req := require.New(t)
+		s, err := svc.FindRecordByID(ctx, ses.ID)
+		req.NoError(err)
+		req.NotNil(s)
+		req.Equal(ses.ID, s.ID)
 	})
 
 	t.Run("Not found", func(t *testing.T) {
-		s, err := svc.FindRecordByID(ctx, sid+1)
-		require.True(t,
-			s == nil,
-			"Session should not be found",
-		)
-
-		require.True(t,
-			err != nil,
-			"Error should not be nil",
-		)
+		req := require.New(t)
+		s, err := svc.FindRecordByID(ctx, ses.ID+1)
+		req.Nil(s)
+		req.Equal(ErrRecordImportSessionNotFound, errors.Cause(err))
+	})
+
+	t.Run("Session of another user", func(t *testing.T) {
+		req := require.New(t)
+		s, err := svc.FindRecordByID(other, ses.ID)
+		req.Nil(s)
+		req.Equal(ErrRecordImportSessionNotFound, errors.Cause(err))
 	})
 }
 
-func TestSetRecordByID(t *testing.T) {
-	DefaultLogger = zap.New(nil, nil)
-	svc := ImportSession()
+func TestImportSessionCreateRecord(t *testing.T) {
+	var ctx = auth.SetIdentityToContext(context.Background(), auth.NewIdentity(1))
 
 	t.Run("New", func(t *testing.T) {
-		ss, err := svc.SetRecordByID(ctx, 1, 0, 0, nil, nil, nil)
-		require.True(t,
-			len(svc.records) == 1 && ss != nil,
-			"Session should be created",
-		)
-
-		require.True(t,
-			err == nil,
-			"Returned with error",
-		)
+		req := require.New(t)
+		svc, sessions, store, _ := testImportSessionService(t)
+
+		ses, err := svc.CreateRecord(ctx, &types.RecordImportSession{Format: "CSV"}, testImportUpload())
+		req.NoError(err)
+		req.Len(sessions.ss, 1)
+		req.Equal("csv", ses.Format)
+		req.Equal(uint64(1), ses.UserID)
+		req.Equal(uint64(2), ses.EntryCount)
+		req.Equal(types.RecordImportFields{"fname": "", "femail": ""}, ses.Fields)
+		req.Contains(store, ses.Url)
 	})
 
 	t.Run("Existing", func(t *testing.T) {
-		svc := ImportSession()
-		ss, err := svc.SetRecordByID(ctx, 1, 0, 0, nil, nil, nil)
-		ns, err := svc.SetRecordByID(ctx, ss.SessionID, 0, 0, nil, nil, nil)
-		require.True(t,
-			len(svc.records) == 1 && ns != nil && ss.SessionID == ns.SessionID,
-			"Existing session should be edited",
-		)
-
-		require.True(t,
-			err == nil,
-			"Returned with error",
-		)
+		req := require.New(t)
+		svc, sessions, _, _ := testImportSessionService(t)
+
+		ses, err := svc.CreateRecord(ctx, &types.RecordImportSession{Format: "csv"}, testImportUpload())
+		req.NoError(err)
+
+		run, err := svc.RunRecord(ctx, ses.ID, map[string]string{"fname": "name"}, "skip", nil)
+		req.NoError(err)
+		req.Len(sessions.ss, 1)
+		req.Equal(ses.ID, run.ID)
+		req.NotNil(run.StartedAt)
+		req.Equal("SKIP", run.OnError)
+		req.Equal(types.RecordImportFields{"fname": "name"}, run.Fields)
+
+		_, err = svc.RunRecord(ctx, ses.ID, nil, "", nil)
+		req.Equal(ErrRecordImportSessionAlreadyStarted, errors.Cause(err))
 	})
 }
 
-func TestDeleteRecordByID(t *testing.T) {
-	DefaultLogger = zap.New(nil, nil)
-	svc := ImportSession()
-	ss, _ := svc.SetRecordByID(ctx, 1, 0, 0, nil, nil, nil)
+func TestImportSessionDeleteRecordByID(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = auth.SetIdentityToContext(context.Background(), auth.NewIdentity(1))
+
+		svc, sessions, store, _ = testImportSessionService(t)
+	)
+
+	ses, err := svc.CreateRecord(ctx, &types.RecordImportSession{Format: "csv"}, testImportUpload())
+	req.NoError(err)
 
 	t.Run("Delete existing", func(t *testing.T) {
-		err := svc.DeleteRecordByID(ctx, ss.SessionID)
-		require.True(t,
-			len(svc.records) == 0,
-			"Session should be deleted",
-		)
-
-		require.True(t,
-			err == nil,
-			"Returned with error",
-		)
+		req := require.New(t)
+		req.NoError(svc.DeleteRecordByID(ctx, ses.ID))
+		req.Len(sessions.ss, 0)
+		req.NotContains(store, ses.Url)
 	})
 
 	t.Run("Session not found", func(t *testing.T) {
-		ss, _ := svc.SetRecordByID(ctx, 1, 0, 0, nil, nil, nil)
-		err := svc.DeleteRecordByID(ctx, ss.SessionID+1)
-		require.True(t,
-			len(svc.records) == 1,
-			"Session should not deleted",
-		)
-
-		require.True(t,
-			err == nil,
-			"Returned with error",
-		)
+		req := require.New(t)
+		ses, err := svc.CreateRecord(ctx, &types.RecordImportSession{Format: "csv"}, testImportUpload())
+		req.NoError(err)
+
+		err = svc.DeleteRecordByID(ctx, ses.ID+1)
+		req.Equal(ErrRecordImportSessionNotFound, errors.Cause(err))
+		req.Len(sessions.ss, 1)
 	})
 }
+
+func TestImportSessionClaim(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = auth.SetIdentityToContext(context.Background(), auth.NewIdentity(1))
+
+		svc, sessions, store, records = testImportSessionService(t)
+	)
+
+	ses, err := svc.CreateRecord(ctx, &types.RecordImportSession{Format: "csv"}, testImportUpload())
+	req.NoError(err)
+
+	// Not started sessions are not claimed
+	svc.runQueued(ctx)
+	req.Empty(records.imported)
+
+	_, err = svc.RunRecord(ctx, ses.ID, nil, "", nil)
+	req.NoError(err)
+
+	svc.runQueued(ctx)
+	svc.runQueued(ctx)
+	req.Equal([]uint64{0}, records.imported, "session should be imported exactly once")
+
+	ses, err = sessions.FindByID(ses.ID)
+	req.NoError(err)
+	req.NotNil(ses.FinishedAt)
+	req.Equal(uint64(2), ses.Completed)
+	req.NotContains(store, ses.Url, "imported file should be removed")
+
+	// Session with a live worker is not taken over
+	ses, err = svc.CreateRecord(ctx, &types.RecordImportSession{Format: "csv"}, testImportUpload())
+	req.NoError(err)
+	ses, err = svc.RunRecord(ctx, ses.ID, nil, "", nil)
+	req.NoError(err)
+
+	hb := time.Now()
+	ses.HeartbeatAt = &hb
+	sessions.ss[ses.ID] = *ses
+
+	svc.runQueued(ctx)
+	req.Len(records.imported, 1)
+}
+
+func TestImportSessionResume(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = auth.SetIdentityToContext(context.Background(), auth.NewIdentity(1))
+
+		svc, sessions, _, records = testImportSessionService(t)
+	)
+
+	ses, err := svc.CreateRecord(ctx, &types.RecordImportSession{Format: "csv"}, testImportUpload())
+	req.NoError(err)
+	ses, err = svc.RunRecord(ctx, ses.ID, nil, "", nil)
+	req.NoError(err)
+
+	// Worker died after the first entry
+	hb := time.Now().Add(-importHeartbeatTimeout * 2)
+	ses.HeartbeatAt = &hb
+	ses.Completed = 1
+	sessions.ss[ses.ID] = *ses
+
+	svc.runQueued(ctx)
+	req.Equal([]uint64{1}, records.imported, "import should continue from the last processed entry")
+
+	ses, err = sessions.FindByID(ses.ID)
+	req.NoError(err)
+	req.NotNil(ses.FinishedAt)
+}
+
+func TestImportSessionInterrupt(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = auth.SetIdentityToContext(context.Background(), auth.NewIdentity(1))
+
+		svc, sessions, store, records = testImportSessionService(t)
+	)
+
+	ses, err := svc.CreateRecord(ctx, &types.RecordImportSession{Format: "csv"}, testImportUpload())
+	req.NoError(err)
+	ses, err = svc.RunRecord(ctx, ses.ID, nil, "", nil)
+	req.NoError(err)
+
+	// Worker is stopped (shutdown) after the first entry
+	wctx, cancel := context.WithCancel(ctx)
+	records.interrupt = cancel
+	svc.runQueued(wctx)
+
+	ses, err = sessions.FindByID(ses.ID)
+	req.NoError(err)
+	req.Nil(ses.FinishedAt, "interrupted session should not be finished")
+	req.Empty(ses.FailReason)
+	req.Nil(ses.HeartbeatAt, "interrupted session should be released")
+	req.Equal(uint64(1), ses.Completed)
+	req.Contains(store, ses.Url, "imported file should be kept")
+
+	// Next worker continues without waiting for the heartbeat timeout
+	records.interrupt = nil
+	svc.runQueued(ctx)
+	req.Equal([]uint64{0, 1}, records.imported)
+
+	ses, err = sessions.FindByID(ses.ID)
+	req.NoError(err)
+	req.NotNil(ses.FinishedAt)
+	req.Empty(ses.FailReason)
+	req.NotContains(store, ses.Url)
+}
+
+func TestImportSessionCancelRecord(t *testing.T) {
+	var ctx = auth.SetIdentityToContext(context.Background(), auth.NewIdentity(1))
+
+	t.Run("Not started", func(t *testing.T) {
+		req := require.New(t)
+		svc, _, store, records := testImportSessionService(t)
+
+		ses, err := svc.CreateRecord(ctx, &types.RecordImportSession{Format: "csv"}, testImportUpload())
+		req.NoError(err)
+
+		ses, err = svc.CancelRecord(ctx, ses.ID)
+		req.NoError(err)
+		req.NotNil(ses.CanceledAt)
+		req.NotNil(ses.FinishedAt)
+		req.NotContains(store, ses.Url)
+
+		// Canceled session can not be run
+		_, err = svc.RunRecord(ctx, ses.ID, nil, "", nil)
+		req.Equal(ErrRecordImportSessionAlreadyStarted, errors.Cause(err))
+		svc.runQueued(ctx)
+		req.Empty(records.imported)
+	})
+
+	t.Run("Running", func(t *testing.T) {
+		req := require.New(t)
+		svc, sessions, store, _ := testImportSessionService(t)
+
+		ses, err := svc.CreateRecord(ctx, &types.RecordImportSession{Format: "csv"}, testImportUpload())
+		req.NoError(err)
+		ses, err = svc.RunRecord(ctx, ses.ID, nil, "", nil)
+		req.NoError(err)
+
+		ses, err = svc.CancelRecord(ctx, ses.ID)
+		req.NoError(err)
+		req.NotNil(ses.CanceledAt)
+		req.Nil(ses.FinishedAt, "running import is finished by its worker")
+		req.Contains(store, ses.Url)
+
+		// Repeated cancel keeps the first cancellation
+		again, err := svc.CancelRecord(ctx, ses.ID)
+		req.NoError(err)
+		req.Equal(ses.CanceledAt, again.CanceledAt)
+		req.Len(sessions.ss, 1)
+	})
+}
+
+func TestRecordDecoder(t *testing.T) {
+	var (
+		req = require.New(t)
+		src = strings.NewReader("fname,femail\nv1,v2\n")
+	)
+
+	dec, err := recordDecoder(&types.RecordImportSession{Format: "csv"}, src, nil)
+	req.NoError(err)
+
+	cnt, err := dec.EntryCount()
+	req.NoError(err)
+	req.Equal(uint64(1), cnt)
+
+	for _, format := range []string{"json", "jsonl", "ldjson", "ndjson"} {
+		_, err = recordDecoder(&types.RecordImportSession{Format: format}, strings.NewReader(`{"fname":"v1"}`), nil)
+		req.NoError(err, format)
+	}
+
+	for _, format := range []string{"xlsx", "ods"} {
+		// Not a spreadsheet
+		_, err = recordDecoder(&types.RecordImportSession{Format: format}, src, nil)
+		req.Error(err, format)
+		req.NotEqual(ErrRecordImportFormatNotSupported, errors.Cause(err), format)
+	}
+
+	_, err = recordDecoder(&types.RecordImportSession{Format: "xml"}, src, nil)
+	req.Error(err)
+	req.Equal(ErrRecordImportFormatNotSupported, errors.Cause(err))
+}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	automation "github.com/cortezaproject/corteza-server/pkg/automation"
 	gomock "github.com/golang/mock/gomock"
+	factory "github.com/titpetric/factory"
 	reflect "reflect"
 )
 
@@ -58,6 +59,18 @@ func (mr *MockautomationScriptsFinderMockRecorder) WatchScheduled(ctx, runner in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchScheduled", reflect.TypeOf((*MockautomationScriptsFinder)(nil).WatchScheduled), ctx, runner)
 }
 
+// WatchQueue mocks base method
+func (m *MockautomationScriptsFinder) WatchQueue(ctx context.Context, x automation.QueueExecutor) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "WatchQueue", ctx, x)
+}
+
+// WatchQueue indicates an expected call of WatchQueue
+func (mr *MockautomationScriptsFinderMockRecorder) WatchQueue(ctx, x interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchQueue", reflect.TypeOf((*MockautomationScriptsFinder)(nil).WatchQueue), ctx, x)
+}
+
 // FindRunnableScripts mocks base method
 func (m *MockautomationScriptsFinder) FindRunnableScripts(resource, event string, cc ...automation.TriggerConditionChecker) automation.ScriptSet {
 	m.ctrl.T.Helper()
@@ -77,6 +90,34 @@ func (mr *MockautomationScriptsFinderMockRecorder) FindRunnableScripts(resource,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRunnableScripts", reflect.TypeOf((*MockautomationScriptsFinder)(nil).FindRunnableScripts), varargs...)
 }
 
+// LogRun mocks base method
+func (m *MockautomationScriptsFinder) LogRun(ctx context.Context, run *automation.Run) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogRun", ctx, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogRun indicates an expected call of LogRun
+func (mr *MockautomationScriptsFinderMockRecorder) LogRun(ctx, run interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogRun", reflect.TypeOf((*MockautomationScriptsFinder)(nil).LogRun), ctx, run)
+}
+
+// Enqueue mocks base method
+func (m *MockautomationScriptsFinder) Enqueue(ctx context.Context, db *factory.DB, e *automation.Execution) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, db, e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue
+func (mr *MockautomationScriptsFinderMockRecorder) Enqueue(ctx, db, e interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockautomationScriptsFinder)(nil).Enqueue), ctx, db, e)
+}
+
 // MockautomationRunnerAccessControler is a mock of automationRunnerAccessControler interface
 type MockautomationRunnerAccessControler struct {
 	ctrl     *gomock.Controller
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 
+	"github.com/pkg/errors"
 	"github.com/titpetric/factory"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -25,6 +26,7 @@ type (
 		moduleRepo repository.ModuleRepository
 		recordRepo repository.RecordRepository
 		pageRepo   repository.PageRepository
+		chartRepo  repository.ChartRepository
 		nsRepo     repository.NamespaceRepository
 	}
 
@@ -48,6 +50,7 @@ type (
 
 		Create(module *types.Module) (*types.Module, error)
 		Update(module *types.Module) (*types.Module, error)
+		UpdateDryRun(module *types.Module) ([]*types.ModuleFieldMigration, error)
 		DeleteByID(namespaceID, moduleID uint64) error
 	}
 )
@@ -71,6 +74,7 @@ func (svc module) With(ctx context.Context) ModuleService {
 		moduleRepo: repository.Module(ctx, db),
 		recordRepo: repository.Record(ctx, db),
 		pageRepo:   repository.Page(ctx, db),
+		chartRepo:  repository.Chart(ctx, db),
 		nsRepo:     repository.Namespace(ctx, db),
 	}
 }
@@ -158,6 +162,10 @@ func (svc module) Create(mod *types.Module) (*types.Module, error) {
 		return nil, err
 	}
 
+	if err := svc.checkFieldConstraints(mod); err != nil {
+		return nil, err
+	}
+
 	if ns, err := svc.loadNamespace(mod.NamespaceID); err != nil {
 		return nil, err
 	} else if !svc.ac.CanCreateModule(svc.ctx, ns) {
@@ -169,15 +177,97 @@ func (svc module) Create(mod *types.Module) (*types.Module, error) {
 		return nil, err
 	}
 
-	err = svc.moduleRepo.UpdateFields(mod.ID, mod.Fields, false)
+	err = svc.moduleRepo.UpdateFields(mod.ID, mod.Fields)
 	if err != nil {
 		return nil, err
 	}
 
+	if err = svc.recordRepo.UpdateIndex(mod); err != nil {
+		return nil, err
+	}
+
+	rollupDeps.reset(mod.NamespaceID)
+
 	return mod, nil
 }
 
+// Update updates module and its fields
+//
+// Values of renamed fields and fields with changed kind are migrated; update fails
+// when any of the values can not be converted to the new kind (see UpdateDryRun)
 func (svc module) Update(mod *types.Module) (m *types.Module, err error) {
+	if m, err = svc.updateCheck(mod); err != nil {
+		return
+	}
+
+	m.Name = mod.Name
+	m.Handle = mod.Handle
+	m.Meta = mod.Meta
+	m.Fields = mod.Fields
+
+	err = svc.db.Transaction(func() (err error) {
+		var (
+			existing types.ModuleFieldSet
+			mm       []*types.ModuleFieldMigration
+		)
+
+		if existing, err = svc.moduleRepo.FindFields(m.ID); err != nil {
+			return
+		}
+
+		if mm, err = svc.planFieldMigrations(m, existing, m.Fields); err != nil {
+			return
+		}
+
+		if m, err = svc.moduleRepo.Update(m); err != nil {
+			return
+		}
+
+		if err = svc.moduleRepo.UpdateFields(m.ID, m.Fields); err != nil {
+			return
+		}
+
+		if err = svc.migrateFields(m, mm); err != nil {
+			return
+		}
+
+		if computedFieldsChanged(existing, m.Fields) {
+			if err = svc.recomputeValues(m); err != nil {
+				return
+			}
+		}
+
+		return svc.recomputeRollups(m, rollupFieldsChanged(existing, m.Fields))
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	rollupDeps.reset(m.NamespaceID)
+
+	// (Re)build record index when indexed fields changed
+	if err = svc.recordRepo.UpdateIndex(m); err != nil {
+		return nil, err
+	}
+
+	return m, err
+}
+
+// UpdateDryRun reports how values of renamed fields and fields with
+// changed kind would be migrated, without updating anything
+func (svc module) UpdateDryRun(mod *types.Module) ([]*types.ModuleFieldMigration, error) {
+	if m, err := svc.updateCheck(mod); err != nil {
+		return nil, err
+	} else if existing, err := svc.moduleRepo.FindFields(m.ID); err != nil {
+		return nil, err
+	} else {
+		return svc.planFieldMigrations(m, existing, mod.Fields)
+	}
+}
+
+// updateCheck validates updated module and returns the existing one
+func (svc module) updateCheck(mod *types.Module) (m *types.Module, err error) {
 	if mod.ID == 0 {
 		return nil, ErrInvalidID.withStack()
 	}
@@ -194,34 +284,26 @@ func (svc module) Update(mod *types.Module) (m *types.Module, err error) {
 		return
 	}
 
-	if isStale(mod.UpdatedAt, m.UpdatedAt, m.CreatedAt) {
-		return nil, ErrStaleData.withStack()
+	// Computed fields follow renamed fields
+	if existing, err := svc.moduleRepo.FindFields(mod.ID); err != nil {
+		return nil, err
+	} else {
+		renameInComputedExpressions(existing, mod.Fields)
 	}
 
-	if !svc.ac.CanUpdateModule(svc.ctx, m) {
-		return nil, ErrNoUpdatePermissions.withStack()
+	if err = svc.checkFieldConstraints(mod); err != nil {
+		return
 	}
 
-	m.Name = mod.Name
-	m.Handle = mod.Handle
-	m.Meta = mod.Meta
-	m.Fields = mod.Fields
-
-	m, err = svc.moduleRepo.Update(m)
-	if err != nil {
-		return nil, err
+	if isStale(mod.UpdatedAt, m.UpdatedAt, m.CreatedAt) {
+		return nil, ErrStaleData.withStack()
 	}
 
-	_, ff, err := svc.recordRepo.Find(m, types.RecordFilter{})
-	if err != nil {
-		return nil, err
-	}
-	err = svc.moduleRepo.UpdateFields(m.ID, m.Fields, ff.Count > 0)
-	if err != nil {
-		return nil, err
+	if !svc.ac.CanUpdateModule(svc.ctx, m) {
+		return nil, ErrNoUpdatePermissions.withStack()
 	}
 
-	return m, err
+	return m, nil
 }
 
 func (svc module) DeleteByID(namespaceID, moduleID uint64) error {
@@ -239,7 +321,14 @@ func (svc module) DeleteByID(namespaceID, moduleID uint64) error {
 		return ErrNoDeletePermissions.withStack()
 	}
 
-	return svc.moduleRepo.DeleteByID(namespaceID, moduleID)
+	if err := svc.moduleRepo.DeleteByID(namespaceID, moduleID); err != nil {
+		return err
+	}
+
+	rollupDeps.reset(namespaceID)
+
+	// Module without fields has no record index
+	return svc.recordRepo.UpdateIndex(&types.Module{ID: moduleID})
 }
 
 func (svc module) UniqueCheck(m *types.Module) (err error) {
@@ -258,6 +347,50 @@ func (svc module) UniqueCheck(m *types.Module) (err error) {
 	return nil
 }
 
+// Makes sure unique constraints on fields are scoped by existing single-value fields,
+// that only single-value fields are indexed and that computed fields have valid expressions
+func (svc module) checkFieldConstraints(m *types.Module) error {
+	return m.Fields.Walk(func(f *types.ModuleField) error {
+		if f.Multi && f.Options.Bool("indexed") {
+			return errors.Errorf("multi-value field %q can not be indexed", f.Name)
+		}
+
+		if f.IsComputed() {
+			if err := checkComputedField(m, f); err != nil {
+				return err
+			}
+		}
+
+		switch f.RefOnDelete() {
+		case types.RecordRefOnDeleteNone, types.RecordRefOnDeleteRestrict, types.RecordRefOnDeleteSetNull, types.RecordRefOnDeleteCascade:
+		default:
+			return errors.Errorf("invalid onDelete option %q on field %q", f.RefOnDelete(), f.Name)
+		}
+
+		if f.IsRollup() {
+			child, err := svc.rollupModule(m, f)
+			if err != nil {
+				return err
+			}
+
+			if err = checkRollupField(m, f, child); err != nil {
+				return err
+			}
+		}
+
+		uc := f.UniqueConstraint()
+		if uc == nil || uc.Scope == "" {
+			return nil
+		}
+
+		if sf := m.Fields.FindByName(uc.Scope); sf == nil || sf.Name == f.Name || sf.Multi {
+			return errors.Errorf("invalid unique constraint scope %q on field %q", uc.Scope, f.Name)
+		}
+
+		return nil
+	})
+}
+
 func (svc module) loadNamespace(namespaceID uint64) (ns *types.Namespace, err error) {
 	if namespaceID == 0 {
 		return nil, ErrNamespaceRequired.withStack()
@@ -0,0 +1,393 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/ql"
+	"github.com/cortezaproject/corteza-server/pkg/rh"
+)
+
+var (
+	// Kinds that hold references (IDs); values of other kinds can not be converted to them
+	moduleFieldRefKinds = map[string]bool{
+		"Record": true,
+		"User":   true,
+		"Owner":  true,
+		"File":   true,
+	}
+)
+
+// fieldMigrations compares existing and updated fields and
+// returns migrations for fields that were renamed or had their kind changed
+//
+// Values are not loaded here, see planFieldMigrations
+func fieldMigrations(existing, updated types.ModuleFieldSet) (mm []*types.ModuleFieldMigration, err error) {
+	for _, f := range updated {
+		e := existing.FindByID(f.ID)
+		if f.ID == 0 || e == nil || (e.Name == f.Name && e.Kind == f.Kind) {
+			continue
+		}
+
+		if e.Name != f.Name {
+			// Values are renamed in place; new name must not be used by
+			// any other existing field or values would get mixed up
+			if o := existing.FindByName(f.Name); o != nil && o.ID != f.ID {
+				return nil, errors.Errorf("can not rename field %q to %q, name is used by another field", e.Name, f.Name)
+			}
+		}
+
+		if e.Kind != f.Kind && moduleFieldRefKinds[f.Kind] {
+			return nil, errors.Errorf("can not change kind of field %q from %s to %s", e.Name, e.Kind, f.Kind)
+		}
+
+		mm = append(mm, &types.ModuleFieldMigration{
+			FieldID: f.ID,
+			OldName: e.Name,
+			Name:    f.Name,
+			OldKind: e.Kind,
+			Kind:    f.Kind,
+		})
+	}
+
+	return
+}
+
+// convertRecordValue converts (non-empty) value to the kind of the field
+//
+// Converted value is checked with the value validator of the new kind
+func convertRecordValue(f *types.ModuleField, v *types.RecordValue) (*types.RecordValue, *types.RecordValueError) {
+	var (
+		c = *v
+	)
+
+	// Old value might have been a reference
+	c.Ref = 0
+	c.Name = f.Name
+
+	switch f.Kind {
+	case "Number":
+		c.Value = strings.TrimSpace(c.Value)
+		if b, err := strconv.ParseBool(c.Value); err == nil {
+			c.Value = boolRecordValue(b)
+		}
+
+	case "Bool":
+		switch strings.ToLower(strings.TrimSpace(c.Value)) {
+		case "1", "t", "true", "y", "yes", "on":
+			c.Value = boolRecordValue(true)
+		case "0", "f", "false", "n", "no", "off":
+			c.Value = boolRecordValue(false)
+		}
+
+	case "DateTime", "Email", "Url", "Select":
+		c.Value = strings.TrimSpace(c.Value)
+	}
+
+	if fn, ok := recordValueValidators[f.Kind]; ok {
+		if e := fn(f, &c); e != nil {
+			return nil, e
+		}
+	}
+
+	return &c, nil
+}
+
+func boolRecordValue(b bool) string {
+	if b {
+		return "1"
+	}
+
+	return "0"
+}
+
+// planFieldMigrations loads values of all migrated fields and converts them to the new kind
+//
+// Values that can not be converted are reported in the migration
+func (svc module) planFieldMigrations(m *types.Module, existing, updated types.ModuleFieldSet) (mm []*types.ModuleFieldMigration, err error) {
+	if mm, err = fieldMigrations(existing, updated); err != nil {
+		return
+	}
+
+	for _, fm := range mm {
+		var (
+			f   = updated.FindByID(fm.FieldID)
+			rvs types.RecordValueSet
+		)
+
+		if rvs, err = svc.recordRepo.LoadFieldValues(m.ID, fm.OldName); err != nil {
+			return
+		}
+
+		fm.Values = uint(len(rvs))
+
+		if fm.OldKind == fm.Kind {
+			continue
+		}
+
+		for _, v := range rvs {
+			if strings.TrimSpace(v.Value) == "" {
+				continue
+			}
+
+			if c, e := convertRecordValue(f, v); e != nil {
+				fm.Failed = append(fm.Failed, &types.ModuleFieldMigrationFailure{
+					RecordID: v.RecordID,
+					Place:    v.Place,
+					Value:    v.Value,
+					Kind:     e.Kind,
+					Message:  e.Message,
+				})
+			} else if c.Value != v.Value || v.Ref > 0 {
+				fm.Converted = append(fm.Converted, c)
+			}
+		}
+	}
+
+	return
+}
+
+// migrateFields renames and converts record values and
+// updates references to renamed fields in charts and pages
+func (svc module) migrateFields(m *types.Module, mm []*types.ModuleFieldMigration) (err error) {
+	var (
+		renamed = map[string]string{}
+	)
+
+	for _, fm := range mm {
+		if len(fm.Failed) > 0 {
+			return ErrModuleFieldConversionFailed.withStack()
+		}
+
+		if fm.IsRenamed() {
+			renamed[fm.OldName] = fm.Name
+
+			if err = svc.recordRepo.RenameValues(m.ID, fm.OldName, fm.Name); err != nil {
+				return
+			}
+		}
+
+		if len(fm.Converted) > 0 {
+			if err = svc.recordRepo.PartialUpdateValues(fm.Converted...); err != nil {
+				return
+			}
+		}
+	}
+
+	if len(renamed) == 0 {
+		return nil
+	}
+
+	cc, _, err := svc.chartRepo.Find(types.ChartFilter{NamespaceID: m.NamespaceID})
+	if err != nil {
+		return
+	}
+
+	for _, c := range cc {
+		var changed bool
+		for from, to := range renamed {
+			changed = c.Config.RenameField(m.ID, from, to) || changed
+		}
+
+		if changed {
+			if _, err = svc.chartRepo.Update(c); err != nil {
+				return
+			}
+		}
+	}
+
+	pp, _, err := svc.pageRepo.Find(types.PageFilter{NamespaceID: m.NamespaceID})
+	if err != nil {
+		return
+	}
+
+	for _, p := range pp {
+		var changed bool
+		for from, to := range renamed {
+			changed = p.RenameField(m.ID, from, to) || changed
+		}
+
+		if changed {
+			if _, err = svc.pageRepo.Update(p); err != nil {
+				return
+			}
+		}
+	}
+
+	return nil
+}
+
+// renameInComputedExpressions replaces old names of renamed fields
+// in expressions of (updated) computed fields
+//
+// Name is not replaced when another field in the updated set uses it
+func renameInComputedExpressions(existing, updated types.ModuleFieldSet) {
+	for _, e := range existing {
+		f := updated.FindByID(e.ID)
+		if f == nil || f.Name == e.Name || updated.FindByName(e.Name) != nil {
+			continue
+		}
+
+		_ = updated.Walk(func(c *types.ModuleField) error {
+			if !c.IsComputed() {
+				return nil
+			}
+
+			if expr := ql.RenameIdent(c.ComputedExpression(), e.Name, f.Name); expr != c.ComputedExpression() {
+				c.Options["expression"] = expr
+			}
+
+			return nil
+		})
+	}
+}
+
+// computedFieldsChanged returns true when any of the computed fields was added
+// or had its expression (or result kind) changed
+func computedFieldsChanged(existing, updated types.ModuleFieldSet) bool {
+	for _, f := range updated {
+		if !f.IsComputed() {
+			continue
+		}
+
+		e := existing.FindByID(f.ID)
+		if e == nil || !e.IsComputed() || e.ComputedExpression() != f.ComputedExpression() || e.ValueKind() != f.ValueKind() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// recomputeValues stores freshly computed values of all computed fields on all module's records
+//
+// Records are processed in batches
+func (svc module) recomputeValues(m *types.Module) error {
+	const batchSize = 500
+
+	var cc = computedFields(m)
+
+	for page := uint(1); ; page++ {
+		rr, _, err := svc.recordRepo.Find(m, types.RecordFilter{
+			Deleted:    rh.FilterStateInclusive,
+			PageFilter: rh.PageFilter{Page: page, PerPage: batchSize, SkipCount: true},
+		})
+
+		if err != nil || len(rr) == 0 {
+			return err
+		}
+
+		rvs, err := svc.recordRepo.LoadValues(m.Fields.Names(), rr.IDs())
+		if err != nil {
+			return err
+		}
+
+		var computed types.RecordValueSet
+		for _, r := range rr {
+			for _, v := range cc.compute(m, rvs.FilterByRecordID(r.ID)) {
+				v.RecordID, v.DeletedAt = r.ID, r.DeletedAt
+				computed = append(computed, v)
+			}
+		}
+
+		if err = svc.recordRepo.PartialUpdateValues(computed...); err != nil {
+			return err
+		}
+
+		if len(rr) < batchSize {
+			return nil
+		}
+	}
+}
+
+// rollupFieldsChanged returns rollup fields that were added or had their configuration changed
+func rollupFieldsChanged(existing, updated types.ModuleFieldSet) (ff types.ModuleFieldSet) {
+	for _, f := range updated {
+		if !f.IsRollup() {
+			continue
+		}
+
+		e := existing.FindByID(f.ID)
+		if e == nil || !e.IsRollup() || *e.Rollup() != *f.Rollup() || e.Options.String("precision") != f.Options.String("precision") {
+			ff = append(ff, f)
+		}
+	}
+
+	return
+}
+
+// rollupModule loads module with records that are aggregated by the rollup field
+func (svc module) rollupModule(m *types.Module, f *types.ModuleField) (child *types.Module, err error) {
+	var ID = f.Rollup().ModuleID
+
+	if ID == m.ID {
+		return m, nil
+	}
+
+	if child, err = svc.moduleRepo.FindByID(m.NamespaceID, ID); err != nil {
+		return nil, errors.Wrapf(err, "can not load module %d of rollup field %q", ID, f.Name)
+	}
+
+	if child.Fields, err = svc.moduleRepo.FindFields(child.ID); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// recomputeRollups stores freshly aggregated values of given rollup fields on all module's records
+//
+// Records are processed in batches
+func (svc module) recomputeRollups(m *types.Module, ff types.ModuleFieldSet) error {
+	const batchSize = 500
+
+	if len(ff) == 0 {
+		return nil
+	}
+
+	var aggregated = map[string]map[uint64]string{}
+	for _, f := range ff {
+		child, err := svc.rollupModule(m, f)
+		if err != nil {
+			return err
+		}
+
+		if aggregated[f.Name], err = rollupValues(svc.recordRepo, f, child); err != nil {
+			return err
+		}
+	}
+
+	for page := uint(1); ; page++ {
+		rr, _, err := svc.recordRepo.Find(m, types.RecordFilter{
+			Deleted:    rh.FilterStateInclusive,
+			PageFilter: rh.PageFilter{Page: page, PerPage: batchSize, SkipCount: true},
+		})
+
+		if err != nil || len(rr) == 0 {
+			return err
+		}
+
+		var values types.RecordValueSet
+		for _, r := range rr {
+			for _, f := range ff {
+				v, ok := aggregated[f.Name][r.ID]
+				if !ok {
+					v = rollupDefault(f)
+				}
+
+				values = append(values, &types.RecordValue{RecordID: r.ID, Name: f.Name, Value: v, DeletedAt: r.DeletedAt})
+			}
+		}
+
+		if err = svc.recordRepo.PartialUpdateValues(values...); err != nil {
+			return err
+		}
+
+		if len(rr) < batchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+)
+
+func TestFieldMigrations(t *testing.T) {
+	var (
+		req      = require.New(t)
+		existing = types.ModuleFieldSet{
+			&types.ModuleField{ID: 1, Name: "foo", Kind: "String"},
+			&types.ModuleField{ID: 2, Name: "bar", Kind: "String"},
+			&types.ModuleField{ID: 3, Name: "baz", Kind: "Number"},
+		}
+	)
+
+	mm, err := fieldMigrations(existing, types.ModuleFieldSet{
+		&types.ModuleField{ID: 1, Name: "foo2", Kind: "String"},
+		&types.ModuleField{ID: 2, Name: "bar", Kind: "Number"},
+		&types.ModuleField{ID: 3, Name: "baz", Kind: "Number"},
+		&types.ModuleField{Name: "new", Kind: "String"},
+	})
+	req.NoError(err)
+	req.Len(mm, 2)
+	req.Equal(types.ModuleFieldMigration{FieldID: 1, OldName: "foo", Name: "foo2", OldKind: "String", Kind: "String"}, *mm[0])
+	req.True(mm[0].IsRenamed())
+	req.Equal(types.ModuleFieldMigration{FieldID: 2, OldName: "bar", Name: "bar", OldKind: "String", Kind: "Number"}, *mm[1])
+	req.False(mm[1].IsRenamed())
+
+	_, err = fieldMigrations(existing, types.ModuleFieldSet{
+		&types.ModuleField{ID: 1, Name: "bar", Kind: "String"},
+		&types.ModuleField{ID: 2, Name: "foo", Kind: "String"},
+	})
+	req.EqualError(err, `can not rename field "foo" to "bar", name is used by another field`)
+
+	_, err = fieldMigrations(existing, types.ModuleFieldSet{
+		&types.ModuleField{ID: 1, Name: "foo", Kind: "Record"},
+	})
+	req.EqualError(err, `can not change kind of field "foo" from String to Record`)
+}
+
+func TestRenameInComputedExpressions(t *testing.T) {
+	var (
+		computed = func(id uint64, expr string) *types.ModuleField {
+			return &types.ModuleField{ID: id, Name: "total", Kind: "Computed", Options: types.ModuleFieldOptions{"expression": expr}}
+		}
+
+		existing = types.ModuleFieldSet{
+			&types.ModuleField{ID: 1, Name: "qty", Kind: "Number"},
+			&types.ModuleField{ID: 2, Name: "price", Kind: "Number"},
+			computed(3, "qty * price + LEN('qty')"),
+		}
+
+		updated = types.ModuleFieldSet{
+			&types.ModuleField{ID: 1, Name: "quantity", Kind: "Number"},
+			&types.ModuleField{ID: 2, Name: "price", Kind: "Number"},
+			computed(3, "qty * price + LEN('qty')"),
+		}
+	)
+
+	renameInComputedExpressions(existing, updated)
+	require.Equal(t, "quantity * price + LEN('qty')", updated[2].ComputedExpression())
+
+	// Old name is used by a new field
+	updated = types.ModuleFieldSet{
+		&types.ModuleField{ID: 1, Name: "quantity", Kind: "Number"},
+		&types.ModuleField{Name: "qty", Kind: "Number"},
+		computed(3, "qty * 2"),
+	}
+
+	renameInComputedExpressions(existing, updated)
+	require.Equal(t, "qty * 2", updated[2].ComputedExpression())
+}
+
+func TestConvertRecordValue(t *testing.T) {
+	var (
+		sel = types.ModuleFieldOptions{"options": []interface{}{"a", "b"}}
+
+		tests = []struct {
+			name  string
+			field *types.ModuleField
+			value string
+			out   string
+			kind  string
+		}{
+			{"string to number", &types.ModuleField{Kind: "Number"}, " 42.5 ", "42.5", ""},
+			{"bool to number", &types.ModuleField{Kind: "Number"}, "true", "1", ""},
+			{"invalid number", &types.ModuleField{Kind: "Number"}, "n/a", "", "invalidNumber"},
+			{"number to string", &types.ModuleField{Kind: "String"}, "42", "42", ""},
+			{"string to select", &types.ModuleField{Kind: "Select", Options: sel}, "a", "a", ""},
+			{"invalid option", &types.ModuleField{Kind: "Select", Options: sel}, "c", "", "invalidOption"},
+			{"string to bool", &types.ModuleField{Kind: "Bool"}, "Yes", "1", ""},
+			{"invalid bool", &types.ModuleField{Kind: "Bool"}, "maybe", "", "invalidBool"},
+			{"string to datetime", &types.ModuleField{Kind: "DateTime"}, "2019-10-01 12:00", "2019-10-01 12:00", ""},
+			{"invalid datetime", &types.ModuleField{Kind: "DateTime"}, "today", "", "invalidDateTime"},
+		}
+	)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				req    = require.New(t)
+				v      = &types.RecordValue{RecordID: 1, Name: "old", Value: tt.value, Ref: 5}
+				c, rve = convertRecordValue(tt.field, v)
+			)
+
+			if tt.kind != "" {
+				req.Nil(c)
+				req.NotNil(rve)
+				req.Equal(tt.kind, rve.Kind)
+				return
+			}
+
+			req.Nil(rve)
+			req.Equal(tt.out, c.Value)
+			req.Equal(tt.field.Name, c.Name)
+			req.Equal(uint64(1), c.RecordID)
+			req.Zero(c.Ref)
+		})
+	}
+}
@@ -2,9 +2,11 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -17,11 +19,23 @@ import (
 	"github.com/cortezaproject/corteza-server/compose/types"
 	"github.com/cortezaproject/corteza-server/pkg/auth"
 	"github.com/cortezaproject/corteza-server/pkg/logger"
+	"github.com/cortezaproject/corteza-server/pkg/permissions"
+	"github.com/cortezaproject/corteza-server/pkg/rh"
+	systemTypes "github.com/cortezaproject/corteza-server/system/types"
 )
 
 const (
 	IMPORT_ON_ERROR_SKIP = "SKIP"
 	IMPORT_ON_ERROR_FAIL = "FAIL"
+
+	// Max number of operations in one bulk request; all of them run in
+	// one transaction that holds the module lock (see lockUniqueValues)
+	recordBulkMaxOperations = 1000
+)
+
+var (
+	// Returned from bulk transaction to roll back all operations
+	errRecordBulkFailed = errors.New("bulk record operation failed")
 )
 
 type (
@@ -30,30 +44,39 @@ type (
 		ctx    context.Context
 		logger *zap.Logger
 
-		ac recordAccessController
-		sr RecordScriptsRunner
+		ac    recordAccessController
+		sr    RecordScriptsRunner
+		users recordUserFinder
 
-		recordRepo repository.RecordRepository
-		moduleRepo repository.ModuleRepository
-		nsRepo     repository.NamespaceRepository
+		recordRepo   repository.RecordRepository
+		revisionRepo repository.RecordRevisionRepository
+		moduleRepo   repository.ModuleRepository
+		nsRepo       repository.NamespaceRepository
 	}
 
 	recordAccessController interface {
 		CanCreateRecord(context.Context, *types.Module) bool
 		CanReadNamespace(context.Context, *types.Namespace) bool
 		CanReadModule(context.Context, *types.Module) bool
-		CanReadRecord(context.Context, *types.Module) bool
-		CanUpdateRecord(context.Context, *types.Module) bool
-		CanDeleteRecord(context.Context, *types.Module) bool
+		CanUndeleteRecord(context.Context, *types.Module) bool
+		CanReadRecordInstance(context.Context, *types.Module, *types.Record) bool
+		CanUpdateRecordInstance(context.Context, *types.Module, *types.Record) bool
+		CanDeleteRecordInstance(context.Context, *types.Module, *types.Record) bool
+		FilterReadableRecords(context.Context, *types.Module) *permissions.ResourceFilter
+		FilterReadableRecordsAs(context.Context, *types.Module, string) *permissions.ResourceFilter
 		CanReadRecordValue(context.Context, *types.ModuleField) bool
 		CanUpdateRecordValue(context.Context, *types.ModuleField) bool
 	}
 
+	recordUserFinder interface {
+		FindByID(context.Context, uint64) (*systemTypes.User, error)
+	}
+
 	RecordScriptsRunner interface {
 		BeforeRecordCreate(ctx context.Context, ns *types.Namespace, m *types.Module, r *types.Record) (err error)
 		AfterRecordCreate(ctx context.Context, ns *types.Namespace, m *types.Module, r *types.Record) (err error)
-		BeforeRecordUpdate(ctx context.Context, ns *types.Namespace, m *types.Module, r *types.Record) (err error)
-		AfterRecordUpdate(ctx context.Context, ns *types.Namespace, m *types.Module, r *types.Record) (err error)
+		BeforeRecordUpdate(ctx context.Context, ns *types.Namespace, m *types.Module, r *types.Record, old types.RecordValueSet) (err error)
+		AfterRecordUpdate(ctx context.Context, ns *types.Namespace, m *types.Module, r *types.Record, old types.RecordValueSet) (err error)
 		BeforeRecordDelete(ctx context.Context, ns *types.Namespace, m *types.Module, r *types.Record) (err error)
 		AfterRecordDelete(ctx context.Context, ns *types.Namespace, m *types.Module, r *types.Record) (err error)
 	}
@@ -63,15 +86,24 @@ type (
 
 		FindByID(namespaceID, recordID uint64) (*types.Record, error)
 
-		Report(namespaceID, moduleID uint64, metrics, dimensions, filter string) (interface{}, error)
+		Report(namespaceID, moduleID uint64, metrics, dimensions, filter string, limit uint) (interface{}, error)
+		ChartReport(namespaceID uint64, report *types.ChartConfigReport) (interface{}, error)
 		Find(filter types.RecordFilter) (set types.RecordSet, f types.RecordFilter, err error)
 		Export(types.RecordFilter, Encoder) error
-		Import(*RecordImportSession, ImportSessionService) error
+		Import(*types.RecordImportSession, Decoder) error
 
 		Create(record *types.Record) (*types.Record, error)
 		Update(record *types.Record) (*types.Record, error)
+		Upsert(record *types.Record, keys ...string) (*types.Record, error)
 
 		DeleteByID(namespaceID, recordID uint64) error
+		References(namespaceID, recordID uint64) (types.RecordReferenceSet, error)
+		UndeleteByID(namespaceID, recordID uint64) error
+
+		Bulk(namespaceID, moduleID uint64, onError string, oo types.RecordBulkOperationSet) (types.RecordBulkResultSet, error)
+
+		Revisions(filter types.RecordRevisionFilter) (set types.RecordRevisionSet, f types.RecordRevisionFilter, err error)
+		RestoreRevision(namespaceID, recordID uint64, revision uint) (*types.Record, error)
 
 		Organize(namespaceID, moduleID, recordID uint64, sortingField, sortingValue, sortingFilter, valueField, value string) error
 	}
@@ -85,52 +117,43 @@ type (
 		EntryCount() (uint64, error)
 		Records(fields map[string]string, Create decoder.RecordCreator) error
 	}
-
-	RecordImportSession struct {
-		Decoder     Decoder              `json:"-"`
-		CreatedAt   time.Time            `json:"createdAt"`
-		UpdatedAt   time.Time            `json:"updatedAt"`
-		OnError     string               `json:"onError"`
-		SessionID   uint64               `json:"sessionID,string"`
-		UserID      uint64               `json:"userID,string"`
-		NamespaceID uint64               `json:"namespaceID,string"`
-		ModuleID    uint64               `json:"moduleID,string"`
-		Fields      map[string]string    `json:"fields"`
-		Progress    RecordImportProgress `json:"progress"`
-	}
-
-	RecordImportProgress struct {
-		StartedAt  *time.Time `json:"startedAt"`
-		FinishedAt *time.Time `json:"finishedAt"`
-		EntryCount uint64     `json:"entryCount"`
-		Completed  uint64     `json:"completed"`
-		Failed     uint64     `json:"failed"`
-		FailReason string     `json:"failReason,omitempty"`
-	}
 )
 
 func Record() RecordService {
-	return (&record{
+	svc := &record{
 		logger: DefaultLogger.Named("record"),
 		ac:     DefaultAccessControl,
 		sr:     DefaultAutomationRunner,
-	}).With(context.Background())
+	}
+
+	if DefaultSystemUser != nil {
+		svc.users = DefaultSystemUser
+	}
+
+	return svc.With(context.Background())
 }
 
 func (svc record) With(ctx context.Context) RecordService {
 	db := repository.DB(ctx)
 
+	// Record writes lock rows (modules, parents of rollups) before they read values
+	// that concurrent writers could change; these reads must see the latest commits
+	// and not the snapshot from the start of the transaction (MySQL's default)
+	db.TxOpts = &sql.TxOptions{Isolation: sql.LevelReadCommitted}
+
 	return &record{
 		db:     db,
 		ctx:    ctx,
 		logger: svc.logger,
 
-		ac: svc.ac,
-		sr: svc.sr,
+		ac:    svc.ac,
+		sr:    svc.sr,
+		users: svc.users,
 
-		recordRepo: repository.Record(ctx, db),
-		moduleRepo: repository.Module(ctx, db),
-		nsRepo:     repository.Namespace(ctx, db),
+		recordRepo:   repository.Record(ctx, db),
+		revisionRepo: repository.RecordRevision(ctx, db),
+		moduleRepo:   repository.Module(ctx, db),
+		nsRepo:       repository.Namespace(ctx, db),
 	}
 }
 
@@ -153,7 +176,7 @@ func (svc record) FindByID(namespaceID, recordID uint64) (r *types.Record, err e
 		return
 	}
 
-	if !svc.ac.CanReadRecord(svc.ctx, m) {
+	if !svc.ac.CanReadRecordInstance(svc.ctx, m, r) {
 		return nil, ErrNoReadPermissions.withStack()
 	}
 
@@ -196,97 +219,62 @@ func (svc record) loadNamespace(namespaceID uint64) (ns *types.Namespace, err er
 	return
 }
 
-func (svc record) Report(namespaceID, moduleID uint64, metrics, dimensions, filter string) (out interface{}, err error) {
-	var m *types.Module
+// Report aggregates readable records of the module
+//
+// With limit, only rows with top N values of the first dimension are
+// reported, others are grouped together (see types.RecordReport)
+func (svc record) Report(namespaceID, moduleID uint64, metrics, dimensions, filter string, limit uint) (out interface{}, err error) {
+	var (
+		m      *types.Module
+		report *types.RecordReport
+	)
+
 	if m, err = svc.loadModule(namespaceID, moduleID); err != nil {
 		return
 	}
 
-	return svc.recordRepo.
-		Report(m, metrics, dimensions, filter)
-}
-
-func (svc record) Find(filter types.RecordFilter) (set types.RecordSet, f types.RecordFilter, err error) {
-	var m *types.Module
-	if m, err = svc.loadModule(filter.NamespaceID, filter.ModuleID); err != nil {
+	var isRefReadable map[string]*permissions.ResourceFilter
+	if isRefReadable, err = svc.prepareReportPaths(m, metrics, dimensions, filter); err != nil {
 		return
 	}
 
-	set, f, err = svc.recordRepo.Find(m, filter)
+	report, err = svc.recordRepo.
+		Report(m, metrics, dimensions, filter, svc.ac.FilterReadableRecords(svc.ctx, m), isRefReadable)
 	if err != nil {
 		return
 	}
 
-	if err = svc.preloadValues(m, set...); err != nil {
-		return
-	}
-
-	return
+	report.Process(limit)
+	return report.Rows, nil
 }
 
-func (svc record) Import(ses *RecordImportSession, ssvc ImportSessionService) error {
-	if ses.Decoder == nil {
-		return nil
+func (svc record) Find(filter types.RecordFilter) (set types.RecordSet, f types.RecordFilter, err error) {
+	var m *types.Module
+	if m, err = svc.loadModule(filter.NamespaceID, filter.ModuleID); err != nil {
+		return
 	}
 
-	if ses.Progress.StartedAt != nil {
-		return errors.New("Unable to start import: Import session already active")
+	if filter.Deleted != rh.FilterStateExcluded && !svc.ac.CanUndeleteRecord(svc.ctx, m) {
+		// Only users that can restore records can see the trash
+		return nil, filter, ErrNoUndeletePermissions.withStack()
 	}
 
-	sa := time.Now()
-	ses.Progress.StartedAt = &sa
-	ssvc.SetRecordByID(svc.ctx, ses.SessionID, 0, 0, nil, &ses.Progress, nil)
-
-	return svc.db.Transaction(func() (err error) {
-		err = ses.Decoder.Records(ses.Fields, func(mod *types.Record) error {
-			mod.NamespaceID = ses.NamespaceID
-			mod.ModuleID = ses.ModuleID
-			mod.OwnedBy = ses.UserID
+	filter.IsReadable = svc.ac.FilterReadableRecords(svc.ctx, m)
 
-			_, err := svc.Create(mod)
-			if err != nil {
-				ses.Progress.Failed++
-				ses.Progress.FailReason = err.Error()
-
-				if ses.OnError == IMPORT_ON_ERROR_FAIL {
-					fa := time.Now()
-					ses.Progress.FinishedAt = &fa
-					ssvc.SetRecordByID(svc.ctx, ses.SessionID, 0, 0, nil, &ses.Progress, nil)
-					return err
-				}
-			} else {
-				ses.Progress.Completed++
-			}
-			return nil
-		})
-
-		fa := time.Now()
-		ses.Progress.FinishedAt = &fa
-		ssvc.SetRecordByID(svc.ctx, ses.SessionID, 0, 0, nil, &ses.Progress, nil)
+	if err = svc.preparePaths(m, &filter); err != nil {
 		return
-	})
-}
-
-// Export returns all records
-//
-// @todo better value handling
-func (svc record) Export(filter types.RecordFilter, enc Encoder) error {
-	m, err := svc.loadModule(filter.NamespaceID, filter.ModuleID)
-
-	if err != nil {
-		return err
 	}
 
-	set, err := svc.recordRepo.Export(m, filter)
+	set, f, err = svc.recordRepo.Find(m, filter)
 	if err != nil {
-		return err
+		return
 	}
 
 	if err = svc.preloadValues(m, set...); err != nil {
-		return err
+		return
 	}
 
-	return set.Walk(enc.Record)
+	return
 }
 
 func (svc record) Create(mod *types.Record) (r *types.Record, err error) {
@@ -331,12 +319,18 @@ func (svc record) Create(mod *types.Record) (r *types.Record, err error) {
 		return
 	}
 
-	defer func() {
-		// Run this at the end and discard the error
-		_ = svc.sr.AfterRecordCreate(svc.ctx, ns, m, r)
-	}()
+	r.Values = withComputedValues(m, r.Values)
+	r.Values = withRollupValues(m, r.Values, nil)
+
+	if err = svc.validateValues(m, r); err != nil {
+		return
+	}
+
+	return r, svc.transaction(func(svc record) (err error) {
+		if err = svc.lockUniqueValues(m, r); err != nil {
+			return
+		}
 
-	return r, svc.db.Transaction(func() (err error) {
 		if r, err = svc.recordRepo.Create(r); err != nil {
 			return
 		}
@@ -345,11 +339,26 @@ func (svc record) Create(mod *types.Record) (r *types.Record, err error) {
 			return
 		}
 
-		return
+		if err = svc.updateRollups(m, nil, r.Values); err != nil {
+			return
+		}
+
+		if err = svc.storeRevision(types.RecordRevisionCreate, r, nil, r.Values); err != nil {
+			return
+		}
+
+		// Queued after-scripts are stored in the transaction, the rest
+		// run when the outermost transaction is committed
+		return svc.sr.AfterRecordCreate(svc.ctx, ns, m, r)
 	})
 }
 
 func (svc record) Update(mod *types.Record) (r *types.Record, err error) {
+	return svc.update(mod, types.RecordRevisionUpdate)
+}
+
+// update stores changes to the record and logs a revision with the given operation
+func (svc record) update(mod *types.Record, operation string) (r *types.Record, err error) {
 	if mod.ID == 0 {
 		return nil, ErrInvalidID.withStack()
 	}
@@ -359,7 +368,7 @@ func (svc record) Update(mod *types.Record) (r *types.Record, err error) {
 		return
 	}
 
-	if !svc.ac.CanUpdateRecord(svc.ctx, m) {
+	if !svc.ac.CanUpdateRecordInstance(svc.ctx, m, r) {
 		return nil, ErrNoUpdatePermissions.withStack()
 	}
 
@@ -368,6 +377,13 @@ func (svc record) Update(mod *types.Record) (r *types.Record, err error) {
 		return nil, ErrStaleData.withStack()
 	}
 
+	// Values before the update, needed for the revision log
+	// and for expressions of update triggers
+	old, err := svc.recordRepo.LoadValues(m.Fields.Names(), []uint64{r.ID})
+	if err != nil {
+		return
+	}
+
 	svc.recordInfoUpdate(r)
 
 	if err = svc.copyChanges(m, mod, r); err != nil {
@@ -377,7 +393,7 @@ func (svc record) Update(mod *types.Record) (r *types.Record, err error) {
 	mod = nil // make sure we do not use it anymore
 
 	// Calling before-record-update scripts
-	if err = svc.sr.BeforeRecordUpdate(svc.ctx, ns, m, r); err != nil {
+	if err = svc.sr.BeforeRecordUpdate(svc.ctx, ns, m, r, old); err != nil {
 		return
 	}
 
@@ -387,12 +403,18 @@ func (svc record) Update(mod *types.Record) (r *types.Record, err error) {
 		return
 	}
 
-	defer func() {
-		// Run this at the end and discard the error
-		_ = svc.sr.AfterRecordUpdate(svc.ctx, ns, m, r)
-	}()
+	r.Values = withComputedValues(m, r.Values)
+	r.Values = withRollupValues(m, r.Values, old)
+
+	if err = svc.validateValues(m, r); err != nil {
+		return
+	}
+
+	return r, svc.transaction(func(svc record) (err error) {
+		if err = svc.lockUniqueValues(m, r); err != nil {
+			return
+		}
 
-	return r, svc.db.Transaction(func() (err error) {
 		if r, err = svc.recordRepo.Update(r); err != nil {
 			return
 		}
@@ -401,6 +423,76 @@ func (svc record) Update(mod *types.Record) (r *types.Record, err error) {
 			return
 		}
 
+		if err = svc.updateRollups(m, old, r.Values); err != nil {
+			return
+		}
+
+		if err = svc.storeRevision(operation, r, old, r.Values); err != nil {
+			return
+		}
+
+		// Queued after-scripts are stored in the transaction, the rest
+		// run when the outermost transaction is committed
+		return svc.sr.AfterRecordUpdate(svc.ctx, ns, m, r, old)
+	})
+}
+
+// Upsert updates record with the same values of key fields or creates a new one
+//
+// Key fields must be single-value fields and record must have values for all of them.
+// Upserts on the same module are serialized (module is locked for the duration of
+// the transaction) so concurrent upserts with the same key do not create duplicates
+func (svc record) Upsert(mod *types.Record, keys ...string) (r *types.Record, err error) {
+	if len(keys) == 0 {
+		return nil, errors.New("upsert requires at least one key field")
+	}
+
+	_, m, _, err := svc.loadCombo(mod.NamespaceID, mod.ModuleID, 0)
+	if err != nil {
+		return
+	}
+
+	var kvs = types.RecordValueSet{}
+	for _, key := range keys {
+		f := m.Fields.FindByName(key)
+		if f == nil {
+			return nil, errors.Errorf("no such field %q", key)
+		}
+
+		if f.Multi {
+			return nil, errors.Errorf("can not use multi-value field %q as upsert key", key)
+		}
+
+		vv := mod.Values.FilterByName(key)
+		if len(vv) == 0 || strings.TrimSpace(vv[0].Value) == "" {
+			return nil, errors.Errorf("missing value for upsert key %q", key)
+		}
+
+		kvs = append(kvs, &types.RecordValue{Name: key, Value: vv[0].Value})
+	}
+
+	return r, svc.transaction(func(svc record) (err error) {
+		var ids []uint64
+
+		if err = svc.moduleRepo.LockByID(m.ID); err != nil {
+			return
+		}
+
+		if ids, err = svc.recordRepo.FindByKeys(m.ID, kvs); err != nil {
+			return
+		}
+
+		switch len(ids) {
+		case 0:
+			mod.ID = 0
+			r, err = svc.Create(mod)
+		case 1:
+			mod.ID = ids[0]
+			r, err = svc.Update(mod)
+		default:
+			err = errors.Errorf("upsert keys match more than one record")
+		}
+
 		return
 	})
 }
@@ -421,7 +513,7 @@ func (svc record) DeleteByID(namespaceID, recordID uint64) (err error) {
 		return
 	}
 
-	if !svc.ac.CanDeleteRecord(svc.ctx, m) {
+	if !svc.ac.CanDeleteRecordInstance(svc.ctx, m, r) {
 		return ErrNoDeletePermissions.withStack()
 	}
 
@@ -435,28 +527,288 @@ func (svc record) DeleteByID(namespaceID, recordID uint64) (err error) {
 		return
 	}
 
-	defer func() {
-		// Run this at the end and discard the error
-		_ = svc.sr.AfterRecordDelete(svc.ctx, ns, m, r)
-	}()
+	err = svc.transaction(func(svc record) (err error) {
+		d := &recordDeletion{namespace: ns, deleted: map[uint64]bool{}}
+		if d.modules, d.fields, err = svc.namespaceFields(ns.ID); err != nil {
+			return
+		}
+
+		if err = svc.deleteRecord(d, m, r); err != nil {
+			return
+		}
+
+		// Queued after-scripts are stored in the transaction, the rest
+		// run when the outermost transaction is committed
+		return svc.sr.AfterRecordDelete(svc.ctx, ns, m, r)
+	})
+
+	if _, ok := errors.Cause(err).(serviceError); ok {
+		// Deletion restricted by a referencing record
+		return err
+	}
+
+	return errors.Wrap(err, "unable to delete record")
+}
+
+// Bulk runs a batch of record creates, updates and deletes in one transaction
+//
+// Each operation goes through the regular create, update, upsert or delete path
+// (permissions, automation scripts, validation); after-scripts are queued with the
+// batch or run when it is committed. With IMPORT_ON_ERROR_FAIL (default)
+// first failed operation rolls back the whole batch; with IMPORT_ON_ERROR_SKIP
+// each operation runs in a savepoint, failed operations are rolled back
+// to it and the rest is stored.
+//
+// Errors of individual operations are returned as part of the results
+func (svc record) Bulk(namespaceID, moduleID uint64, onError string, oo types.RecordBulkOperationSet) (rr types.RecordBulkResultSet, err error) {
+	if onError == "" {
+		onError = IMPORT_ON_ERROR_FAIL
+	}
+
+	if onError != IMPORT_ON_ERROR_FAIL && onError != IMPORT_ON_ERROR_SKIP {
+		return nil, errors.Errorf("unsupported onError value %q", onError)
+	}
+
+	if len(oo) > recordBulkMaxOperations {
+		return nil, errors.Errorf("bulk request is limited to %d operations", recordBulkMaxOperations)
+	}
+
+	if _, _, _, err = svc.loadCombo(namespaceID, moduleID, 0); err != nil {
+		return
+	}
+
+	err = svc.transaction(func(svc record) error {
+		// Transactions can be retried, always start with a clean result set
+		rr = make(types.RecordBulkResultSet, 0, len(oo))
+
+		for i, o := range oo {
+			res := &types.RecordBulkResult{Index: i, Operation: o.Operation, RecordID: o.RecordID}
+			rr = append(rr, res)
+
+			if onError == IMPORT_ON_ERROR_FAIL {
+				if err := svc.bulkOperation(namespaceID, moduleID, o, res); err != nil {
+					res.SetError(err)
+					return errRecordBulkFailed
+				}
+
+				continue
+			}
+
+			var opErr error
+			err := svc.savepoint(func() error {
+				opErr = svc.bulkOperation(namespaceID, moduleID, o, res)
+				return opErr
+			})
+
+			if opErr != nil {
+				res.SetError(opErr)
+			}
+
+			if err != nil && err != opErr {
+				// Failed operation could not be rolled back
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err == errRecordBulkFailed {
+		for _, res := range rr {
+			res.RolledBack = res.Error == ""
+		}
+
+		return rr, nil
+	}
+
+	return
+}
+
+func (svc record) bulkOperation(namespaceID, moduleID uint64, o *types.RecordBulkOperation, res *types.RecordBulkResult) error {
+	if o.Operation == types.RecordBulkUpdate || o.Operation == types.RecordBulkDelete {
+		// Update & delete would happily modify records from other modules
+		if r, err := svc.recordRepo.FindByID(namespaceID, o.RecordID); err != nil {
+			return err
+		} else if r.ModuleID != moduleID {
+			return repository.ErrRecordNotFound
+		}
+	}
+
+	switch o.Operation {
+	case types.RecordBulkCreate:
+		r, err := svc.Create(&types.Record{
+			NamespaceID: namespaceID,
+			ModuleID:    moduleID,
+			Values:      o.Values,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		res.RecordID = r.ID
+		return nil
 
-	err = svc.db.Transaction(func() (err error) {
-		now := time.Now()
-		r.DeletedAt = &now
-		r.DeletedBy = auth.GetIdentityFromContext(svc.ctx).Identity()
+	case types.RecordBulkUpsert:
+		r, err := svc.Upsert(&types.Record{
+			NamespaceID: namespaceID,
+			ModuleID:    moduleID,
+			Values:      o.Values,
+		}, o.Keys...)
 
-		if err = svc.recordRepo.Delete(r); err != nil {
+		if err != nil {
+			return err
+		}
+
+		res.RecordID = r.ID
+		return nil
+
+	case types.RecordBulkUpdate:
+		_, err := svc.Update(&types.Record{
+			ID:          o.RecordID,
+			NamespaceID: namespaceID,
+			ModuleID:    moduleID,
+			Values:      o.Values,
+			UpdatedAt:   o.UpdatedAt,
+		})
+
+		return err
+
+	case types.RecordBulkDelete:
+		return svc.DeleteByID(namespaceID, o.RecordID)
+
+	default:
+		return errors.Errorf("unsupported bulk operation %q", o.Operation)
+	}
+}
+
+// UndeleteByID restores soft-deleted record and its values
+//
+// Restored values are checked against unique constraints since they
+// might be used by another record in the meantime (see lockUniqueValues)
+func (svc record) UndeleteByID(namespaceID, recordID uint64) (err error) {
+	if recordID == 0 {
+		return ErrInvalidID.withStack()
+	}
+
+	if _, err = svc.loadNamespace(namespaceID); err != nil {
+		return
+	}
+
+	r, err := svc.recordRepo.FindDeletedByID(namespaceID, recordID)
+	if err != nil {
+		return
+	}
+
+	m, err := svc.loadModule(namespaceID, r.ModuleID)
+	if err != nil {
+		return
+	}
+
+	if !svc.ac.CanUndeleteRecord(svc.ctx, m) {
+		return ErrNoUndeletePermissions.withStack()
+	}
+
+	if r.Values, err = svc.recordRepo.LoadValues(m.Fields.Names(), []uint64{r.ID}); err != nil {
+		return
+	}
+
+	return svc.transaction(func(svc record) (err error) {
+		if err = svc.lockUniqueValues(m, r); err != nil {
 			return
 		}
 
-		if err = svc.recordRepo.DeleteValues(r); err != nil {
+		if err = svc.recordRepo.Undelete(r); err != nil {
 			return
 		}
 
+		if err = svc.recordRepo.UndeleteValues(r); err != nil {
+			return
+		}
+
+		if err = svc.updateRollups(m, nil, r.Values); err != nil {
+			return
+		}
+
+		return svc.storeRevision(types.RecordRevisionUndelete, r, r.Values, r.Values)
+	})
+}
+
+// Revisions returns revision log of a record
+//
+// Changes of fields that current user can not read are removed from the log
+func (svc record) Revisions(filter types.RecordRevisionFilter) (set types.RecordRevisionSet, f types.RecordRevisionFilter, err error) {
+	if filter.RecordID == 0 {
+		return nil, filter, ErrInvalidID.withStack()
+	}
+
+	_, m, r, err := svc.loadCombo(filter.NamespaceID, 0, filter.RecordID)
+	if err != nil {
 		return
+	}
+
+	if !svc.ac.CanReadRecordInstance(svc.ctx, m, r) {
+		return nil, filter, ErrNoReadPermissions.withStack()
+	}
+
+	if set, f, err = svc.revisionRepo.Find(filter); err != nil {
+		return
+	}
+
+	var readable = map[string]bool{}
+	for _, name := range svc.readableFields(m) {
+		readable[name] = true
+	}
+
+	_ = set.Walk(func(rev *types.RecordRevision) error {
+		rev.Changes = rev.Changes.FilterByField(func(field string) bool {
+			return readable[field]
+		})
+
+		return nil
 	})
 
-	return errors.Wrap(err, "unable to delete record")
+	return
+}
+
+// RestoreRevision updates record with values from the given revision
+//
+// Restore goes through the same path as regular update (permissions, scripts,
+// validation) and is logged as a new revision. Values of fields that
+// were removed from the module in the meantime are ignored.
+func (svc record) RestoreRevision(namespaceID, recordID uint64, revision uint) (r *types.Record, err error) {
+	if recordID == 0 {
+		return nil, ErrInvalidID.withStack()
+	}
+
+	_, m, r, err := svc.loadCombo(namespaceID, 0, recordID)
+	if err != nil {
+		return
+	}
+
+	rev, err := svc.revisionRepo.FindByRevision(namespaceID, recordID, revision)
+	if err != nil {
+		return
+	}
+
+	if rev.ModuleID != r.ModuleID {
+		// Snapshot holds values of another module's fields
+		return nil, ErrRecordRevisionModuleMismatch.withStack()
+	}
+
+	mod := &types.Record{
+		ID:          r.ID,
+		ModuleID:    r.ModuleID,
+		NamespaceID: r.NamespaceID,
+		OwnedBy:     r.OwnedBy,
+		UpdatedAt:   r.UpdatedAt,
+	}
+
+	mod.Values, _ = rev.Snapshot.Filter(func(v *types.RecordValue) (bool, error) {
+		return m.Fields.HasName(v.Name), nil
+	})
+
+	return svc.update(mod, types.RecordRevisionRestore)
 }
 
 // Organize - Record organizer
@@ -485,7 +837,7 @@ func (svc record) Organize(namespaceID, moduleID, recordID uint64, posField, pos
 		return err
 	}
 
-	if !svc.ac.CanUpdateRecord(svc.ctx, module) {
+	if !svc.ac.CanUpdateRecordInstance(svc.ctx, module, record) {
 		return ErrNoUpdatePermissions.withStack()
 	}
 
@@ -512,6 +864,10 @@ func (svc record) Organize(namespaceID, moduleID, recordID uint64, posField, pos
 			return errors.Errorf("can not reorder on multi-value field %q", posField)
 		}
 
+		if sf.IsComputed() || sf.IsRollup() {
+			return errors.Errorf("can not reorder on calculated field %q", posField)
+		}
+
 		if !svc.ac.CanUpdateRecordValue(svc.ctx, sf) {
 			return ErrNoUpdatePermissions.withStack()
 		}
@@ -536,6 +892,10 @@ func (svc record) Organize(namespaceID, moduleID, recordID uint64, posField, pos
 			return errors.Errorf("can not update multi-value field %q", posField)
 		}
 
+		if vf.IsComputed() || vf.IsRollup() {
+			return errors.Errorf("can not update calculated field %q", grpField)
+		}
+
 		if !svc.ac.CanUpdateRecordValue(svc.ctx, vf) {
 			return ErrNoUpdatePermissions.withStack()
 		}
@@ -550,15 +910,43 @@ func (svc record) Organize(namespaceID, moduleID, recordID uint64, posField, pos
 
 	return svc.db.Transaction(func() (err error) {
 		if len(recordValues) > 0 {
+			var before, after types.RecordValueSet
+			if before, err = svc.recordRepo.LoadValues(module.Fields.Names(), []uint64{recordID}); err != nil {
+				return
+			}
+
 			svc.recordInfoUpdate(record)
 			if _, err = svc.recordRepo.Update(record); err != nil {
 				return
 			}
 
+			// Moved record might have computed values that depend on position or group
+			var merged = append(types.RecordValueSet{}, before...)
+			for _, v := range recordValues {
+				merged = merged.Set(v)
+			}
+
+			for _, v := range computeValues(module, merged) {
+				v.RecordID = recordID
+				recordValues = recordValues.Set(v)
+			}
+
 			if err = svc.recordRepo.PartialUpdateValues(recordValues...); err != nil {
 				return
 			}
 
+			if after, err = svc.recordRepo.LoadValues(module.Fields.Names(), []uint64{recordID}); err != nil {
+				return
+			}
+
+			if err = svc.updateRollups(module, before, after); err != nil {
+				return
+			}
+
+			if err = svc.storeRevision(types.RecordRevisionUpdate, record, before, after); err != nil {
+				return
+			}
+
 			log.Info("record moved")
 		}
 
@@ -609,6 +997,23 @@ func (svc record) Organize(namespaceID, moduleID, recordID uint64, posField, pos
 	})
 }
 
+// storeRevision logs a change of record values
+//
+// Snapshot holds all values of the record after the change
+func (svc record) storeRevision(operation string, r *types.Record, old, new types.RecordValueSet) error {
+	_, err := svc.revisionRepo.Create(&types.RecordRevision{
+		NamespaceID: r.NamespaceID,
+		ModuleID:    r.ModuleID,
+		RecordID:    r.ID,
+		Operation:   operation,
+		Changes:     types.NewRecordRevisionChanges(old, new),
+		Snapshot:    new,
+		CreatedBy:   auth.GetIdentityFromContext(svc.ctx).Identity(),
+	})
+
+	return err
+}
+
 // loadCombo Loads everything we need for record manipulation
 //
 // Loads namespace, module, record and set of triggers.
@@ -637,8 +1042,12 @@ func (svc record) loadCombo(namespaceID, moduleID, recordID uint64) (ns *types.N
 }
 
 // Copies changes from mod to r(ecord)
+//
+// Owner is kept when mod does not set it
 func (svc record) copyChanges(m *types.Module, mod, r *types.Record) (err error) {
-	r.OwnedBy = mod.OwnedBy
+	if mod.OwnedBy > 0 {
+		r.OwnedBy = mod.OwnedBy
+	}
 	r.Values, err = svc.sanitizeValues(m, mod.Values)
 	return err
 }
@@ -689,6 +1098,11 @@ func (svc record) sanitizeValues(module *types.Module, values types.RecordValueS
 			return false, nil
 		}
 
+		if field.IsComputed() || field.IsRollup() {
+			// Values of computed and rollup fields are never set directly
+			return false, nil
+		}
+
 		return svc.ac.CanUpdateRecordValue(svc.ctx, field), nil
 	})
 
@@ -724,14 +1138,142 @@ func (svc record) sanitizeValues(module *types.Module, values types.RecordValueS
 	})
 }
 
+// Validates values against field kind, options and required flag;
+// unique constraints are checked when values are stored, see lockUniqueValues
+//
+// Returns *types.RecordValueErrorSet with details about all invalid values
+func (svc record) validateValues(module *types.Module, r *types.Record) error {
+	return validateRecordValues(module, r.Values, func(f *types.ModuleField) bool {
+		return svc.ac.CanUpdateRecordValue(svc.ctx, f)
+	})
+}
+
+// lockUniqueValues locks the module and checks values against unique constraints
+//
+// Must be called in the transaction that stores the values; writes to modules with
+// unique fields are serialized (as upserts are) so concurrent writes can not store
+// the same value
+func (svc record) lockUniqueValues(module *types.Module, r *types.Record) error {
+	var unique bool
+	for _, f := range module.Fields {
+		unique = unique || f.UniqueConstraint() != nil
+	}
+
+	if !unique {
+		return nil
+	}
+
+	if err := svc.moduleRepo.LockByID(module.ID); err != nil {
+		return err
+	}
+
+	var rves = &types.RecordValueErrorSet{}
+	if err := svc.checkUniqueValues(module, r, rves); err != nil {
+		return err
+	} else if !rves.IsValid() {
+		return rves
+	}
+
+	return nil
+}
+
+// Checks values of fields with unique constraint against values of other records in the module
+// and against other values of the same (multi-value) field
+//
+// Fields that already failed validation are skipped
+func (svc record) checkUniqueValues(module *types.Module, r *types.Record, rves *types.RecordValueErrorSet) error {
+	return module.Fields.Walk(func(f *types.ModuleField) error {
+		var (
+			uc    = f.UniqueConstraint()
+			scope string
+			seen  = map[string]bool{}
+		)
+
+		if uc == nil || rves.HasField(f.Name) {
+			return nil
+		}
+
+		if uc.Scope != "" {
+			if sv := r.Values.FilterByName(uc.Scope); len(sv) > 0 {
+				scope = sv[0].Value
+			}
+		}
+
+		for _, v := range r.Values.FilterByName(f.Name) {
+			if strings.TrimSpace(v.Value) == "" {
+				continue
+			}
+
+			key := v.Value
+			if uc.IgnoreCase {
+				key = strings.ToLower(key)
+			}
+
+			if seen[key] {
+				rves.Push(types.RecordValueError{
+					Field:   f.Name,
+					Place:   v.Place,
+					Kind:    "duplicateValue",
+					Message: "value used more than once",
+				})
+
+				continue
+			}
+
+			seen[key] = true
+
+			dupID, err := svc.recordRepo.FindDuplicate(module.ID, r.ID, v, *uc, scope)
+			if err != nil {
+				return err
+			}
+
+			if dupID > 0 {
+				rves.Push(types.RecordValueError{
+					Field:   f.Name,
+					Place:   v.Place,
+					Kind:    "duplicateValue",
+					Message: fmt.Sprintf("value already used by record %d", dupID),
+					Meta:    map[string]interface{}{"recordID": strconv.FormatUint(dupID, 10)},
+				})
+			}
+		}
+
+		return nil
+	})
+}
+
+// preloadValues loads values of all readable fields
+//
+// Values of computed fields are (re)computed from readable values; computed
+// fields that reference fields the user can not read are left empty
 func (svc record) preloadValues(m *types.Module, rr ...*types.Record) error {
-	if rvs, err := svc.recordRepo.LoadValues(svc.readableFields(m), types.RecordSet(rr).IDs()); err != nil {
+	var (
+		readable = svc.readableFields(m)
+		canRead  = map[string]bool{}
+		computed = computedFields(m)
+	)
+
+	for _, name := range readable {
+		canRead[name] = true
+	}
+
+	if rvs, err := svc.recordRepo.LoadValues(readable, types.RecordSet(rr).IDs()); err != nil {
 		return err
-	} else {
+	} else if len(computed) == 0 {
 		return types.RecordSet(rr).Walk(func(r *types.Record) error {
 			r.Values = rvs.FilterByRecordID(r.ID)
 			return nil
 		})
+	} else {
+		computed = computed.readable(func(name string) bool { return canRead[name] })
+
+		return types.RecordSet(rr).Walk(func(r *types.Record) error {
+			r.Values = computed.apply(m, rvs.FilterByRecordID(r.ID))
+			return r.Values.Walk(func(v *types.RecordValue) error {
+				v.RecordID = r.ID
+				return nil
+			})
+		})
 	}
 }
 
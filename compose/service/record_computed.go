@@ -0,0 +1,239 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/ql"
+)
+
+type (
+	// computedField is a computed field with parsed expression
+	computedField struct {
+		*types.ModuleField
+
+		// Parsed expression, nil when expression is invalid
+		expr ql.ASTNode
+
+		// Fields referenced in the expression
+		refs []string
+	}
+
+	computedFieldSet []*computedField
+)
+
+var (
+	// Kinds of values computed fields can produce
+	computedResultKinds = map[string]bool{
+		"String": true,
+		"Number": true,
+	}
+)
+
+// parseComputedExpression parses expression of the computed field
+//
+// Returns names of all fields the expression references
+func parseComputedExpression(f *types.ModuleField) (n ql.ASTNode, refs []string, err error) {
+	var p = ql.NewParser()
+
+	p.OnIdent = func(i ql.Ident) (ql.Ident, error) {
+		refs = append(refs, i.Value)
+		return i, nil
+	}
+
+	if n, err = p.ParseExpression(f.ComputedExpression()); err != nil {
+		return nil, nil, errors.Wrapf(err, "invalid expression on computed field %q", f.Name)
+	}
+
+	return
+}
+
+// checkComputedField makes sure computed field's expression can be evaluated
+//
+// Expression can only reference single-value fields of the same module that are not calculated (computed or rollup)
+func checkComputedField(m *types.Module, f *types.ModuleField) error {
+	if f.Multi {
+		return errors.Errorf("computed field %q can not be multi-value", f.Name)
+	}
+
+	if !computedResultKinds[f.ValueKind()] {
+		return errors.Errorf("unsupported result kind %q on computed field %q", f.ValueKind(), f.Name)
+	}
+
+	if strings.TrimSpace(f.ComputedExpression()) == "" {
+		return errors.Errorf("computed field %q without expression", f.Name)
+	}
+
+	n, refs, err := parseComputedExpression(f)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range refs {
+		if rf := m.Fields.FindByName(name); rf == nil {
+			return errors.Errorf("unknown field %q in expression of computed field %q", name, f.Name)
+		} else if rf.IsComputed() || rf.IsRollup() || rf.Multi {
+			return errors.Errorf("can not use %q in expression of computed field %q", name, f.Name)
+		}
+	}
+
+	// Evaluate without values to catch unsupported operators & functions
+	_, err = ql.Evaluate(n, func(string) (interface{}, error) { return nil, nil })
+	return errors.Wrapf(err, "invalid expression on computed field %q", f.Name)
+}
+
+// computedFields parses expressions of all computed fields of the module
+//
+// Expressions are parsed once and evaluated with values of any number of records
+func computedFields(m *types.Module) (cc computedFieldSet) {
+	_ = m.Fields.Walk(func(f *types.ModuleField) error {
+		if !f.IsComputed() {
+			return nil
+		}
+
+		c := &computedField{ModuleField: f}
+		if n, refs, err := parseComputedExpression(f); err == nil {
+			c.expr, c.refs = n, refs
+		}
+
+		cc = append(cc, c)
+		return nil
+	})
+
+	return
+}
+
+// readable returns computed fields that can be read and only reference readable fields
+func (cc computedFieldSet) readable(canRead func(name string) bool) (out computedFieldSet) {
+	for _, c := range cc {
+		if !canRead(c.Name) {
+			continue
+		}
+
+		var ok = true
+		for _, name := range c.refs {
+			ok = ok && canRead(name)
+		}
+
+		if ok {
+			out = append(out, c)
+		}
+	}
+
+	return
+}
+
+// compute evaluates computed fields with the given record values
+//
+// Returns one value for each computed field; value is empty when
+// expression results in nil or can not be evaluated
+func (cc computedFieldSet) compute(m *types.Module, values types.RecordValueSet) (out types.RecordValueSet) {
+	var resolve = recordValueResolver(m, values)
+
+	for _, c := range cc {
+		var v = &types.RecordValue{Name: c.Name}
+
+		if c.expr != nil {
+			if r, err := ql.Evaluate(c.expr, resolve); err == nil {
+				v.Value = formatComputedValue(c.ModuleField, r)
+			}
+		}
+
+		out = append(out, v)
+	}
+
+	return
+}
+
+// apply replaces values of all module's computed fields with values computed by fields in the set
+//
+// Empty values are omitted, as are values of computed fields that are not in the set
+func (cc computedFieldSet) apply(m *types.Module, values types.RecordValueSet) types.RecordValueSet {
+	var (
+		out = types.RecordValueSet{}
+	)
+
+	for _, v := range values {
+		if f := m.Fields.FindByName(v.Name); f == nil || !f.IsComputed() {
+			out = append(out, v)
+		}
+	}
+
+	for _, v := range cc.compute(m, values) {
+		if v.Value != "" {
+			out = append(out, v)
+		}
+	}
+
+	return out
+}
+
+// computeValues evaluates all computed fields of the module with the given record values
+//
+// See computedFieldSet.compute
+func computeValues(m *types.Module, values types.RecordValueSet) types.RecordValueSet {
+	return computedFields(m).compute(m, values)
+}
+
+// recordValueResolver resolves field names in ql expressions to record values
+//
+// First value of the field is used; values of numeric fields are
+// converted to numbers, empty values to nil
+func recordValueResolver(m *types.Module, values types.RecordValueSet) ql.IdentResolver {
+	return func(name string) (interface{}, error) {
+		var (
+			f  = m.Fields.FindByName(name)
+			vv = values.FilterByName(name)
+		)
+
+		if f == nil {
+			return nil, errors.Errorf("unknown field %q", name)
+		}
+
+		if len(vv) == 0 || vv[0].Value == "" {
+			return nil, nil
+		}
+
+		if f.IsNumeric() {
+			if n, err := strconv.ParseFloat(vv[0].Value, 64); err == nil {
+				return n, nil
+			}
+		}
+
+		return vv[0].Value, nil
+	}
+}
+
+// formatComputedValue converts evaluated value to the field's result kind
+func formatComputedValue(f *types.ModuleField, r interface{}) string {
+	if !f.IsNumeric() || r == nil {
+		return ql.EvalToString(r)
+	}
+
+	n, err := strconv.ParseFloat(strings.TrimSpace(ql.EvalToString(r)), 64)
+	if err != nil {
+		return ""
+	}
+
+	if precision, ok := f.Options.Int64("precision"); ok && precision >= 0 {
+		return strconv.FormatFloat(n, 'f', int(precision), 64)
+	}
+
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
+
+// withComputedValues replaces values of computed fields with freshly computed ones
+//
+// Empty values are omitted
+func withComputedValues(m *types.Module, values types.RecordValueSet) types.RecordValueSet {
+	var cc = computedFields(m)
+
+	if len(cc) == 0 {
+		return values
+	}
+
+	return cc.apply(m, values)
+}
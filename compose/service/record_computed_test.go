@@ -0,0 +1,114 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+)
+
+func TestCheckComputedField(t *testing.T) {
+	var (
+		computed = func(expr string) *types.ModuleField {
+			return &types.ModuleField{Name: "total", Kind: "Computed", Options: types.ModuleFieldOptions{"expression": expr, "resultKind": "Number"}}
+		}
+
+		m = &types.Module{Fields: types.ModuleFieldSet{
+			&types.ModuleField{Name: "qty", Kind: "Number"},
+			&types.ModuleField{Name: "price", Kind: "Number"},
+			&types.ModuleField{Name: "tags", Kind: "String", Multi: true},
+			&types.ModuleField{Name: "other", Kind: "Computed", Options: types.ModuleFieldOptions{"expression": "qty"}},
+		}}
+
+		tc = map[string]string{
+			"qty * price":      "",
+			"ROUND(qty * 1.5)": "",
+			"":                 `computed field "total" without expression`,
+			"qty * missing":    `unknown field "missing" in expression of computed field "total"`,
+			"CONCAT(tags)":     `can not use "tags" in expression of computed field "total"`,
+			"other + 1":        `can not use "other" in expression of computed field "total"`,
+			"NOW()":            `invalid expression on computed field "total": unsupported function "NOW"`,
+		}
+	)
+
+	for expr, msg := range tc {
+		err := checkComputedField(m, computed(expr))
+		if msg == "" {
+			require.NoError(t, err, expr)
+		} else {
+			require.EqualError(t, err, msg, expr)
+		}
+	}
+
+	f := computed("qty")
+	f.Multi = true
+	require.EqualError(t, checkComputedField(m, f), `computed field "total" can not be multi-value`)
+
+	f = computed("qty")
+	f.Options["resultKind"] = "File"
+	require.EqualError(t, checkComputedField(m, f), `unsupported result kind "File" on computed field "total"`)
+}
+
+func TestWithComputedValues(t *testing.T) {
+	var (
+		req = require.New(t)
+		m   = &types.Module{Fields: types.ModuleFieldSet{
+			&types.ModuleField{Name: "qty", Kind: "Number"},
+			&types.ModuleField{Name: "price", Kind: "Number"},
+			&types.ModuleField{Name: "first", Kind: "String"},
+			&types.ModuleField{Name: "last", Kind: "String"},
+			&types.ModuleField{Name: "total", Kind: "Computed", Options: types.ModuleFieldOptions{"expression": "qty * price", "resultKind": "Number", "precision": 2.0}},
+			&types.ModuleField{Name: "fullName", Kind: "Computed", Options: types.ModuleFieldOptions{"expression": "first + ' ' + last"}},
+		}}
+
+		out = withComputedValues(m, types.RecordValueSet{
+			{Name: "qty", Value: "3"},
+			{Name: "price", Value: "2.5"},
+			{Name: "first", Value: "John"},
+			{Name: "last", Value: "Doe"},
+			{Name: "total", Value: "stale"},
+		})
+	)
+
+	req.Len(out, 6)
+	req.Equal("7.50", out.FilterByName("total")[0].Value)
+	req.Equal("John Doe", out.FilterByName("fullName")[0].Value)
+
+	// Values that can not be computed are removed
+	out = withComputedValues(m, types.RecordValueSet{
+		{Name: "qty", Value: "3"},
+		{Name: "total", Value: "stale"},
+	})
+
+	req.Len(out, 2)
+	req.Empty(out.FilterByName("total"))
+	req.Equal(" ", out.FilterByName("fullName")[0].Value)
+}
+
+func TestComputedFieldsReadable(t *testing.T) {
+	var (
+		req = require.New(t)
+		m   = &types.Module{Fields: types.ModuleFieldSet{
+			&types.ModuleField{Name: "qty", Kind: "Number"},
+			&types.ModuleField{Name: "salary", Kind: "Number"},
+			&types.ModuleField{Name: "double", Kind: "Computed", Options: types.ModuleFieldOptions{"expression": "qty * 2", "resultKind": "Number"}},
+			&types.ModuleField{Name: "leak", Kind: "Computed", Options: types.ModuleFieldOptions{"expression": "salary + 0", "resultKind": "Number"}},
+		}}
+
+		cc = computedFields(m).readable(func(name string) bool { return name != "salary" })
+
+		out = cc.apply(m, types.RecordValueSet{
+			{Name: "qty", Value: "3"},
+			{Name: "leak", Value: "1000"},
+		})
+	)
+
+	req.Len(cc, 1)
+	req.Equal("double", cc[0].Name)
+
+	// Stored value of the computed field that references unreadable field is removed as well
+	req.Len(out, 2)
+	req.Equal("6", out.FilterByName("double")[0].Value)
+	req.Empty(out.FilterByName("leak"))
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/cortezaproject/corteza-server/compose/encoder"
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/rh"
+)
+
+type (
+	// recordExport tells encoders more about the exported module fields
+	// and resolves labels of referenced records and users
+	recordExport struct {
+		svc    record
+		module *types.Module
+		filter types.RecordFilter
+
+		// Fields encoder asked about; labels are loaded only for these
+		fields map[string]bool
+
+		// Labels of referenced records (by field name) and users
+		records map[string]map[uint64]string
+		users   map[uint64]string
+	}
+
+	// Encoders that format values by the kind of the exported fields
+	// are prepared before the first record is exported
+	preparedEncoder interface {
+		Prepare(encoder.FieldInfo) error
+		Labels() bool
+	}
+)
+
+// Export encodes all records that match the filter
+//
+// Records are loaded (with their values) in chunks; labels of
+// referenced records are loaded for each chunk when encoder needs them
+func (svc record) Export(filter types.RecordFilter, enc Encoder) error {
+	m, err := svc.loadModule(filter.NamespaceID, filter.ModuleID)
+	if err != nil {
+		return err
+	}
+
+	if filter.Deleted != rh.FilterStateExcluded && !svc.ac.CanUndeleteRecord(svc.ctx, m) {
+		// Only users that can restore records can see the trash
+		return ErrNoUndeletePermissions.withStack()
+	}
+
+	filter.IsReadable = svc.ac.FilterReadableRecords(svc.ctx, m)
+
+	if err = svc.preparePaths(m, &filter); err != nil {
+		return err
+	}
+
+	var (
+		exp = &recordExport{
+			svc:     svc,
+			module:  m,
+			filter:  filter,
+			fields:  map[string]bool{},
+			records: map[string]map[uint64]string{},
+			users:   map[uint64]string{},
+		}
+
+		labels bool
+	)
+
+	if p, ok := enc.(preparedEncoder); ok {
+		if err = p.Prepare(exp); err != nil {
+			return err
+		}
+
+		labels = p.Labels()
+	}
+
+	return svc.recordRepo.Export(m, filter, func(set types.RecordSet) error {
+		if err := svc.preloadValues(m, set...); err != nil {
+			return err
+		}
+
+		if labels {
+			if err := exp.preloadRecordLabels(set); err != nil {
+				return err
+			}
+		}
+
+		return set.Walk(enc.Record)
+	})
+}
+
+func (exp *recordExport) Field(name string) *types.ModuleField {
+	exp.fields[name] = true
+	return exp.module.Fields.FindByName(name)
+}
+
+func (exp *recordExport) MaxValueCount(name string) (uint, error) {
+	return exp.svc.recordRepo.MaxValueCount(exp.module, exp.filter, name)
+}
+
+// RecordLabel returns label of the referenced record or its ID when label is not known
+func (exp *recordExport) RecordLabel(f *types.ModuleField, recordID uint64) string {
+	if f != nil {
+		if l, ok := exp.records[f.Name][recordID]; ok {
+			return l
+		}
+	}
+
+	return strconv.FormatUint(recordID, 10)
+}
+
+// UserLabel returns name (handle or email) of the user or its ID when user can not be found
+//
+// Users are loaded from the system service when they are first referenced
+func (exp *recordExport) UserLabel(userID uint64) string {
+	if l, ok := exp.users[userID]; ok {
+		return l
+	}
+
+	var l = strconv.FormatUint(userID, 10)
+
+	if DefaultSystemUser != nil {
+		if u, err := DefaultSystemUser.FindByID(exp.svc.ctx, userID); err != nil {
+			exp.svc.logger.Debug("could not load referenced user", zap.Uint64("userID", userID), zap.Error(err))
+		} else if u.Name != "" {
+			l = u.Name
+		} else if u.Handle != "" {
+			l = u.Handle
+		} else if u.Email != "" {
+			l = u.Email
+		}
+	}
+
+	exp.users[userID] = l
+	return l
+}
+
+// preloadRecordLabels loads labels of records that are referenced from the chunk
+//
+// Label is the value of the field set with the "labelField" option of the Record field;
+// only referenced records (and label fields) that the user can read are labeled
+func (exp *recordExport) preloadRecordLabels(set types.RecordSet) error {
+	return exp.module.Fields.Walk(func(f *types.ModuleField) error {
+		var (
+			refModuleID = f.RefModuleID()
+			labelField  = f.Options.String("labelField")
+		)
+
+		if !exp.fields[f.Name] || refModuleID == 0 || labelField == "" {
+			return nil
+		}
+
+		if exp.records[f.Name] == nil {
+			exp.records[f.Name] = map[uint64]string{}
+		}
+
+		var (
+			labels = exp.records[f.Name]
+			IDs    = make([]uint64, 0)
+		)
+
+		_ = set.Walk(func(r *types.Record) error {
+			for _, v := range r.Values.FilterByName(f.Name) {
+				if _, ok := labels[v.Ref]; !ok && v.Ref > 0 {
+					// Records without (readable) labels are exported with ID
+					labels[v.Ref] = strconv.FormatUint(v.Ref, 10)
+					IDs = append(IDs, v.Ref)
+				}
+			}
+
+			return nil
+		})
+
+		if len(IDs) == 0 {
+			return nil
+		}
+
+		rm, err := exp.svc.loadModule(exp.module.NamespaceID, refModuleID)
+		if err != nil {
+			// Referenced module is gone or not readable
+			return nil
+		}
+
+		if lf := rm.Fields.FindByName(labelField); lf == nil || !exp.svc.ac.CanReadRecordValue(exp.svc.ctx, lf) {
+			return nil
+		}
+
+		if IDs, err = exp.svc.recordRepo.FindReadableIDs(rm.ID, IDs, exp.svc.ac.FilterReadableRecords(exp.svc.ctx, rm)); err != nil {
+			return err
+		}
+
+		rvs, err := exp.svc.recordRepo.LoadValues([]string{labelField}, IDs)
+		if err != nil {
+			return err
+		}
+
+		for _, ID := range IDs {
+			var vv []string
+			for _, v := range rvs.FilterByRecordID(ID) {
+				if v.DeletedAt == nil {
+					vv = append(vv, v.Value)
+				}
+			}
+
+			if len(vv) > 0 {
+				labels[ID] = strings.Join(vv, ", ")
+			}
+		}
+
+		return nil
+	})
+}
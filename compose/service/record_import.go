@@ -0,0 +1,197 @@
+package service
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/cortezaproject/corteza-server/compose/repository"
+	"github.com/cortezaproject/corteza-server/compose/types"
+)
+
+const (
+	// Number of entries imported in one transaction (with IMPORT_ON_ERROR_SKIP);
+	// cancellation is checked and progress is stored after each batch
+	importBatchSize = 100
+
+	// Number of failed entries included in the import progress
+	importFailLogLimit = 100
+)
+
+var (
+	// Returned when import session was canceled (or removed) while import was running
+	errRecordImportCanceled = errors.New("record import canceled")
+)
+
+// Import creates (or updates, when session has upsert keys) records from the decoded entries
+//
+// With IMPORT_ON_ERROR_SKIP entries are imported in batches and progress is stored in the
+// same transaction as the imported records; interrupted import continues with the first
+// entry that was not processed.
+//
+// With IMPORT_ON_ERROR_FAIL all entries are imported in one transaction that is rolled
+// back on the first failure; interrupted import starts from the beginning.
+//
+// After-scripts (and queued script executions) of imported records are run
+// only when the transaction with the records is committed.
+//
+// Import session is finished (and stored) when Import returns, unless the import was
+// interrupted (context canceled); interrupted session is left unfinished and is resumed
+// by the next worker
+func (svc record) Import(ses *types.RecordImportSession, dec Decoder) (err error) {
+	var (
+		// Progress is stored with the imported records
+		progress = repository.RecordImportSession(svc.ctx, svc.db)
+
+		// Entries processed before the import was interrupted
+		skip = ses.Processed()
+
+		entry uint64
+		batch = make([]*types.Record, 0, importBatchSize)
+		fail  = ses.OnError == IMPORT_ON_ERROR_FAIL
+	)
+
+	if fail {
+		// Progress needs to be visible while the (only) transaction is running
+		progress = repository.RecordImportSession(svc.ctx, nil)
+		skip = 0
+		ses.Completed, ses.Failed = 0, 0
+	}
+
+	flush := func(svc record) error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		defer func() { batch = batch[:0] }()
+
+		if err := svc.importCanceled(ses); err != nil {
+			return err
+		}
+
+		var (
+			first     = entry - uint64(len(batch))
+			completed = ses.Completed
+			failed    = ses.Failed
+		)
+
+		err := svc.transaction(func(svc record) error {
+			for i, r := range batch {
+				if err := svc.importRecord(ses, r); err != nil {
+					ses.Failed++
+					ses.FailReason = err.Error()
+
+					f := &types.RecordImportFailure{SessionID: ses.ID, Entry: first + uint64(i) + 1, Reason: err.Error()}
+					if rves, ok := err.(*types.RecordValueErrorSet); ok {
+						f.Errors = rves.Set
+					}
+
+					if err := progress.CreateFailure(f); err != nil {
+						return err
+					}
+
+					if fail {
+						return err
+					}
+				} else {
+					ses.Completed++
+				}
+			}
+
+			_, err := progress.Update(ses)
+			return err
+		})
+
+		if err != nil && !fail {
+			// Nothing from this batch was stored
+			ses.Completed, ses.Failed = completed, failed
+		}
+
+		return err
+	}
+
+	run := func(svc record) error {
+		err := dec.Records(ses.Fields, func(r *types.Record) error {
+			if entry++; entry <= skip {
+				return nil
+			}
+
+			if batch = append(batch, r); len(batch) < importBatchSize {
+				return nil
+			}
+
+			return flush(svc)
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return flush(svc)
+	}
+
+	if fail {
+		if err = svc.transaction(run); err != nil {
+			// Whole import was rolled back
+			ses.Completed, ses.Failed = 0, 0
+		}
+	} else {
+		err = run(svc)
+	}
+
+	if svc.ctx.Err() != nil {
+		return errors.Wrap(svc.ctx.Err(), "record import interrupted")
+	}
+
+	fa := time.Now()
+	ses.FinishedAt = &fa
+
+	if err != nil && err != errRecordImportCanceled {
+		ses.FailReason = err.Error()
+	}
+
+	if _, uerr := repository.RecordImportSession(svc.ctx, nil).Update(ses); uerr != nil {
+		// Session (with the imported file) is kept until it is stored as finished
+		ses.FinishedAt = nil
+		return errors.Wrap(uerr, "could not finish record import session")
+	}
+
+	if err == errRecordImportCanceled {
+		return nil
+	}
+
+	return err
+}
+
+// importCanceled returns errRecordImportCanceled when session was canceled or removed
+//
+// Session is read outside of the import transaction
+func (svc record) importCanceled(ses *types.RecordImportSession) error {
+	cur, err := repository.RecordImportSession(svc.ctx, nil).FindByID(ses.ID)
+	if err == repository.ErrRecordImportSessionNotFound {
+		return errRecordImportCanceled
+	} else if err != nil {
+		return err
+	}
+
+	if cur.CanceledAt != nil {
+		ses.CanceledAt = cur.CanceledAt
+		return errRecordImportCanceled
+	}
+
+	return nil
+}
+
+func (svc record) importRecord(ses *types.RecordImportSession, mod *types.Record) (err error) {
+	mod.NamespaceID = ses.NamespaceID
+	mod.ModuleID = ses.ModuleID
+	mod.OwnedBy = ses.UserID
+
+	if len(ses.UpsertKeys) > 0 {
+		_, err = svc.Upsert(mod, ses.UpsertKeys...)
+	} else {
+		_, err = svc.Create(mod)
+	}
+
+	return
+}
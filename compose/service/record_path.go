@@ -0,0 +1,205 @@
+package service
+
+import (
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/cortezaproject/corteza-server/compose/repository"
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/permissions"
+	"github.com/cortezaproject/corteza-server/pkg/ql"
+	systemTypes "github.com/cortezaproject/corteza-server/system/types"
+)
+
+// recordPaths returns paths (<ref>.<field>) used in the filter expression and columns (sort, metrics...)
+func recordPaths(filter string, columns ...string) (pp []string, err error) {
+	var (
+		p    = ql.NewParser()
+		seen = map[string]bool{}
+	)
+
+	p.OnIdent = func(i ql.Ident) (ql.Ident, error) {
+		if strings.Contains(i.Value, ".") && !seen[i.Value] {
+			seen[i.Value] = true
+			pp = append(pp, i.Value)
+		}
+
+		return i, nil
+	}
+
+	if filter != "" {
+		if _, err = p.ParseExpression(filter); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, cc := range columns {
+		if cc == "" {
+			continue
+		}
+
+		if _, err = p.ParseColumns(cc); err != nil {
+			return nil, err
+		}
+	}
+
+	return
+}
+
+// preparePaths checks permissions of fields and records referenced by paths in the filter
+// and sort and prepares everything the repository needs to join them
+//
+// Referenced records are limited to readable ones (IsRefReadable) and values of referenced
+// users are loaded from the system service (UserValues) when its users can not be joined
+// (see repository.IsSystemUserJoinable).
+//
+// Unknown references and fields are left to the repository to report
+func (svc record) preparePaths(m *types.Module, f *types.RecordFilter) error {
+	pp, err := recordPaths(f.Filter, f.Sort)
+	if err != nil {
+		return err
+	}
+
+	return svc.checkPaths(m, f, pp)
+}
+
+// prepareReportPaths checks permissions of fields and records referenced by paths in
+// the report and returns record-level permission check filters of referenced modules
+func (svc record) prepareReportPaths(m *types.Module, metrics, dimensions, filter string) (map[string]*permissions.ResourceFilter, error) {
+	var f = &types.RecordFilter{}
+
+	pp, err := recordPaths(filter, metrics, dimensions)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reports can only use fields of referenced records; the
+	// rest are left to the report builder to report
+	var refs = make([]string, 0, len(pp))
+	for _, path := range pp {
+		if rf := m.Fields.FindByName(strings.SplitN(path, ".", 2)[0]); rf != nil && rf.Kind == "Record" {
+			refs = append(refs, path)
+		}
+	}
+
+	if err = svc.checkPaths(m, f, refs); err != nil {
+		return nil, err
+	}
+
+	return f.IsRefReadable, nil
+}
+
+// checkPaths checks permissions and prepares the filter for paths (see preparePaths)
+func (svc record) checkPaths(m *types.Module, f *types.RecordFilter, pp []string) (err error) {
+	if len(pp) == 0 {
+		return nil
+	}
+
+	var (
+		// Users loaded from the system service, shared among paths
+		users = map[uint64]*systemTypes.User{}
+	)
+
+	for _, path := range pp {
+		var (
+			s         = strings.SplitN(path, ".", 2)
+			ref, name = s[0], s[1]
+			toUser    = repository.IsRecordUserColumn(ref)
+		)
+
+		if !toUser {
+			rf := m.Fields.FindByName(ref)
+			if rf == nil {
+				continue
+			}
+
+			if !svc.ac.CanReadRecordValue(svc.ctx, rf) {
+				return ErrNoReadPermissions.withStack()
+			}
+
+			switch rf.Kind {
+			case "Record":
+				rm, err := svc.loadModule(m.NamespaceID, rf.RefModuleID())
+				if err != nil {
+					return err
+				}
+
+				if field := rm.Fields.FindByName(name); field != nil && !svc.ac.CanReadRecordValue(svc.ctx, field) {
+					return ErrNoReadPermissions.withStack()
+				}
+
+				if f.IsRefReadable == nil {
+					f.IsRefReadable = map[string]*permissions.ResourceFilter{}
+				}
+
+				f.IsRefReadable[ref] = svc.ac.FilterReadableRecordsAs(svc.ctx, rm, repository.RecordRefAlias(ref))
+				continue
+			case "User", "Owner":
+				toUser = true
+			default:
+				continue
+			}
+		}
+
+		// Users are joined by the repository
+		if repository.IsSystemUserJoinable() {
+			continue
+		}
+
+		if err = svc.loadPathUsers(m, ref, users); err != nil {
+			return err
+		}
+
+		if f.UserValues == nil {
+			f.UserValues = map[string]map[uint64]string{}
+		}
+
+		vv := map[uint64]string{}
+		for ID, u := range users {
+			if u == nil {
+				continue
+			}
+
+			switch name {
+			case "email":
+				vv[ID] = u.Email
+			case "name":
+				vv[ID] = u.Name
+			case "handle":
+				vv[ID] = u.Handle
+			}
+		}
+
+		f.UserValues[path] = vv
+	}
+
+	return nil
+}
+
+// loadPathUsers loads users referenced by the user column or field (ref) of the module's records
+//
+// Users that can not be loaded are ignored (nil)
+func (svc record) loadPathUsers(m *types.Module, ref string, users map[uint64]*systemTypes.User) error {
+	if svc.users == nil {
+		return nil
+	}
+
+	IDs, err := svc.recordRepo.UserIDs(m, ref)
+	if err != nil {
+		return err
+	}
+
+	for _, ID := range IDs {
+		if _, ok := users[ID]; ok {
+			continue
+		}
+
+		if users[ID], err = svc.users.FindByID(svc.ctx, ID); err != nil {
+			svc.log(svc.ctx).Debug("could not load referenced user", zap.Uint64("userID", ID), zap.Error(err))
+			users[ID] = nil
+		}
+	}
+
+	return nil
+}
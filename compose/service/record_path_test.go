@@ -0,0 +1,17 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordPaths(t *testing.T) {
+	pp, err := recordPaths("account.revenue > 1000 AND foo = 7 OR account.revenue < 10", "ownedBy.email, bar DESC, account.name")
+	require.NoError(t, err)
+	require.Equal(t, []string{"account.revenue", "ownedBy.email", "account.name"}, pp)
+
+	pp, err = recordPaths("", "")
+	require.NoError(t, err)
+	require.Empty(t, pp)
+}
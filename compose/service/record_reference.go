@@ -0,0 +1,256 @@
+package service
+
+import (
+	"time"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+)
+
+type (
+	// recordDeletion keeps track of records deleted with one (cascading) delete
+	recordDeletion struct {
+		namespace *types.Namespace
+		modules   types.ModuleSet
+		fields    types.ModuleFieldSet
+		deleted   map[uint64]bool
+	}
+)
+
+// refFields returns Record fields that reference records of the module
+func refFields(ff types.ModuleFieldSet, moduleID uint64) (out types.ModuleFieldSet) {
+	for _, f := range ff {
+		if f.RefModuleID() == moduleID {
+			out = append(out, f)
+		}
+	}
+
+	return
+}
+
+// namespaceFields loads all modules of the namespace and their fields
+func (svc record) namespaceFields(namespaceID uint64) (mm types.ModuleSet, ff types.ModuleFieldSet, err error) {
+	if mm, _, err = svc.moduleRepo.Find(types.ModuleFilter{NamespaceID: namespaceID}); err != nil || len(mm) == 0 {
+		return
+	}
+
+	if ff, err = svc.moduleRepo.FindFields(mm.IDs()...); err != nil {
+		return
+	}
+
+	_ = mm.Walk(func(m *types.Module) error {
+		m.Fields = ff.FilterByModule(m.ID)
+		return nil
+	})
+
+	return
+}
+
+// References returns records (of all modules in the namespace) that reference the record
+//
+// Only records and fields that current user can read are returned
+func (svc record) References(namespaceID, recordID uint64) (rr types.RecordReferenceSet, err error) {
+	if recordID == 0 {
+		return nil, ErrInvalidID.withStack()
+	}
+
+	_, m, r, err := svc.loadCombo(namespaceID, 0, recordID)
+	if err != nil {
+		return
+	}
+
+	if !svc.ac.CanReadRecordInstance(svc.ctx, m, r) {
+		return nil, ErrNoReadPermissions.withStack()
+	}
+
+	mm, ff, err := svc.namespaceFields(namespaceID)
+	if err != nil {
+		return
+	}
+
+	rr = types.RecordReferenceSet{}
+	for _, f := range refFields(ff, m.ID) {
+		rm := mm.FindByID(f.ModuleID)
+		if rm == nil || !svc.ac.CanReadModule(svc.ctx, rm) || !svc.ac.CanReadRecordValue(svc.ctx, f) {
+			continue
+		}
+
+		IDs, err := svc.recordRepo.FindRefs(rm.ID, f.Name, r.ID, svc.ac.FilterReadableRecords(svc.ctx, rm))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ID := range IDs {
+			rr = append(rr, &types.RecordReference{ModuleID: rm.ID, RecordID: ID, Field: f.Name})
+		}
+	}
+
+	return
+}
+
+// deleteRecord soft-deletes record with its values and
+// applies onDelete behaviour of fields that reference it
+func (svc record) deleteRecord(d *recordDeletion, m *types.Module, r *types.Record) (err error) {
+	d.deleted[r.ID] = true
+
+	if err = svc.deleteRefs(d, m, r); err != nil {
+		return
+	}
+
+	now := time.Now()
+	r.DeletedAt = &now
+	r.DeletedBy = auth.GetIdentityFromContext(svc.ctx).Identity()
+
+	// All values (not just readable ones) are kept in the snapshot of the deleted record
+	old, err := svc.recordRepo.LoadValues(m.Fields.Names(), []uint64{r.ID})
+	if err != nil {
+		return
+	}
+
+	if err = svc.recordRepo.Delete(r); err != nil {
+		return
+	}
+
+	if err = svc.recordRepo.DeleteValues(r); err != nil {
+		return
+	}
+
+	if err = svc.updateRollups(m, old, nil); err != nil {
+		return
+	}
+
+	return svc.storeRevision(types.RecordRevisionDelete, r, old, old)
+}
+
+// deleteRefs restricts deletion, removes references (set null) or deletes
+// referencing records (cascade), as configured on referencing Record fields
+//
+// Cascaded records are deleted as if user deleted them: deletion fails when user
+// can not delete any of them and delete scripts are run for each one.
+//
+// Records that are deleted with the same (cascading) delete are ignored
+func (svc record) deleteRefs(d *recordDeletion, m *types.Module, r *types.Record) error {
+	for _, f := range refFields(d.fields, m.ID) {
+		if f.RefOnDelete() == types.RecordRefOnDeleteNone {
+			continue
+		}
+
+		rm := d.modules.FindByID(f.ModuleID)
+		if rm == nil {
+			continue
+		}
+
+		found, err := svc.recordRepo.FindRefs(rm.ID, f.Name, r.ID, nil)
+		if err != nil {
+			return err
+		}
+
+		var IDs []uint64
+		for _, ID := range found {
+			if !d.deleted[ID] {
+				IDs = append(IDs, ID)
+			}
+		}
+
+		if len(IDs) == 0 {
+			continue
+		}
+
+		switch f.RefOnDelete() {
+		case types.RecordRefOnDeleteRestrict:
+			return ErrRecordReferenced.withStack()
+
+		case types.RecordRefOnDeleteSetNull:
+			if err = svc.deleteRefValues(rm, f, r.ID, IDs); err != nil {
+				return err
+			}
+
+		case types.RecordRefOnDeleteCascade:
+			for _, ID := range IDs {
+				if d.deleted[ID] {
+					// Deleted in the meantime (through some other reference)
+					continue
+				}
+
+				if err = svc.deleteCascaded(d, rm, ID); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// deleteCascaded deletes record that references deleted record
+//
+// Permissions and delete scripts are checked and run as with DeleteByID
+func (svc record) deleteCascaded(d *recordDeletion, m *types.Module, ID uint64) (err error) {
+	r, err := svc.recordRepo.FindByID(m.NamespaceID, ID)
+	if err != nil {
+		return
+	}
+
+	if !svc.ac.CanDeleteRecordInstance(svc.ctx, m, r) {
+		return ErrNoDeletePermissions.withStack()
+	}
+
+	if err = svc.preloadValues(m, r); err != nil {
+		return
+	}
+
+	if err = svc.sr.BeforeRecordDelete(svc.ctx, d.namespace, m, r); err != nil {
+		return
+	}
+
+	if err = svc.deleteRecord(d, m, r); err != nil {
+		return
+	}
+
+	return svc.sr.AfterRecordDelete(svc.ctx, d.namespace, m, r)
+}
+
+// deleteRefValues removes values that reference deleted record and logs the change
+//
+// Computed values of changed records are recomputed and rollups that
+// aggregate them are updated
+func (svc record) deleteRefValues(m *types.Module, f *types.ModuleField, refID uint64, IDs []uint64) error {
+	before, err := svc.recordRepo.LoadValues(m.Fields.Names(), IDs)
+	if err != nil {
+		return err
+	}
+
+	if err = svc.recordRepo.DeleteRefValues(f.Name, refID, IDs...); err != nil {
+		return err
+	}
+
+	after, err := svc.recordRepo.LoadValues(m.Fields.Names(), IDs)
+	if err != nil {
+		return err
+	}
+
+	var (
+		computed = computedFields(m)
+		changed  = types.RecordValueSet{}
+	)
+
+	for _, ID := range IDs {
+		var values = after.FilterByRecordID(ID)
+
+		if len(computed) > 0 {
+			values = computed.apply(m, values)
+			if err = svc.recordRepo.UpdateValues(ID, values); err != nil {
+				return err
+			}
+		}
+
+		r := &types.Record{ID: ID, ModuleID: m.ID, NamespaceID: m.NamespaceID}
+		if err = svc.storeRevision(types.RecordRevisionUpdate, r, before.FilterByRecordID(ID), values); err != nil {
+			return err
+		}
+
+		changed = append(changed, values...)
+	}
+
+	return svc.updateRollups(m, before, changed)
+}
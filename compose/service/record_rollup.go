@@ -0,0 +1,296 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/cortezaproject/corteza-server/compose/repository"
+	"github.com/cortezaproject/corteza-server/compose/types"
+)
+
+type (
+	// rollupDependencies caches rollup fields of namespaces by ID of the module they aggregate
+	//
+	// Namespace is reloaded when its modules change, see ModuleRepository.Version
+	rollupDependencies struct {
+		l  sync.RWMutex
+		nn map[uint64]*rollupNamespace
+	}
+
+	rollupNamespace struct {
+		version string
+		fields  map[uint64]types.ModuleFieldSet
+	}
+)
+
+var (
+	// Aggregate functions supported by rollup fields
+	rollupAggregates = map[string]bool{
+		"COUNT": true,
+		"SUM":   true,
+		"MIN":   true,
+		"MAX":   true,
+		"AVG":   true,
+	}
+
+	rollupDeps = &rollupDependencies{nn: map[uint64]*rollupNamespace{}}
+)
+
+// checkRollupField makes sure rollup field can aggregate records of the child module
+//
+// Child module must have a Record field that references the module of the rollup field
+func checkRollupField(m *types.Module, f *types.ModuleField, child *types.Module) error {
+	var r = f.Rollup()
+
+	if f.Multi {
+		return errors.Errorf("rollup field %q can not be multi-value", f.Name)
+	}
+
+	if !rollupAggregates[r.Aggregate] {
+		return errors.Errorf("unsupported aggregate %q on rollup field %q", r.Aggregate, f.Name)
+	}
+
+	if rf := child.Fields.FindByName(r.Field); rf == nil || rf.RefModuleID() != m.ID {
+		return errors.Errorf("field %q does not reference records of this module (rollup field %q)", r.Field, f.Name)
+	}
+
+	if r.Aggregate != "COUNT" {
+		if vf := child.Fields.FindByName(r.ValueField); vf == nil || !vf.IsNumeric() {
+			return errors.Errorf("rollup field %q requires a numeric value field", f.Name)
+		}
+	}
+
+	metrics, dimensions, filter := rollupReport(r)
+	if _, _, err := repository.NewRecordReportBuilder(child).Build(metrics, dimensions, filter); err != nil {
+		return errors.Wrapf(err, "invalid filter on rollup field %q", f.Name)
+	}
+
+	return nil
+}
+
+// rollupReport returns report metrics, dimensions & filter that aggregate child records
+// by referenced record; when parentIDs are given, only records referencing them are included
+func rollupReport(r *types.ModuleFieldRollup, parentIDs ...uint64) (metrics, dimensions, filter string) {
+	var ff = []string{}
+
+	if r.Aggregate != "COUNT" {
+		metrics = fmt.Sprintf("%s(%s)", r.Aggregate, r.ValueField)
+	}
+
+	if strings.TrimSpace(r.Filter) != "" {
+		ff = append(ff, "("+r.Filter+")")
+	}
+
+	if len(parentIDs) > 0 {
+		var pp = make([]string, len(parentIDs))
+		for i, ID := range parentIDs {
+			pp[i] = fmt.Sprintf("%s = '%d'", r.Field, ID)
+		}
+
+		ff = append(ff, "("+strings.Join(pp, " OR ")+")")
+	}
+
+	return metrics, r.Field, strings.Join(ff, " AND ")
+}
+
+// rollupValues aggregates child records for the rollup field
+//
+// Returns values keyed by ID of the referenced record; records without
+// any (matching) child records are not included, see rollupDefault
+func rollupValues(repo repository.RecordRepository, f *types.ModuleField, child *types.Module, parentIDs ...uint64) (map[uint64]string, error) {
+	var (
+		r   = f.Rollup()
+		out = map[uint64]string{}
+
+		metrics, dimensions, filter = rollupReport(r, parentIDs...)
+	)
+
+	// Rollups aggregate all records, regardless of who is looking at them
+	report, err := repo.Report(child, metrics, dimensions, filter, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range report.Rows {
+		ID, err := strconv.ParseUint(fmt.Sprintf("%v", row["dimension_0"]), 10, 64)
+		if err != nil || ID == 0 {
+			continue
+		}
+
+		if r.Aggregate == "COUNT" {
+			out[ID] = fmt.Sprintf("%v", row["count"])
+		} else {
+			out[ID] = formatComputedValue(f, row["metric_0"])
+		}
+	}
+
+	return out, nil
+}
+
+// rollupDefault returns value of the rollup field on records without child records
+func rollupDefault(f *types.ModuleField) string {
+	if f.Rollup().Aggregate == "COUNT" {
+		return "0"
+	}
+
+	return ""
+}
+
+// withRollupValues replaces values of rollup fields with stored ones
+//
+// Rollups are never set directly; records without stored
+// values (new records) get the default, see rollupDefault
+func withRollupValues(m *types.Module, values, stored types.RecordValueSet) types.RecordValueSet {
+	var out = types.RecordValueSet{}
+
+	for _, v := range values {
+		if f := m.Fields.FindByName(v.Name); f == nil || !f.IsRollup() {
+			out = append(out, v)
+		}
+	}
+
+	_ = m.Fields.Walk(func(f *types.ModuleField) error {
+		if !f.IsRollup() {
+			return nil
+		}
+
+		var v = &types.RecordValue{Name: f.Name, Value: rollupDefault(f)}
+		if sv := stored.FilterByName(f.Name); len(sv) > 0 {
+			v.Value = sv[0].Value
+		}
+
+		if v.Value != "" {
+			out = append(out, v)
+		}
+
+		return nil
+	})
+
+	return out
+}
+
+// rollupRefs returns IDs of records referenced with the field
+func rollupRefs(field string, sets ...types.RecordValueSet) (IDs []uint64) {
+	var seen = map[uint64]bool{}
+
+	for _, set := range sets {
+		for _, v := range set.FilterByName(field) {
+			ID := v.Ref
+			if ID == 0 {
+				ID, _ = strconv.ParseUint(v.Value, 10, 64)
+			}
+
+			if ID > 0 && !seen[ID] {
+				seen[ID] = true
+				IDs = append(IDs, ID)
+			}
+		}
+	}
+
+	return
+}
+
+// reset removes cached rollup fields of the namespace
+func (d *rollupDependencies) reset(namespaceID uint64) {
+	d.l.Lock()
+	defer d.l.Unlock()
+	delete(d.nn, namespaceID)
+}
+
+// rollupFields returns rollup fields (of all modules in the namespace) that aggregate records of the module
+func (svc record) rollupFields(m *types.Module) (types.ModuleFieldSet, error) {
+	version, err := svc.moduleRepo.Version(m.NamespaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	rollupDeps.l.RLock()
+	ns := rollupDeps.nn[m.NamespaceID]
+	rollupDeps.l.RUnlock()
+
+	if ns == nil || ns.version != version {
+		_, ff, err := svc.namespaceFields(m.NamespaceID)
+		if err != nil {
+			return nil, err
+		}
+
+		ns = &rollupNamespace{version: version, fields: map[uint64]types.ModuleFieldSet{}}
+		for _, f := range ff {
+			if f.IsRollup() {
+				ns.fields[f.Rollup().ModuleID] = append(ns.fields[f.Rollup().ModuleID], f)
+			}
+		}
+
+		rollupDeps.l.Lock()
+		rollupDeps.nn[m.NamespaceID] = ns
+		rollupDeps.l.Unlock()
+	}
+
+	return ns.fields[m.ID], nil
+}
+
+// updateRollups recalculates rollup values on records referenced by the changed record
+//
+// Values from before and after the change are used so that
+// records that are no longer referenced are updated as well.
+//
+// Referenced records are locked before child records are aggregated; concurrent
+// writes of their children wait for each other and the last one sees all changes.
+//
+// Rollups are updated regardless of user's permissions on referenced
+// records (see types.ModuleFieldRollup); errors fail the change
+func (svc record) updateRollups(m *types.Module, before, after types.RecordValueSet) error {
+	ff, err := svc.rollupFields(m)
+	if err != nil {
+		return err
+	}
+
+	var (
+		values types.RecordValueSet
+		locked []uint64
+	)
+
+	for _, f := range ff {
+		locked = append(locked, rollupRefs(f.Rollup().Field, before, after)...)
+	}
+
+	if err = svc.recordRepo.LockByIDs(locked...); err != nil {
+		return err
+	}
+
+	for _, f := range ff {
+		var parentIDs = rollupRefs(f.Rollup().Field, before, after)
+		if len(parentIDs) == 0 {
+			continue
+		}
+
+		rvs, err := rollupValues(svc.recordRepo, f, m, parentIDs...)
+		if err != nil {
+			return errors.Wrapf(err, "can not calculate rollup field %q", f.Name)
+		}
+
+		// Reference might point to a record of some other module
+		if parentIDs, err = svc.recordRepo.FindReadableIDs(f.ModuleID, parentIDs, nil); err != nil {
+			return err
+		}
+
+		for _, ID := range parentIDs {
+			v, ok := rvs[ID]
+			if !ok {
+				v = rollupDefault(f)
+			}
+
+			values = append(values, &types.RecordValue{RecordID: ID, Name: f.Name, Value: v})
+		}
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	return svc.recordRepo.PartialUpdateValues(values...)
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+)
+
+func TestCheckRollupField(t *testing.T) {
+	var (
+		rollup = func(aggregate, valueField, filter string) *types.ModuleField {
+			return &types.ModuleField{Name: "won", Kind: "Rollup", Options: types.ModuleFieldOptions{
+				"moduleID":   "2",
+				"field":      "account",
+				"aggregate":  aggregate,
+				"valueField": valueField,
+				"filter":     filter,
+			}}
+		}
+
+		m     = &types.Module{ID: 1}
+		child = &types.Module{ID: 2, Fields: types.ModuleFieldSet{
+			&types.ModuleField{Name: "account", Kind: "Record", Options: types.ModuleFieldOptions{"moduleID": "1"}},
+			&types.ModuleField{Name: "other", Kind: "Record", Options: types.ModuleFieldOptions{"moduleID": "3"}},
+			&types.ModuleField{Name: "amount", Kind: "Number"},
+			&types.ModuleField{Name: "stage", Kind: "String"},
+		}}
+
+		tc = []struct {
+			f   *types.ModuleField
+			msg string
+		}{
+			{rollup("count", "", ""), ""},
+			{rollup("SUM", "amount", "stage = 'won'"), ""},
+			{rollup("MEDIAN", "amount", ""), `unsupported aggregate "MEDIAN" on rollup field "won"`},
+			{rollup("SUM", "stage", ""), `rollup field "won" requires a numeric value field`},
+			{rollup("AVG", "", ""), `rollup field "won" requires a numeric value field`},
+			{rollup("COUNT", "", "missing = 1"), `invalid filter on rollup field "won": could not parse filters "(missing = 1)": unknown field "missing"`},
+		}
+	)
+
+	for _, c := range tc {
+		err := checkRollupField(m, c.f, child)
+		if c.msg == "" {
+			require.NoError(t, err, c.f.Options)
+		} else {
+			require.EqualError(t, err, c.msg, c.f.Options)
+		}
+	}
+
+	f := rollup("COUNT", "", "")
+	f.Options["field"] = "other"
+	require.EqualError(t, checkRollupField(m, f, child), `field "other" does not reference records of this module (rollup field "won")`)
+}
+
+func TestRollupReport(t *testing.T) {
+	var (
+		req = require.New(t)
+		r   = &types.ModuleFieldRollup{Field: "account", Aggregate: "SUM", ValueField: "amount", Filter: "stage = 'won'"}
+	)
+
+	metrics, dimensions, filter := rollupReport(r, 10, 20)
+	req.Equal("SUM(amount)", metrics)
+	req.Equal("account", dimensions)
+	req.Equal("(stage = 'won') AND (account = '10' OR account = '20')", filter)
+
+	r = &types.ModuleFieldRollup{Field: "account", Aggregate: "COUNT"}
+	metrics, _, filter = rollupReport(r)
+	req.Empty(metrics)
+	req.Empty(filter)
+}
+
+func TestWithRollupValues(t *testing.T) {
+	var (
+		req = require.New(t)
+		m   = &types.Module{Fields: types.ModuleFieldSet{
+			&types.ModuleField{Name: "name", Kind: "String"},
+			&types.ModuleField{Name: "open", Kind: "Rollup", Options: types.ModuleFieldOptions{"aggregate": "COUNT"}},
+			&types.ModuleField{Name: "won", Kind: "Rollup", Options: types.ModuleFieldOptions{"aggregate": "SUM"}},
+		}}
+	)
+
+	// New records
+	out := withRollupValues(m, types.RecordValueSet{{Name: "name", Value: "Acme"}, {Name: "won", Value: "1000"}}, nil)
+	req.Len(out, 2)
+	req.Equal("0", out.FilterByName("open")[0].Value)
+
+	// Stored values are kept
+	out = withRollupValues(m, types.RecordValueSet{{Name: "name", Value: "Acme"}}, types.RecordValueSet{
+		{Name: "name", Value: "Old"},
+		{Name: "open", Value: "3"},
+		{Name: "won", Value: "250"},
+	})
+	req.Len(out, 3)
+	req.Equal("Acme", out.FilterByName("name")[0].Value)
+	req.Equal("3", out.FilterByName("open")[0].Value)
+	req.Equal("250", out.FilterByName("won")[0].Value)
+}
+
+func TestRollupRefs(t *testing.T) {
+	require.Equal(t,
+		[]uint64{10, 20},
+		rollupRefs("account",
+			types.RecordValueSet{{Name: "account", Value: "10", Ref: 10}, {Name: "name", Value: "30"}},
+			types.RecordValueSet{{Name: "account", Value: "20"}, {Name: "account", Value: "10"}},
+		),
+	)
+}
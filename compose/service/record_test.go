@@ -1,10 +1,13 @@
 package service
 
 import (
+	"context"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cortezaproject/corteza-server/compose/repository"
 	"github.com/cortezaproject/corteza-server/compose/types"
 	"github.com/cortezaproject/corteza-server/pkg/permissions"
 )
@@ -71,3 +74,133 @@ func TestValueSanitizer(t *testing.T) {
 	req.NoError(err)
 	req.Len(out, 0, "expecting 0 record values after sanitization, got %d", len(rvs))
 }
+
+type (
+	// uniqueRecordRepo finds duplicates of the given values only
+	uniqueRecordRepo struct {
+		repository.RecordRepository
+		used map[string]uint64
+	}
+
+	// Repositories and access control that serve one record with its revision
+	revisionTestNamespaces struct {
+		repository.NamespaceRepository
+	}
+
+	revisionTestModules struct {
+		repository.ModuleRepository
+	}
+
+	revisionTestRecords struct {
+		repository.RecordRepository
+		record *types.Record
+	}
+
+	revisionTestRevisions struct {
+		repository.RecordRevisionRepository
+		revision *types.RecordRevision
+	}
+
+	revisionTestAccess struct {
+		recordAccessController
+	}
+)
+
+func (r uniqueRecordRepo) FindDuplicate(moduleID, recordID uint64, value *types.RecordValue, uc types.ModuleFieldUniqueConstraint, scope string) (uint64, error) {
+	return r.used[value.Value], nil
+}
+
+func (revisionTestNamespaces) FindByID(ID uint64) (*types.Namespace, error) {
+	return &types.Namespace{ID: ID}, nil
+}
+
+func (revisionTestModules) FindByID(namespaceID, moduleID uint64) (*types.Module, error) {
+	return &types.Module{ID: moduleID, NamespaceID: namespaceID}, nil
+}
+
+func (revisionTestModules) FindFields(moduleIDs ...uint64) (types.ModuleFieldSet, error) {
+	return types.ModuleFieldSet{}, nil
+}
+
+func (r revisionTestRecords) FindByID(namespaceID, recordID uint64) (*types.Record, error) {
+	return r.record, nil
+}
+
+func (r revisionTestRevisions) FindByRevision(namespaceID, recordID uint64, revision uint) (*types.RecordRevision, error) {
+	return r.revision, nil
+}
+
+func (revisionTestAccess) CanReadNamespace(context.Context, *types.Namespace) bool {
+	return true
+}
+
+func (revisionTestAccess) CanReadModule(context.Context, *types.Module) bool {
+	return true
+}
+
+func TestRestoreRevisionModuleMismatch(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		svc = record{
+			ctx:          context.Background(),
+			ac:           revisionTestAccess{},
+			nsRepo:       revisionTestNamespaces{},
+			moduleRepo:   revisionTestModules{},
+			recordRepo:   revisionTestRecords{record: &types.Record{ID: 3, NamespaceID: 1, ModuleID: 2}},
+			revisionRepo: revisionTestRevisions{revision: &types.RecordRevision{NamespaceID: 1, ModuleID: 4, RecordID: 3, Revision: 1}},
+		}
+	)
+
+	_, err := svc.RestoreRevision(1, 3, 1)
+	req.Equal(ErrRecordRevisionModuleMismatch, errors.Cause(err))
+}
+
+func TestCheckUniqueValues(t *testing.T) {
+	var (
+		req = require.New(t)
+
+		svc = record{
+			recordRepo: uniqueRecordRepo{used: map[string]uint64{"taken": 42}},
+		}
+
+		module = &types.Module{
+			Fields: types.ModuleFieldSet{
+				&types.ModuleField{Name: "code", Options: types.ModuleFieldOptions{"unique": true}},
+				&types.ModuleField{Name: "tags", Multi: true, Options: types.ModuleFieldOptions{"unique": true, "uniqueIgnoreCase": true}},
+				&types.ModuleField{Name: "other", Multi: true},
+			},
+		}
+
+		r = &types.Record{Values: types.RecordValueSet{
+			{Name: "code", Value: "taken"},
+			{Name: "tags", Value: "a", Place: 0},
+			{Name: "tags", Value: "b", Place: 1},
+			{Name: "tags", Value: "A", Place: 2},
+			{Name: "other", Value: "x", Place: 0},
+			{Name: "other", Value: "x", Place: 1},
+		}}
+
+		rves = &types.RecordValueErrorSet{}
+	)
+
+	req.NoError(svc.checkUniqueValues(module, r, rves))
+	req.Len(rves.Set, 2)
+
+	req.Equal("code", rves.Set[0].Field)
+	req.Equal("value already used by record 42", rves.Set[0].Message)
+
+	req.Equal("tags", rves.Set[1].Field)
+	req.Equal(uint(2), rves.Set[1].Place)
+	req.Equal("value used more than once", rves.Set[1].Message)
+}
+
+func TestRecordBulkLimit(t *testing.T) {
+	var (
+		req = require.New(t)
+		oo  = make(types.RecordBulkOperationSet, recordBulkMaxOperations+1)
+	)
+
+	_, err := record{ctx: context.Background()}.Bulk(1, 2, IMPORT_ON_ERROR_SKIP, oo)
+	req.Error(err)
+}
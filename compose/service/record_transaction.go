@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/titpetric/factory"
+)
+
+type (
+	// afterCommit collects work (after-scripts...) that must wait
+	// until the outermost record transaction is committed
+	afterCommit struct {
+		fns []func()
+
+		// Set when transaction was committed;
+		// work added after that is done immediately
+		committed bool
+
+		// Number of savepoints created in the transaction (see savepoint)
+		savepoints int
+
+		// Handle of the transaction, for work that must be a part of it (see transactionDB)
+		db *factory.DB
+	}
+
+	afterCommitCtxKey struct{}
+)
+
+// withAfterCommit returns context with a new collector
+func withAfterCommit(ctx context.Context) (context.Context, *afterCommit) {
+	ac := &afterCommit{}
+	return context.WithValue(ctx, afterCommitCtxKey{}, ac), ac
+}
+
+// runAfterCommit runs fn when transaction (that collects work in the context) is committed
+//
+// Without a collector in the context, fn is run immediately
+func runAfterCommit(ctx context.Context, fn func()) {
+	if ac, ok := ctx.Value(afterCommitCtxKey{}).(*afterCommit); ok && !ac.committed {
+		ac.fns = append(ac.fns, fn)
+		return
+	}
+
+	fn()
+}
+
+// transactionDB returns handle of the record transaction that collects work in the context
+//
+// Returns nil when there is no such transaction
+func transactionDB(ctx context.Context) *factory.DB {
+	if ac, ok := ctx.Value(afterCommitCtxKey{}).(*afterCommit); ok && !ac.committed {
+		return ac.db
+	}
+
+	return nil
+}
+
+// run does collected work
+func (ac *afterCommit) run() {
+	ac.committed = true
+	for _, fn := range ac.fns {
+		fn()
+	}
+
+	ac.fns = nil
+}
+
+// transaction runs fn in a transaction
+//
+// Work added with runAfterCommit while fn is running is done only when the
+// outermost transaction commits and discarded when it is rolled back.
+//
+// Transaction that is nested in a running record transaction is a savepoint
+func (svc record) transaction(fn func(svc record) error) error {
+	if ac, ok := svc.ctx.Value(afterCommitCtxKey{}).(*afterCommit); ok && !ac.committed {
+		if svc.db.Tx != nil {
+			return svc.savepoint(func() error { return fn(svc) })
+		}
+
+		// Outer transaction does the collected work
+		return svc.db.Transaction(func() error { return fn(svc) })
+	}
+
+	var (
+		tx      = svc
+		ctx, ac = withAfterCommit(svc.ctx)
+	)
+
+	tx.ctx = ctx
+	ac.db = svc.db
+
+	err := svc.db.Transaction(func() error {
+		// Transactions can be retried, drop work from the failed attempt
+		ac.fns = nil
+		return fn(tx)
+	})
+
+	if err == nil {
+		ac.run()
+	}
+
+	return err
+}
+
+// savepoint runs fn in a savepoint of the running record transaction
+//
+// When fn fails, its changes are rolled back to the savepoint, work it added with
+// runAfterCommit is dropped and the fn's error is returned; the rest of the transaction
+// is kept. Any other error means that the transaction can not continue.
+//
+// Savepoints are not left to factory's nested transactions since those can
+// not be rolled back (ROLLBACK SAVEPOINT instead of ROLLBACK TO SAVEPOINT)
+func (svc record) savepoint(fn func() error) error {
+	ac, ok := svc.ctx.Value(afterCommitCtxKey{}).(*afterCommit)
+	if !ok || svc.db.Tx == nil {
+		return errors.New("savepoint outside of record transaction")
+	}
+
+	ac.savepoints++
+
+	var (
+		name = fmt.Sprintf("record_sp_%d", ac.savepoints)
+		fns  = len(ac.fns)
+	)
+
+	if _, err := svc.db.Exec("SAVEPOINT " + name); err != nil {
+		return err
+	}
+
+	err := fn()
+	if err != nil {
+		ac.fns = ac.fns[:fns]
+
+		if _, rerr := svc.db.Exec("ROLLBACK TO SAVEPOINT " + name); rerr != nil {
+			return errors.Wrap(rerr, "could not roll back to savepoint")
+		}
+	}
+
+	if _, rerr := svc.db.Exec("RELEASE SAVEPOINT " + name); rerr != nil {
+		return errors.Wrap(rerr, "could not release savepoint")
+	}
+
+	return err
+}
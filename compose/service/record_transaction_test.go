@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"github.com/titpetric/factory"
+	"github.com/titpetric/factory/logger"
+)
+
+type (
+	// Database driver that only logs executed statements
+	testStmtLog struct {
+		stmts []string
+	}
+
+	testStmtConn struct {
+		log *testStmtLog
+	}
+
+	testStmtTx struct {
+		log *testStmtLog
+	}
+)
+
+func (l *testStmtLog) Connect(context.Context) (driver.Conn, error) {
+	return &testStmtConn{log: l}, nil
+}
+
+func (l *testStmtLog) Driver() driver.Driver {
+	return nil
+}
+
+func (c *testStmtConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *testStmtConn) Close() error {
+	return nil
+}
+
+func (c *testStmtConn) Begin() (driver.Tx, error) {
+	c.log.stmts = append(c.log.stmts, "BEGIN")
+	return &testStmtTx{log: c.log}, nil
+}
+
+func (c *testStmtConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	c.log.stmts = append(c.log.stmts, query)
+	return driver.RowsAffected(0), nil
+}
+
+func (tx *testStmtTx) Commit() error {
+	tx.log.stmts = append(tx.log.stmts, "COMMIT")
+	return nil
+}
+
+func (tx *testStmtTx) Rollback() error {
+	tx.log.stmts = append(tx.log.stmts, "ROLLBACK")
+	return nil
+}
+
+// testStmtRecordService returns record service with db handle that logs executed statements
+func testStmtRecordService() (record, *testStmtLog) {
+	var (
+		log = &testStmtLog{}
+		db  = (&factory.DB{DB: sqlx.NewDb(sql.OpenDB(log), "mysql"), TxOpts: &sql.TxOptions{}}).With(context.Background())
+	)
+
+	db.SetLogger(logger.Silent{})
+	return record{db: db, ctx: context.Background()}, log
+}
+
+func TestRunAfterCommit(t *testing.T) {
+	var (
+		req = require.New(t)
+		ran []string
+	)
+
+	// No collector, run immediately
+	runAfterCommit(context.Background(), func() { ran = append(ran, "immediate") })
+	req.Equal([]string{"immediate"}, ran)
+
+	ctx, ac := withAfterCommit(context.Background())
+
+	runAfterCommit(ctx, func() {
+		ran = append(ran, "first")
+
+		// Work added while collected work is done runs immediately
+		runAfterCommit(ctx, func() { ran = append(ran, "nested") })
+	})
+	runAfterCommit(ctx, func() { ran = append(ran, "second") })
+	req.Len(ran, 1, "collected work should wait for commit")
+
+	ac.run()
+	req.Equal([]string{"immediate", "first", "nested", "second"}, ran)
+
+	runAfterCommit(ctx, func() { ran = append(ran, "committed") })
+	req.Equal("committed", ran[len(ran)-1])
+}
+
+func TestRecordTransactionSavepoint(t *testing.T) {
+	var (
+		req = require.New(t)
+		ran []string
+
+		svc, log = testStmtRecordService()
+		failed   = errors.New("failed")
+	)
+
+	err := svc.transaction(func(svc record) error {
+		err := svc.transaction(func(svc record) error {
+			runAfterCommit(svc.ctx, func() { ran = append(ran, "failed") })
+			return failed
+		})
+		req.Equal(failed, err)
+
+		return svc.transaction(func(svc record) error {
+			runAfterCommit(svc.ctx, func() { ran = append(ran, "stored") })
+			return nil
+		})
+	})
+
+	req.NoError(err)
+	req.Equal([]string{
+		"BEGIN",
+		"SAVEPOINT record_sp_1",
+		"ROLLBACK TO SAVEPOINT record_sp_1",
+		"RELEASE SAVEPOINT record_sp_1",
+		"SAVEPOINT record_sp_2",
+		"RELEASE SAVEPOINT record_sp_2",
+		"COMMIT",
+	}, log.stmts)
+	req.Equal([]string{"stored"}, ran, "work of the rolled back savepoint should be dropped")
+
+	req.Error(svc.savepoint(func() error { return nil }), "savepoint should not be created outside of transaction")
+}
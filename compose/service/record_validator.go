@@ -0,0 +1,210 @@
+package service
+
+import (
+	"math"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+)
+
+type (
+	// recordValueValidator checks a single (non-empty) value of a field
+	//
+	// Returns nil when value is valid; Field & Place on returned error
+	// are filled in by the caller
+	recordValueValidator func(f *types.ModuleField, v *types.RecordValue) *types.RecordValueError
+)
+
+var (
+	// Registry of value validators, keyed by module field kind
+	//
+	// Kinds without validator (Record, User, File...) are handled by
+	// the value sanitizer that checks the reference format
+	recordValueValidators = map[string]recordValueValidator{
+		"Bool":     validateBoolValue,
+		"DateTime": validateDateTimeValue,
+		"Email":    validateEmailValue,
+		"Number":   validateNumberValue,
+		"Select":   validateSelectValue,
+		"String":   validateStringValue,
+		"Url":      validateUrlValue,
+	}
+
+	// Accepted formats of date-time values
+	recordValueDateTimeLayouts = []string{
+		time.RFC3339,
+		"2006-01-02T15:04:05",
+		"2006-01-02 15:04:05",
+		"2006-01-02T15:04",
+		"2006-01-02 15:04",
+	}
+
+	recordValueDateLayouts = []string{"2006-01-02"}
+	recordValueTimeLayouts = []string{"15:04:05", "15:04"}
+)
+
+// validateRecordValues runs required check and kind specific validators on all fields
+//
+// Fields that are not updatable (see canUpdate) are not checked for required-ness;
+// their values are removed by sanitizer before they get here
+func validateRecordValues(m *types.Module, values types.RecordValueSet, canUpdate func(*types.ModuleField) bool) error {
+	var (
+		rves = &types.RecordValueErrorSet{}
+	)
+
+	_ = m.Fields.Walk(func(f *types.ModuleField) error {
+		var (
+			vv    = values.FilterByName(f.Name)
+			empty = true
+		)
+
+		for _, v := range vv {
+			if strings.TrimSpace(v.Value) == "" {
+				continue
+			}
+
+			empty = false
+
+			if fn, ok := recordValueValidators[f.Kind]; ok {
+				if e := fn(f, v); e != nil {
+					e.Field, e.Place = f.Name, v.Place
+					rves.Push(*e)
+				}
+			}
+		}
+
+		if empty && f.Required && (canUpdate == nil || canUpdate(f)) {
+			rves.Push(types.RecordValueError{
+				Field:   f.Name,
+				Kind:    "required",
+				Message: "value is required",
+			})
+		}
+
+		return nil
+	})
+
+	if rves.IsValid() {
+		return nil
+	}
+
+	return rves
+}
+
+func validateBoolValue(f *types.ModuleField, v *types.RecordValue) *types.RecordValueError {
+	if _, err := strconv.ParseBool(v.Value); err != nil {
+		return &types.RecordValueError{Kind: "invalidBool", Message: "expecting boolean value"}
+	}
+
+	return nil
+}
+
+func validateDateTimeValue(f *types.ModuleField, v *types.RecordValue) *types.RecordValueError {
+	var layouts = recordValueDateTimeLayouts
+
+	switch {
+	case f.Options.Bool("onlyDate"):
+		layouts = recordValueDateLayouts
+	case f.Options.Bool("onlyTime"):
+		layouts = recordValueTimeLayouts
+	}
+
+	for _, l := range layouts {
+		if _, err := time.Parse(l, v.Value); err == nil {
+			return nil
+		}
+	}
+
+	return &types.RecordValueError{Kind: "invalidDateTime", Message: "invalid date/time format"}
+}
+
+func validateEmailValue(f *types.ModuleField, v *types.RecordValue) *types.RecordValueError {
+	if addr, err := mail.ParseAddress(v.Value); err != nil || addr.Address != v.Value {
+		return &types.RecordValueError{Kind: "invalidEmail", Message: "invalid email address"}
+	}
+
+	return nil
+}
+
+func validateNumberValue(f *types.ModuleField, v *types.RecordValue) *types.RecordValueError {
+	n, err := strconv.ParseFloat(v.Value, 64)
+	if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+		return &types.RecordValueError{Kind: "invalidNumber", Message: "expecting numeric value"}
+	}
+
+	if min, ok := f.Options.Float64("min"); ok && n < min {
+		return &types.RecordValueError{
+			Kind:    "min",
+			Message: "value is lower than allowed minimum",
+			Meta:    map[string]interface{}{"min": min},
+		}
+	}
+
+	if max, ok := f.Options.Float64("max"); ok && n > max {
+		return &types.RecordValueError{
+			Kind:    "max",
+			Message: "value is higher than allowed maximum",
+			Meta:    map[string]interface{}{"max": max},
+		}
+	}
+
+	if precision, ok := f.Options.Int64("precision"); ok && precision >= 0 {
+		// Trailing zeros are not significant (1.50 is fine with precision 1)
+		if p := strings.IndexByte(v.Value, '.'); p > -1 && int64(len(strings.TrimRight(v.Value[p+1:], "0"))) > precision {
+			return &types.RecordValueError{
+				Kind:    "precision",
+				Message: "too many decimal places",
+				Meta:    map[string]interface{}{"precision": precision},
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateSelectValue(f *types.ModuleField, v *types.RecordValue) *types.RecordValueError {
+	var options = f.Options.Strings("options")
+
+	if len(options) == 0 {
+		// No options configured, nothing to check against
+		return nil
+	}
+
+	for _, o := range options {
+		if o == v.Value {
+			return nil
+		}
+	}
+
+	return &types.RecordValueError{Kind: "invalidOption", Message: "value is not one of the allowed options"}
+}
+
+func validateStringValue(f *types.ModuleField, v *types.RecordValue) *types.RecordValueError {
+	if max, ok := f.Options.Int64("maxLength"); ok && max > 0 && int64(utf8.RuneCountInString(v.Value)) > max {
+		return &types.RecordValueError{
+			Kind:    "maxLength",
+			Message: "value is too long",
+			Meta:    map[string]interface{}{"maxLength": max},
+		}
+	}
+
+	return nil
+}
+
+func validateUrlValue(f *types.ModuleField, v *types.RecordValue) *types.RecordValueError {
+	u, err := url.ParseRequestURI(v.Value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return &types.RecordValueError{Kind: "invalidUrl", Message: "invalid URL"}
+	}
+
+	if f.Options.Bool("onlySecure") && u.Scheme != "https" {
+		return &types.RecordValueError{Kind: "insecureUrl", Message: "only secure (https) URLs are allowed"}
+	}
+
+	return nil
+}
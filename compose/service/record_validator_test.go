@@ -0,0 +1,89 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cortezaproject/corteza-server/compose/types"
+)
+
+func TestRecordValueValidator(t *testing.T) {
+	var (
+		module = &types.Module{
+			Fields: types.ModuleFieldSet{
+				&types.ModuleField{Name: "num", Kind: "Number", Options: types.ModuleFieldOptions{"min": 1.0, "max": "10", "precision": 1.0}},
+				&types.ModuleField{Name: "email", Kind: "Email"},
+				&types.ModuleField{Name: "url", Kind: "Url", Options: types.ModuleFieldOptions{"onlySecure": true}},
+				&types.ModuleField{Name: "dt", Kind: "DateTime"},
+				&types.ModuleField{Name: "date", Kind: "DateTime", Options: types.ModuleFieldOptions{"onlyDate": true}},
+				&types.ModuleField{Name: "bool", Kind: "Bool"},
+				&types.ModuleField{Name: "sel", Kind: "Select", Options: types.ModuleFieldOptions{"options": []interface{}{"a", map[string]interface{}{"value": "b", "text": "B"}}}},
+				&types.ModuleField{Name: "str", Kind: "String", Options: types.ModuleFieldOptions{"maxLength": 3.0}},
+				&types.ModuleField{Name: "req", Kind: "String", Required: true},
+			},
+		}
+
+		tests = []struct {
+			name  string
+			value *types.RecordValue
+			kind  string
+		}{
+			{"valid number", &types.RecordValue{Name: "num", Value: "5.50"}, ""},
+			{"invalid number", &types.RecordValue{Name: "num", Value: "abc"}, "invalidNumber"},
+			{"number below min", &types.RecordValue{Name: "num", Value: "0.5"}, "min"},
+			{"number above max", &types.RecordValue{Name: "num", Value: "11"}, "max"},
+			{"number precision", &types.RecordValue{Name: "num", Value: "2.25"}, "precision"},
+			{"valid email", &types.RecordValue{Name: "email", Value: "foo@example.tld"}, ""},
+			{"invalid email", &types.RecordValue{Name: "email", Value: "Foo <foo@example.tld>"}, "invalidEmail"},
+			{"valid url", &types.RecordValue{Name: "url", Value: "https://example.tld/foo"}, ""},
+			{"invalid url", &types.RecordValue{Name: "url", Value: "example"}, "invalidUrl"},
+			{"insecure url", &types.RecordValue{Name: "url", Value: "http://example.tld"}, "insecureUrl"},
+			{"valid datetime", &types.RecordValue{Name: "dt", Value: "2019-10-01T12:00:00Z"}, ""},
+			{"invalid datetime", &types.RecordValue{Name: "dt", Value: "yesterday"}, "invalidDateTime"},
+			{"valid date", &types.RecordValue{Name: "date", Value: "2019-10-01"}, ""},
+			{"datetime on date", &types.RecordValue{Name: "date", Value: "2019-10-01T12:00:00Z"}, "invalidDateTime"},
+			{"valid bool", &types.RecordValue{Name: "bool", Value: "1"}, ""},
+			{"invalid bool", &types.RecordValue{Name: "bool", Value: "yes"}, "invalidBool"},
+			{"valid option", &types.RecordValue{Name: "sel", Value: "b"}, ""},
+			{"invalid option", &types.RecordValue{Name: "sel", Value: "c"}, "invalidOption"},
+			{"valid string", &types.RecordValue{Name: "str", Value: "abc"}, ""},
+			{"string too long", &types.RecordValue{Name: "str", Value: "abcd"}, "maxLength"},
+		}
+	)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				req  = require.New(t)
+				vv   = types.RecordValueSet{tt.value, {Name: "req", Value: "r"}}
+				err  = validateRecordValues(module, vv, nil)
+				rves *types.RecordValueErrorSet
+			)
+
+			if tt.kind == "" {
+				req.NoError(err)
+				return
+			}
+
+			req.IsType(rves, err)
+			rves = err.(*types.RecordValueErrorSet)
+			req.Len(rves.Set, 1)
+			req.Equal(tt.kind, rves.Set[0].Kind)
+			req.Equal(tt.value.Name, rves.Set[0].Field)
+		})
+	}
+
+	t.Run("required", func(t *testing.T) {
+		var (
+			req = require.New(t)
+			err = validateRecordValues(module, types.RecordValueSet{{Name: "req", Value: " "}}, nil)
+		)
+
+		req.Error(err)
+		req.True(err.(*types.RecordValueErrorSet).HasField("req"))
+
+		// not checked when user can not update the field
+		req.NoError(validateRecordValues(module, nil, func(*types.ModuleField) bool { return false }))
+	})
+}
@@ -36,6 +36,9 @@ type (
 		Storage          options.StorageOpt
 		Corredor         options.CorredorOpt
 		GRPCClientSystem options.GRPCServerOpt
+
+		// How long are files of record export jobs kept
+		RecordExportLifetime time.Duration
 	}
 )
 
@@ -69,6 +72,7 @@ var (
 
 	DefaultNamespace     NamespaceService
 	DefaultImportSession ImportSessionService
+	DefaultExportJob     ExportJobService
 	DefaultRecord        RecordService
 	DefaultModule        ModuleService
 	DefaultChart         ChartService
@@ -154,9 +158,14 @@ func Init(ctx context.Context, log *zap.Logger, c Config) (err error) {
 		if DefaultInternalAutomationManager == nil {
 			// handles script & trigger management & keeping runnable scripts in internal cache
 			DefaultInternalAutomationManager = automation.Service(automation.AutomationServiceConfig{
-				Logger:        DefaultLogger,
-				DbTablePrefix: "compose",
-				DB:            db,
+				Logger:            DefaultLogger,
+				DbTablePrefix:     "compose",
+				RunLogRetention:   c.Corredor.RunLogRetention,
+				RunLogOutputLimit: c.Corredor.RunLogOutputLimit,
+				QueueInterval:     c.Corredor.QueueInterval,
+				RetryMaxAttempts:  uint(c.Corredor.RetryMaxAttempts),
+				RetryBackoff:      c.Corredor.RetryBackoff,
+				DB:                db,
 				TokenMaker: func(ctx context.Context, userID uint64) (s string, e error) {
 					ctx = auth.SetSuperUserContext(ctx)
 					return DefaultSystemUser.MakeJWT(ctx, userID)
@@ -186,14 +195,16 @@ func Init(ctx context.Context, log *zap.Logger, c Config) (err error) {
 				ApiBaseURLSystem:    c.Corredor.ApiBaseURLSystem,
 				ApiBaseURLMessaging: c.Corredor.ApiBaseURLMessaging,
 				ApiBaseURLCompose:   c.Corredor.ApiBaseURLCompose,
+				Timeout:             c.Corredor.DefaultTimeout,
 			},
 			DefaultInternalAutomationManager,
 			scriptRunnerClient,
 		)
 	}
 
-	DefaultImportSession = ImportSession()
 	DefaultRecord = Record()
+	DefaultImportSession = ImportSession(DefaultStore, DefaultRecord)
+	DefaultExportJob = ExportJob(DefaultStore, DefaultRecord, c.RecordExportLifetime)
 	DefaultPage = Page()
 	DefaultChart = Chart()
 	DefaultNotification = Notification()
@@ -208,6 +219,12 @@ func Watchers(ctx context.Context) {
 
 	// Reloading permissions on change
 	DefaultPermissions.Watch(ctx)
+
+	// Running queued record imports
+	DefaultImportSession.Watch(ctx)
+
+	// Running queued record exports
+	DefaultExportJob.Watch(ctx)
 }
 
 // Data is stale when new date does not match updatedAt or createdAt (before first update)
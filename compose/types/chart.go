@@ -3,11 +3,14 @@ package types
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/cortezaproject/corteza-server/pkg/permissions"
+	"github.com/cortezaproject/corteza-server/pkg/ql"
 	"github.com/cortezaproject/corteza-server/pkg/rh"
 )
 
@@ -88,3 +91,54 @@ func (cc *ChartConfig) Scan(value interface{}) error {
 func (cc ChartConfig) Value() (driver.Value, error) {
 	return json.Marshal(cc)
 }
+
+// RenameField renames module field in filters, metrics and dimensions of all reports on the module
+//
+// Metrics on other modules (with their own moduleID) are renamed
+// together with their filters
+//
+// Returns true if anything was changed
+func (cc *ChartConfig) RenameField(moduleID uint64, from, to string) (changed bool) {
+	for _, r := range cc.Reports {
+		for _, m := range r.Metrics {
+			if r.metricModuleID(m) != moduleID {
+				continue
+			}
+
+			if m["field"] == from {
+				m["field"], changed = to, true
+			}
+
+			if filter, ok := m["filter"].(string); ok && r.metricModuleID(m) != r.ModuleID {
+				if f := ql.RenameIdent(filter, from, to); f != filter {
+					m["filter"], changed = f, true
+				}
+			}
+		}
+
+		if r.ModuleID != moduleID {
+			continue
+		}
+
+		if f := ql.RenameIdent(r.Filter, from, to); f != r.Filter {
+			r.Filter, changed = f, true
+		}
+
+		for _, d := range r.Dimensions {
+			if d["field"] == from {
+				d["field"], changed = to, true
+			}
+		}
+	}
+
+	return
+}
+
+// metricModuleID returns ID of the module that metric aggregates; report's module by default
+func (r ChartConfigReport) metricModuleID(m map[string]interface{}) uint64 {
+	if ID, _ := strconv.ParseUint(fmt.Sprintf("%v", m["moduleID"]), 10, 64); ID > 0 {
+		return ID
+	}
+
+	return r.ModuleID
+}
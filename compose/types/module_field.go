@@ -3,7 +3,10 @@ package types
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -36,6 +39,74 @@ type (
 	}
 
 	ModuleFieldOptions map[string]interface{}
+
+	// ModuleFieldUniqueConstraint describes how values of a field must be unique within a module
+	//
+	// Configured through field options:
+	//  - unique: true when constraint is enabled
+	//  - uniqueIgnoreCase: compare values case-insensitive
+	//  - uniqueScope: name of the field that scopes uniqueness (unique per value of that field)
+	ModuleFieldUniqueConstraint struct {
+		IgnoreCase bool
+		Scope      string
+	}
+
+	// ModuleFieldRollup describes how values of records in another module are aggregated
+	//
+	// Configured through options of the Rollup field:
+	//  - moduleID: module with records that are aggregated
+	//  - field: Record field on that module that references records of this module
+	//  - aggregate: COUNT, SUM, MIN, MAX or AVG
+	//  - valueField: field with aggregated values (not used with COUNT)
+	//  - filter: optional filter (ql) for aggregated records
+	//
+	// Rollups are maintained by the system: all matching records are aggregated, including
+	// the ones that the user who changed them (or who reads the rollup) can not read. Access
+	// to aggregated values is controlled only by the read permission on the rollup field
+	ModuleFieldRollup struct {
+		ModuleID   uint64
+		Field      string
+		Aggregate  string
+		ValueField string
+		Filter     string
+	}
+
+	// ModuleFieldMigration describes how values of an existing field are migrated
+	// when field is renamed or its kind is changed
+	ModuleFieldMigration struct {
+		FieldID uint64 `json:"fieldID,string"`
+
+		OldName string `json:"oldName"`
+		Name    string `json:"name"`
+		OldKind string `json:"oldKind"`
+		Kind    string `json:"kind"`
+
+		// Number of stored values
+		Values uint `json:"values"`
+
+		// Values that can not be converted to the new kind
+		Failed []*ModuleFieldMigrationFailure `json:"failed,omitempty"`
+
+		// Values converted to the new kind
+		Converted RecordValueSet `json:"-"`
+	}
+
+	ModuleFieldMigrationFailure struct {
+		RecordID uint64 `json:"recordID,string"`
+		Place    uint   `json:"place"`
+		Value    string `json:"value"`
+
+		Kind    string `json:"kind"`
+		Message string `json:"message"`
+	}
+)
+
+const (
+	// What happens to Record field values when referenced record is deleted (option "onDelete")
+	RecordRefOnDeleteNone     = ""
+	RecordRefOnDeleteRestrict = "restrict"
+	RecordRefOnDeleteSetNull  = "setNull"
+	RecordRefOnDeleteCascade  = "cascade"
 )
 
 var (
@@ -61,6 +132,94 @@ func (mfo ModuleFieldOptions) Value() (driver.Value, error) {
 	return json.Marshal(mfo)
 }
 
+// String returns option value as string or an empty string when option is not set
+func (mfo ModuleFieldOptions) String(key string) string {
+	switch v := mfo[key].(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// Bool returns option value as boolean
+//
+// Strings are parsed with strconv.ParseBool and numbers are true when not zero
+func (mfo ModuleFieldOptions) Bool(key string) bool {
+	switch v := mfo[key].(type) {
+	case bool:
+		return v
+	case string:
+		b, _ := strconv.ParseBool(v)
+		return b
+	case float64:
+		return v != 0
+	case int:
+		return v != 0
+	}
+
+	return false
+}
+
+// Float64 returns option value as float64
+//
+// Second return value is false when option is not set or when it can not be converted
+func (mfo ModuleFieldOptions) Float64(key string) (float64, bool) {
+	switch v := mfo[key].(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case string:
+		if f, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+			return f, true
+		}
+	}
+
+	return 0, false
+}
+
+// Int64 returns option value as int64, see Float64
+func (mfo ModuleFieldOptions) Int64(key string) (int64, bool) {
+	f, ok := mfo.Float64(key)
+	return int64(f), ok
+}
+
+// Strings returns option value as slice of strings
+//
+// Supports list of strings and list of objects with "value" key
+// (select options are stored this way)
+func (mfo ModuleFieldOptions) Strings(key string) (ss []string) {
+	switch v := mfo[key].(type) {
+	case []string:
+		return v
+	case []interface{}:
+		for _, i := range v {
+			switch o := i.(type) {
+			case string:
+				ss = append(ss, o)
+			case map[string]interface{}:
+				ss = append(ss, fmt.Sprintf("%v", o["value"]))
+			default:
+				ss = append(ss, fmt.Sprintf("%v", o))
+			}
+		}
+	}
+
+	return
+}
+
+// IsRenamed returns true when migration changes field's name
+func (m ModuleFieldMigration) IsRenamed() bool {
+	return m.OldName != m.Name
+}
+
 // Resource returns a system resource ID for this type
 func (m ModuleField) PermissionResource() permissions.Resource {
 	return ModuleFieldPermissionResource.AppendID(m.ID)
@@ -134,10 +293,110 @@ func (f ModuleField) IsRef() bool {
 	return f.Kind == "Record" || f.Kind == "Owner" || f.Kind == "File"
 }
 
+// RefModuleID returns ID of the module that Record field is referencing
+//
+// Returns 0 for other kinds or when option is not set
+func (f ModuleField) RefModuleID() uint64 {
+	if f.Kind != "Record" {
+		return 0
+	}
+
+	id, _ := strconv.ParseUint(f.Options.String("moduleID"), 10, 64)
+	return id
+}
+
+// RefOnDelete returns what happens to values of the Record field when referenced record is deleted
+//
+// Values are kept by default (RecordRefOnDeleteNone); deletion can be restricted,
+// values can be removed (set null) or referencing records can be deleted as well (cascade)
+func (f ModuleField) RefOnDelete() string {
+	if f.Kind != "Record" {
+		return RecordRefOnDeleteNone
+	}
+
+	return f.Options.String("onDelete")
+}
+
 func (f ModuleField) IsNumeric() bool {
-	return f.Kind == "Number"
+	return f.ValueKind() == "Number"
 }
 
 func (f ModuleField) IsDateTime() bool {
-	return f.Kind == "DateTime"
+	return f.ValueKind() == "DateTime"
+}
+
+// IsComputed returns true for fields with values calculated from other fields of the record
+//
+// Expression is set with "expression" option, see ComputedExpression
+func (f ModuleField) IsComputed() bool {
+	return f.Kind == "Computed"
+}
+
+// ComputedExpression returns expression of the computed field
+func (f ModuleField) ComputedExpression() string {
+	return f.Options.String("expression")
+}
+
+// IsRollup returns true for fields with values aggregated from records that reference this record
+//
+// See Rollup for options
+func (f ModuleField) IsRollup() bool {
+	return f.Kind == "Rollup"
+}
+
+// Rollup returns rollup configuration of the field or nil when field is not a rollup
+func (f ModuleField) Rollup() *ModuleFieldRollup {
+	if !f.IsRollup() {
+		return nil
+	}
+
+	id, _ := strconv.ParseUint(f.Options.String("moduleID"), 10, 64)
+
+	return &ModuleFieldRollup{
+		ModuleID:   id,
+		Field:      f.Options.String("field"),
+		Aggregate:  strings.ToUpper(strings.TrimSpace(f.Options.String("aggregate"))),
+		ValueField: f.Options.String("valueField"),
+		Filter:     f.Options.String("filter"),
+	}
+}
+
+// ValueKind returns kind of values that are stored for this field
+//
+// For computed fields that is the kind set with "resultKind" option (String by default),
+// rollups always store numbers
+func (f ModuleField) ValueKind() string {
+	if f.IsRollup() {
+		return "Number"
+	}
+
+	if !f.IsComputed() {
+		return f.Kind
+	}
+
+	if k := f.Options.String("resultKind"); k != "" {
+		return k
+	}
+
+	return "String"
+}
+
+// UniqueConstraint returns unique constraint for the field or nil when values do not need to be unique
+func (f ModuleField) UniqueConstraint() *ModuleFieldUniqueConstraint {
+	if !f.Options.Bool("unique") {
+		return nil
+	}
+
+	return &ModuleFieldUniqueConstraint{
+		IgnoreCase: f.Options.Bool("uniqueIgnoreCase"),
+		Scope:      f.Options.String("uniqueScope"),
+	}
+}
+
+// IsIndexed returns true when values of the field are materialized into
+// a typed & indexed column of the module's record index table
+//
+// Only single-value fields can be indexed (option "indexed")
+func (f ModuleField) IsIndexed() bool {
+	return f.Options.Bool("indexed") && !f.Multi
 }
@@ -3,11 +3,13 @@ package types
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/cortezaproject/corteza-server/pkg/permissions"
+	"github.com/cortezaproject/corteza-server/pkg/ql"
 	"github.com/cortezaproject/corteza-server/pkg/rh"
 )
 
@@ -74,6 +76,53 @@ func (p Page) PermissionResource() permissions.Resource {
 	return PagePermissionResource.AppendID(p.ID)
 }
 
+// RenameField renames module field in options of all page blocks that use the module
+//
+// Blocks use the module set in their moduleID option or, on record pages, the page's module.
+// Field lists (fields option) and filter/sort expressions are updated
+//
+// Returns true if anything was changed
+func (p *Page) RenameField(moduleID uint64, from, to string) (changed bool) {
+	for _, b := range p.Blocks {
+		if b.Options == nil {
+			continue
+		}
+
+		if id, ok := b.Options["moduleID"]; ok {
+			if fmt.Sprintf("%v", id) != fmt.Sprintf("%d", moduleID) {
+				continue
+			}
+		} else if p.ModuleID != moduleID {
+			continue
+		}
+
+		for _, key := range []string{"filter", "prefilter", "sort", "presort"} {
+			if expr, ok := b.Options[key].(string); ok {
+				if renamed := ql.RenameIdent(expr, from, to); renamed != expr {
+					b.Options[key], changed = renamed, true
+				}
+			}
+		}
+
+		if ff, ok := b.Options["fields"].([]interface{}); ok {
+			for i, f := range ff {
+				switch f := f.(type) {
+				case string:
+					if f == from {
+						ff[i], changed = to, true
+					}
+				case map[string]interface{}:
+					if f["name"] == from {
+						f["name"], changed = to, true
+					}
+				}
+			}
+		}
+	}
+
+	return
+}
+
 // FindByHandle finds page by it's handle
 func (set PageSet) FindByHandle(handle string) *Page {
 	for i := range set {
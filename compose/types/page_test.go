@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageRenameField(t *testing.T) {
+	var (
+		req = require.New(t)
+		p   = &Page{
+			ModuleID: 2,
+			Blocks: PageBlocks{
+				{Kind: "RecordList", Options: map[string]interface{}{
+					"moduleID":  "1",
+					"fields":    []interface{}{map[string]interface{}{"name": "foo"}, "bar"},
+					"prefilter": "foo = 'foo' AND bar = 1",
+					"presort":   "foo DESC",
+				}},
+				{Kind: "RecordList", Options: map[string]interface{}{
+					"moduleID": "3",
+					"fields":   []interface{}{"foo"},
+				}},
+				{Kind: "Record", Options: map[string]interface{}{
+					"fields": []interface{}{"foo"},
+				}},
+			},
+		}
+	)
+
+	req.True(p.RenameField(1, "foo", "qux"))
+	req.Equal([]interface{}{map[string]interface{}{"name": "qux"}, "bar"}, p.Blocks[0].Options["fields"])
+	req.Equal("qux = 'foo' AND bar = 1", p.Blocks[0].Options["prefilter"])
+	req.Equal("qux DESC", p.Blocks[0].Options["presort"])
+	req.Equal([]interface{}{"foo"}, p.Blocks[1].Options["fields"])
+	req.Equal([]interface{}{"foo"}, p.Blocks[2].Options["fields"])
+
+	req.True(p.RenameField(2, "foo", "qux"))
+	req.Equal([]interface{}{"qux"}, p.Blocks[2].Options["fields"])
+
+	req.False(p.RenameField(4, "foo", "qux"))
+}
+
+func TestChartConfigRenameField(t *testing.T) {
+	var (
+		req = require.New(t)
+		cc  = &ChartConfig{Reports: []*ChartConfigReport{
+			{
+				ModuleID:   1,
+				Filter:     "foo > 0",
+				Metrics:    []map[string]interface{}{{"field": "foo", "aggregate": "SUM"}},
+				Dimensions: []map[string]interface{}{{"field": "bar"}},
+			},
+			{ModuleID: 2, Filter: "foo > 0"},
+		}}
+	)
+
+	req.True(cc.RenameField(1, "foo", "qux"))
+	req.Equal("qux > 0", cc.Reports[0].Filter)
+	req.Equal("qux", cc.Reports[0].Metrics[0]["field"])
+	req.Equal("bar", cc.Reports[0].Dimensions[0]["field"])
+	req.Equal("foo > 0", cc.Reports[1].Filter)
+	req.False(cc.RenameField(1, "foo", "qux"))
+
+	// Metric on other module
+	cc.Reports[1].Metrics = []map[string]interface{}{{"field": "foo", "moduleID": "1", "filter": "foo > 1"}}
+	req.True(cc.RenameField(1, "foo", "qux"))
+	req.Equal("qux", cc.Reports[1].Metrics[0]["field"])
+	req.Equal("qux > 1", cc.Reports[1].Metrics[0]["filter"])
+	req.Equal("foo > 0", cc.Reports[1].Filter)
+}
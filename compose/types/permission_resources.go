@@ -8,6 +8,7 @@ const ComposePermissionResource = permissions.Resource("compose")
 const NamespacePermissionResource = permissions.Resource("compose:namespace:")
 const ChartPermissionResource = permissions.Resource("compose:chart:")
 const ModulePermissionResource = permissions.Resource("compose:module:")
+const RecordPermissionResource = permissions.Resource("compose:record:")
 const ModuleFieldPermissionResource = permissions.Resource("compose:module-field:")
 const PagePermissionResource = permissions.Resource("compose:page:")
 const AutomationScriptPermissionResource = permissions.Resource("compose:automation-script:")
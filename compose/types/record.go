@@ -32,9 +32,31 @@ type (
 		Filter      string `json:"query"`
 		Sort        string `json:"sort"`
 
+		// Exclude (default), include or return only deleted records
+		Deleted rh.FilterState `json:"deleted"`
+
 		// Standard paging fields & helpers
 		rh.PageFilter
+
+		// Record-level permission check filter
+		IsReadable *permissions.ResourceFilter `json:"-"`
+
+		// Record-level permission check filters of modules referenced in filter
+		// and sort paths (<ref>.<field>), by name of the reference field
+		IsRefReadable map[string]*permissions.ResourceFilter `json:"-"`
+
+		// Values of users in filter and sort paths (ownedBy.email), by path and user ID
+		UserValues map[string]map[uint64]string `json:"-"`
 	}
+
+	// RecordReference is a record that references another record with one of its (Record) fields
+	RecordReference struct {
+		ModuleID uint64 `json:"moduleID,string"`
+		RecordID uint64 `json:"recordID,string"`
+		Field    string `json:"field"`
+	}
+
+	RecordReferenceSet []*RecordReference
 )
 
 // UserIDs returns a slice of user IDs from all items in the set
@@ -57,5 +79,5 @@ loop:
 
 // Resource returns a system resource ID for this type
 func (r Record) PermissionResource() permissions.Resource {
-	return ModulePermissionResource.AppendID(r.ModuleID)
+	return RecordPermissionResource.AppendID(r.ID)
 }
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"time"
+)
+
+type (
+	// RecordBulkOperation is one item of a bulk record request
+	//
+	// Create uses Values, update uses RecordID, Values (and optional UpdatedAt
+	// for stale data check), upsert uses Keys and Values, delete uses only RecordID
+	RecordBulkOperation struct {
+		Operation string         `json:"operation"`
+		RecordID  uint64         `json:"recordID,string,omitempty"`
+		Keys      []string       `json:"keys,omitempty"`
+		Values    RecordValueSet `json:"values,omitempty"`
+		UpdatedAt *time.Time     `json:"updatedAt,omitempty"`
+	}
+
+	RecordBulkOperationSet []*RecordBulkOperation
+
+	// RecordBulkResult holds outcome of one bulk operation
+	RecordBulkResult struct {
+		// Position of the operation in the request
+		Index     int    `json:"index"`
+		Operation string `json:"operation"`
+		RecordID  uint64 `json:"recordID,string,omitempty"`
+
+		Error       string             `json:"error,omitempty"`
+		ValueErrors []RecordValueError `json:"valueErrors,omitempty"`
+
+		// Set when operation succeeded but was rolled back because another one failed
+		RolledBack bool `json:"rolledBack,omitempty"`
+	}
+
+	RecordBulkResultSet []*RecordBulkResult
+)
+
+const (
+	RecordBulkCreate = "create"
+	RecordBulkUpdate = "update"
+	RecordBulkUpsert = "upsert"
+	RecordBulkDelete = "delete"
+)
+
+// SetError stores error (and value errors when available) to the result
+func (r *RecordBulkResult) SetError(err error) {
+	r.Error = err.Error()
+
+	if rves, ok := err.(*RecordValueErrorSet); ok {
+		r.ValueErrors = rves.Set
+	}
+}
+
+// Failed returns true when at least one of the operations failed
+func (set RecordBulkResultSet) Failed() bool {
+	for i := range set {
+		if set[i].Error != "" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,67 @@
+package types
+
+// 	Hello! This file is auto-generated.
+
+type (
+
+	// RecordExportJobSet slice of RecordExportJob
+	//
+	// This type is auto-generated.
+	RecordExportJobSet []*RecordExportJob
+)
+
+// Walk iterates through every slice item and calls w(RecordExportJob) err
+//
+// This function is auto-generated.
+func (set RecordExportJobSet) Walk(w func(*RecordExportJob) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(RecordExportJob) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set RecordExportJobSet) Filter(f func(*RecordExportJob) (bool, error)) (out RecordExportJobSet, err error) {
+	var ok bool
+	out = RecordExportJobSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
+// FindByID finds items from slice by its ID property
+//
+// This function is auto-generated.
+func (set RecordExportJobSet) FindByID(ID uint64) *RecordExportJob {
+	for i := range set {
+		if set[i].ID == ID {
+			return set[i]
+		}
+	}
+
+	return nil
+}
+
+// IDs returns a slice of uint64s from all items in the set
+//
+// This function is auto-generated.
+func (set RecordExportJobSet) IDs() (IDs []uint64) {
+	IDs = make([]uint64, len(set))
+
+	for i := range set {
+		IDs[i] = set[i].ID
+	}
+
+	return
+}
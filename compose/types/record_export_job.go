@@ -0,0 +1,85 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// RecordExportJob is a stored row in the `record_export_job` table
+	//
+	// Job is queued when created; exported file is kept in the store until the job expires
+	RecordExportJob struct {
+		ID          uint64 `db:"id"            json:"jobID,string"`
+		NamespaceID uint64 `db:"rel_namespace" json:"namespaceID,string"`
+		ModuleID    uint64 `db:"rel_module"    json:"moduleID,string"`
+		UserID      uint64 `db:"rel_owner"     json:"userID,string"`
+
+		// Name (without extension), format (csv, json, xlsx) and location of the exported file in the store
+		Filename string `db:"filename" json:"filename"`
+		Format   string `db:"format"   json:"format"`
+		Url      string `db:"url"      json:"-"`
+
+		Filter  string             `db:"filter"  json:"filter,omitempty"`
+		Sort    string             `db:"sort"    json:"sort,omitempty"`
+		Deleted uint               `db:"deleted" json:"deleted,omitempty"`
+		Fields  RecordExportFields `db:"fields"  json:"fields"`
+
+		// Encoding of multi-value fields and references, see encoder.Options
+		MultiValue          string `db:"multi_value"           json:"multiValue,omitempty"`
+		MultiValueDelimiter string `db:"multi_value_delimiter" json:"multiValueDelimiter,omitempty"`
+		Labels              bool   `db:"labels"                json:"labels,omitempty"`
+
+		Exported   uint64 `db:"exported"    json:"exported"`
+		FailReason string `db:"fail_reason" json:"failReason,omitempty"`
+
+		CreatedAt  time.Time  `db:"created_at"  json:"createdAt"`
+		StartedAt  *time.Time `db:"started_at"  json:"startedAt"`
+		FinishedAt *time.Time `db:"finished_at" json:"finishedAt"`
+
+		// Exported file is removed (and the job with it) after this time
+		ExpiresAt *time.Time `db:"expires_at" json:"expiresAt,omitempty"`
+
+		// Job was deleted before it finished; it is removed (with the
+		// exported file) by the worker that runs it
+		CanceledAt *time.Time `db:"canceled_at" json:"canceledAt,omitempty"`
+
+		// Last sign of life from the worker that runs the export
+		HeartbeatAt *time.Time `db:"heartbeat_at" json:"-"`
+
+		// Signed URL of the exported file, set when job is finished
+		DownloadUrl string `db:"-" json:"downloadUrl,omitempty"`
+	}
+
+	RecordExportFields []string
+)
+
+// IsDone returns true when export finished without errors
+func (job RecordExportJob) IsDone() bool {
+	return job.FinishedAt != nil && job.FailReason == ""
+}
+
+func (ff *RecordExportFields) Scan(value interface{}) error {
+	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
+	switch value.(type) {
+	case nil:
+		*ff = RecordExportFields{}
+	case []uint8:
+		if err := json.Unmarshal(value.([]byte), ff); err != nil {
+			return errors.Wrapf(err, "Can not scan '%v' into RecordExportFields", value)
+		}
+	}
+
+	return nil
+}
+
+func (ff RecordExportFields) Value() (driver.Value, error) {
+	if ff == nil {
+		ff = RecordExportFields{}
+	}
+
+	return json.Marshal(ff)
+}
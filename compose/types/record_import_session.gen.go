@@ -0,0 +1,67 @@
+package types
+
+// 	Hello! This file is auto-generated.
+
+type (
+
+	// RecordImportSessionSet slice of RecordImportSession
+	//
+	// This type is auto-generated.
+	RecordImportSessionSet []*RecordImportSession
+)
+
+// Walk iterates through every slice item and calls w(RecordImportSession) err
+//
+// This function is auto-generated.
+func (set RecordImportSessionSet) Walk(w func(*RecordImportSession) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(RecordImportSession) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set RecordImportSessionSet) Filter(f func(*RecordImportSession) (bool, error)) (out RecordImportSessionSet, err error) {
+	var ok bool
+	out = RecordImportSessionSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
+// FindByID finds items from slice by its ID property
+//
+// This function is auto-generated.
+func (set RecordImportSessionSet) FindByID(ID uint64) *RecordImportSession {
+	for i := range set {
+		if set[i].ID == ID {
+			return set[i]
+		}
+	}
+
+	return nil
+}
+
+// IDs returns a slice of uint64s from all items in the set
+//
+// This function is auto-generated.
+func (set RecordImportSessionSet) IDs() (IDs []uint64) {
+	IDs = make([]uint64, len(set))
+
+	for i := range set {
+		IDs[i] = set[i].ID
+	}
+
+	return
+}
@@ -0,0 +1,155 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// RecordImportSession is a stored row in the `record_import_session` table
+	//
+	// Uploaded file is kept in the store until the import is finished
+	RecordImportSession struct {
+		ID          uint64 `db:"id"            json:"sessionID,string"`
+		NamespaceID uint64 `db:"rel_namespace" json:"namespaceID,string"`
+		ModuleID    uint64 `db:"rel_module"    json:"moduleID,string"`
+		UserID      uint64 `db:"rel_owner"     json:"userID,string"`
+
+		// Name of the uploaded file, its format (csv, json, xlsx, ods) and location in the store
+		Name   string `db:"name"   json:"name"`
+		Format string `db:"format" json:"format"`
+		Url    string `db:"url"    json:"-"`
+
+		// Spreadsheets only: imported sheet (first when empty) and
+		// number of the header row (detected when 0)
+		Sheet     string `db:"sheet"      json:"sheet,omitempty"`
+		HeaderRow uint   `db:"header_row" json:"headerRow,omitempty"`
+
+		// All sheets of the uploaded spreadsheet, set when session is created
+		Sheets []string `db:"-" json:"sheets,omitempty"`
+
+		Fields  RecordImportFields `db:"fields"   json:"fields"`
+		OnError string             `db:"on_error" json:"onError"`
+
+		// When set, imported records with the same values of these fields are updated
+		UpsertKeys RecordImportKeys `db:"upsert_keys" json:"upsertKeys,omitempty"`
+
+		RecordImportProgress `json:"progress"`
+
+		CreatedAt time.Time  `db:"created_at" json:"createdAt"`
+		UpdatedAt *time.Time `db:"updated_at" json:"updatedAt,omitempty"`
+
+		// Last sign of life from the worker that runs the import
+		HeartbeatAt *time.Time `db:"heartbeat_at" json:"-"`
+	}
+
+	RecordImportProgress struct {
+		StartedAt  *time.Time `db:"started_at"  json:"startedAt"`
+		FinishedAt *time.Time `db:"finished_at" json:"finishedAt"`
+		CanceledAt *time.Time `db:"canceled_at" json:"canceledAt,omitempty"`
+		EntryCount uint64     `db:"entry_count" json:"entryCount"`
+		Completed  uint64     `db:"completed"   json:"completed"`
+		Failed     uint64     `db:"failed"      json:"failed"`
+		FailReason string     `db:"fail_reason" json:"failReason,omitempty"`
+
+		// Value errors of the first failed entries, see RecordImportFailure
+		FailLog RecordImportFailureSet `db:"-" json:"failLog,omitempty"`
+	}
+
+	// RecordImportFailure is a stored row in the `record_import_error` table
+	//
+	// One row is stored for every entry that failed to import
+	RecordImportFailure struct {
+		SessionID uint64 `db:"rel_session" json:"-"`
+
+		// Entry number (1-based) in the imported source
+		Entry  uint64                    `db:"entry"  json:"entry"`
+		Reason string                    `db:"reason" json:"reason,omitempty"`
+		Errors RecordImportFailureErrors `db:"errors" json:"errors"`
+	}
+
+	RecordImportFields map[string]string
+
+	RecordImportKeys []string
+
+	RecordImportFailureErrors []RecordValueError
+)
+
+// IsQueued returns true when import was started but not yet finished
+func (ses RecordImportSession) IsQueued() bool {
+	return ses.StartedAt != nil && ses.FinishedAt == nil
+}
+
+// Processed returns number of entries that were imported or failed to import
+func (p RecordImportProgress) Processed() uint64 {
+	return p.Completed + p.Failed
+}
+
+func (ff *RecordImportFields) Scan(value interface{}) error {
+	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
+	switch value.(type) {
+	case nil:
+		*ff = RecordImportFields{}
+	case []uint8:
+		if err := json.Unmarshal(value.([]byte), ff); err != nil {
+			return errors.Wrapf(err, "Can not scan '%v' into RecordImportFields", value)
+		}
+	}
+
+	return nil
+}
+
+func (ff RecordImportFields) Value() (driver.Value, error) {
+	if ff == nil {
+		ff = RecordImportFields{}
+	}
+
+	return json.Marshal(ff)
+}
+
+func (kk *RecordImportKeys) Scan(value interface{}) error {
+	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
+	switch value.(type) {
+	case nil:
+		*kk = RecordImportKeys{}
+	case []uint8:
+		if err := json.Unmarshal(value.([]byte), kk); err != nil {
+			return errors.Wrapf(err, "Can not scan '%v' into RecordImportKeys", value)
+		}
+	}
+
+	return nil
+}
+
+func (kk RecordImportKeys) Value() (driver.Value, error) {
+	if kk == nil {
+		kk = RecordImportKeys{}
+	}
+
+	return json.Marshal(kk)
+}
+
+func (ee *RecordImportFailureErrors) Scan(value interface{}) error {
+	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
+	switch value.(type) {
+	case nil:
+		*ee = RecordImportFailureErrors{}
+	case []uint8:
+		if err := json.Unmarshal(value.([]byte), ee); err != nil {
+			return errors.Wrapf(err, "Can not scan '%v' into RecordImportFailureErrors", value)
+		}
+	}
+
+	return nil
+}
+
+func (ee RecordImportFailureErrors) Value() (driver.Value, error) {
+	if ee == nil {
+		ee = RecordImportFailureErrors{}
+	}
+
+	return json.Marshal(ee)
+}
@@ -0,0 +1,41 @@
+package types
+
+// 	Hello! This file is auto-generated.
+
+type (
+
+	// RecordImportFailureSet slice of RecordImportFailure
+	//
+	// This type is auto-generated.
+	RecordImportFailureSet []*RecordImportFailure
+)
+
+// Walk iterates through every slice item and calls w(RecordImportFailure) err
+//
+// This function is auto-generated.
+func (set RecordImportFailureSet) Walk(w func(*RecordImportFailure) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(RecordImportFailure) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set RecordImportFailureSet) Filter(f func(*RecordImportFailure) (bool, error)) (out RecordImportFailureSet, err error) {
+	var ok bool
+	out = RecordImportFailureSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
@@ -0,0 +1,477 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// RecordReport holds aggregated records
+	//
+	// Each row is a map of dimension and metric values (by their aliases)
+	// with number of aggregated records under "count"
+	RecordReport struct {
+		Dimensions []*RecordReportDimension
+		Metrics    []*RecordReportMetric
+		Rows       []map[string]interface{}
+	}
+
+	RecordReportDimension struct {
+		Alias string
+
+		// Time bucket (day, week, month, quarter, year) of DATE_TRUNC dimension;
+		// dimension values are dates of the first day in the bucket (YYYY-MM-DD)
+		Bucket string
+	}
+
+	RecordReportMetric struct {
+		Alias string
+
+		// Aggregate function of the metric (COUNT, COUNTD, SUM, MIN, MAX, ...);
+		// tells how values of more rows are combined into one
+		Aggregate string
+
+		// Report running total of the metric instead of its value
+		Cumulative bool
+	}
+)
+
+const (
+	// RecordReportCount is the alias of the number of aggregated records
+	RecordReportCount = "count"
+
+	// RecordReportOther is the dimension value of the row that combines
+	// all rows that did not make it into the top N, see Top
+	RecordReportOther = "other"
+
+	recordReportBucketFormat = "2006-01-02"
+
+	// Gaps are not filled when the report would have more rows than this
+	// (e.g. daily buckets over centuries of wrongly entered dates)
+	recordReportMaxFilledRows = 10000
+)
+
+// Process applies top-N grouping (when limit is set), fills gaps between
+// time buckets and calculates cumulative metrics
+func (r *RecordReport) Process(limit uint) {
+	if limit > 0 {
+		r.Top(limit)
+	} else {
+		r.FillGaps()
+	}
+
+	r.Cumulate()
+}
+
+// Merge adds metrics (and rows) of another report over the same dimensions
+//
+// Rows with the same dimension values are joined; count of records
+// is kept from this report
+func (r *RecordReport) Merge(o *RecordReport) {
+	var index = make(map[string]map[string]interface{}, len(r.Rows))
+
+	for _, row := range r.Rows {
+		index[r.key(row, 0)] = row
+	}
+
+	for _, orow := range o.Rows {
+		row, ok := index[r.key(orow, 0)]
+		if !ok {
+			row = map[string]interface{}{RecordReportCount: int64(0)}
+			for _, d := range r.Dimensions {
+				row[d.Alias] = orow[d.Alias]
+			}
+
+			for _, m := range r.Metrics {
+				row[m.Alias] = m.zero()
+			}
+
+			index[r.key(orow, 0)] = row
+			r.Rows = append(r.Rows, row)
+		}
+
+		for _, m := range o.Metrics {
+			row[m.Alias] = orow[m.Alias]
+		}
+	}
+
+	// Rows missing in the other report have no values for its metrics
+	for _, row := range r.Rows {
+		for _, m := range o.Metrics {
+			if _, ok := row[m.Alias]; !ok {
+				row[m.Alias] = m.zero()
+			}
+		}
+	}
+
+	r.Metrics = append(r.Metrics, o.Metrics...)
+	r.sort()
+}
+
+// Top keeps rows with the first N values of the first dimension and combines
+// the rest into rows with RecordReportOther value
+//
+// Dimension values are ranked by the (total of) first metric or by the number
+// of records when there are no metrics
+func (r *RecordReport) Top(n uint) {
+	if len(r.Dimensions) == 0 {
+		return
+	}
+
+	var (
+		dim    = r.Dimensions[0].Alias
+		rank   = RecordReportCount
+		totals = map[string]float64{}
+		values = make([]string, 0)
+	)
+
+	if len(r.Metrics) > 0 {
+		rank = r.Metrics[0].Alias
+	}
+
+	for _, row := range r.Rows {
+		var v = fmt.Sprint(row[dim])
+		if _, ok := totals[v]; !ok {
+			values = append(values, v)
+		}
+
+		f, _ := reportFloat(row[rank])
+		totals[v] += f
+	}
+
+	if uint(len(values)) <= n {
+		return
+	}
+
+	sort.SliceStable(values, func(i, j int) bool {
+		return totals[values[i]] > totals[values[j]]
+	})
+
+	var (
+		top    = map[string]bool{}
+		rows   = make([]map[string]interface{}, 0, len(r.Rows))
+		others = map[string]map[string]interface{}{}
+		series = make([]string, 0)
+	)
+
+	for _, v := range values[:n] {
+		top[v] = true
+	}
+
+	for _, row := range r.Rows {
+		if top[fmt.Sprint(row[dim])] {
+			rows = append(rows, row)
+			continue
+		}
+
+		var (
+			key   = r.key(row, 1)
+			other = others[key]
+		)
+
+		if other == nil {
+			other = make(map[string]interface{}, len(row))
+			for k, v := range row {
+				other[k] = v
+			}
+
+			other[dim] = RecordReportOther
+			others[key] = other
+			series = append(series, key)
+			continue
+		}
+
+		other[RecordReportCount] = combineReportValues("COUNT", other[RecordReportCount], row[RecordReportCount])
+		for _, m := range r.Metrics {
+			other[m.Alias] = combineReportValues(m.Aggregate, other[m.Alias], row[m.Alias])
+		}
+	}
+
+	// Rows are sorted by dimensions, "other" rows are kept at the end
+	for _, key := range series {
+		rows = append(rows, others[key])
+	}
+
+	r.Rows = rows
+}
+
+// FillGaps adds rows for the missing time buckets of the first dimension
+//
+// Rows are added between the first and the last bucket in the report
+// for each combination of values of the other dimensions, so all series
+// have values for the same buckets.
+//
+// Report is left as it is when it would have more than recordReportMaxFilledRows rows
+func (r *RecordReport) FillGaps() {
+	if len(r.Dimensions) == 0 || r.Dimensions[0].Bucket == "" || len(r.Rows) == 0 {
+		return
+	}
+
+	var (
+		dim      = r.Dimensions[0]
+		from, to time.Time
+		existing = map[string]bool{}
+		series   = map[string]map[string]interface{}{}
+		keys     = make([]string, 0)
+	)
+
+	for _, row := range r.Rows {
+		t, ok := reportBucket(row[dim.Alias])
+		if !ok {
+			continue
+		}
+
+		if len(keys) == 0 || t.Before(from) {
+			from = t
+		}
+
+		if len(keys) == 0 || t.After(to) {
+			to = t
+		}
+
+		key := r.key(row, 1)
+		existing[key+"|"+t.Format(recordReportBucketFormat)] = true
+		if _, ok := series[key]; !ok {
+			series[key] = row
+			keys = append(keys, key)
+		}
+	}
+
+	if len(keys) == 0 {
+		return
+	}
+
+	// Buckets are counted only up to the limit
+	for t, n := from, 0; !t.After(to); t = nextReportBucket(t, dim.Bucket) {
+		if n++; n*len(keys) > recordReportMaxFilledRows {
+			return
+		}
+	}
+
+	for _, key := range keys {
+		for t := from; !t.After(to); t = nextReportBucket(t, dim.Bucket) {
+			var b = t.Format(recordReportBucketFormat)
+			if existing[key+"|"+b] {
+				continue
+			}
+
+			row := map[string]interface{}{
+				dim.Alias:         b,
+				RecordReportCount: int64(0),
+			}
+
+			for _, d := range r.Dimensions[1:] {
+				row[d.Alias] = series[key][d.Alias]
+			}
+
+			for _, m := range r.Metrics {
+				row[m.Alias] = m.zero()
+			}
+
+			r.Rows = append(r.Rows, row)
+		}
+	}
+
+	r.sort()
+}
+
+// Cumulate replaces values of cumulative metrics with their running totals
+//
+// Totals run over the first dimension, separately for each combination
+// of values of the other dimensions
+func (r *RecordReport) Cumulate() {
+	for _, m := range r.Metrics {
+		if !m.Cumulative {
+			continue
+		}
+
+		var totals = map[string]float64{}
+		for _, row := range r.Rows {
+			key := r.key(row, 1)
+			f, _ := reportFloat(row[m.Alias])
+			totals[key] += f
+			row[m.Alias] = totals[key]
+		}
+	}
+}
+
+// key returns values of dimensions (from the given one on) as a string
+func (r RecordReport) key(row map[string]interface{}, from int) string {
+	var kk = make([]string, 0, len(r.Dimensions))
+	for _, d := range r.Dimensions[from:] {
+		kk = append(kk, fmt.Sprintf("%T:%v", row[d.Alias], row[d.Alias]))
+	}
+
+	return strings.Join(kk, "|")
+}
+
+// sort orders rows by values of dimensions; empty values first
+func (r *RecordReport) sort() {
+	sort.SliceStable(r.Rows, func(i, j int) bool {
+		for _, d := range r.Dimensions {
+			if c := compareReportValues(r.Rows[i][d.Alias], r.Rows[j][d.Alias]); c != 0 {
+				return c < 0
+			}
+		}
+
+		return false
+	})
+}
+
+// zero returns value of the metric for rows without records
+func (m RecordReportMetric) zero() interface{} {
+	switch m.Aggregate {
+	case "COUNT", "COUNTD", "SUM":
+		return float64(0)
+	}
+
+	return nil
+}
+
+// combineReportValues returns value of the aggregate over the rows of both values
+//
+// Values of aggregates that can not be combined (AVG, COUNTD...) are unknown (nil)
+func combineReportValues(aggregate string, a, b interface{}) interface{} {
+	if aggregate == "MIN" || aggregate == "MAX" {
+		if v, ok := combineReportStrings(aggregate, a, b); ok {
+			return v
+		}
+	}
+
+	var (
+		fa, oka = reportFloat(a)
+		fb, okb = reportFloat(b)
+	)
+
+	switch {
+	case !oka && !okb:
+		return nil
+	case !oka:
+		fa = fb
+	case !okb:
+		fb = fa
+	}
+
+	switch aggregate {
+	case "COUNT", "SUM":
+		if !oka || !okb {
+			return fa
+		}
+
+		return fa + fb
+	case "MIN":
+		if fb < fa {
+			return fb
+		}
+
+		return fa
+	case "MAX":
+		if fb > fa {
+			return fb
+		}
+
+		return fa
+	}
+
+	return nil
+}
+
+// combineReportStrings returns minimum or maximum of non-numeric values (dates, strings)
+func combineReportStrings(aggregate string, a, b interface{}) (interface{}, bool) {
+	var (
+		sa, oka = a.(string)
+		sb, okb = b.(string)
+	)
+
+	switch {
+	case !oka && !okb:
+		return nil, false
+	case !oka && a == nil:
+		return sb, true
+	case !okb && b == nil:
+		return sa, true
+	case !oka || !okb:
+		return nil, false
+	}
+
+	if (aggregate == "MIN") == (sb < sa) {
+		return sb, true
+	}
+
+	return sa, true
+}
+
+func reportFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int64:
+		return float64(n), true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	}
+
+	return 0, false
+}
+
+func reportBucket(v interface{}) (time.Time, bool) {
+	s, ok := v.(string)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(recordReportBucketFormat, s)
+	return t, err == nil
+}
+
+func nextReportBucket(t time.Time, bucket string) time.Time {
+	switch bucket {
+	case "week":
+		return t.AddDate(0, 0, 7)
+	case "month":
+		return t.AddDate(0, 1, 0)
+	case "quarter":
+		return t.AddDate(0, 3, 0)
+	case "year":
+		return t.AddDate(1, 0, 0)
+	}
+
+	return t.AddDate(0, 0, 1)
+}
+
+// compareReportValues compares dimension values; nil < numbers < strings
+func compareReportValues(a, b interface{}) int {
+	var rank = func(v interface{}) int {
+		switch v.(type) {
+		case nil:
+			return 0
+		case float64, int64:
+			return 1
+		}
+
+		return 2
+	}
+
+	if ra, rb := rank(a), rank(b); ra != rb {
+		return ra - rb
+	}
+
+	if fa, ok := reportFloat(a); ok {
+		if fb, ok := reportFloat(b); ok {
+			switch {
+			case fa < fb:
+				return -1
+			case fa > fb:
+				return 1
+			}
+
+			return 0
+		}
+	}
+
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordReportFillGaps(t *testing.T) {
+	var (
+		req = require.New(t)
+		r   = &RecordReport{
+			Dimensions: []*RecordReportDimension{{Alias: "d", Bucket: "month"}, {Alias: "s"}},
+			Metrics:    []*RecordReportMetric{{Alias: "m", Aggregate: "SUM", Cumulative: true}, {Alias: "a", Aggregate: "AVG"}},
+			Rows: []map[string]interface{}{
+				{"d": "2019-01-01", "s": "x", "count": int64(1), "m": 1.0, "a": 1.0},
+				{"d": "2019-03-01", "s": "x", "count": int64(2), "m": 2.0, "a": 2.0},
+				{"d": "2019-02-01", "s": "y", "count": int64(3), "m": 3.0, "a": 3.0},
+			},
+		}
+	)
+
+	r.Process(0)
+	req.Equal([]map[string]interface{}{
+		{"d": "2019-01-01", "s": "x", "count": int64(1), "m": 1.0, "a": 1.0},
+		{"d": "2019-01-01", "s": "y", "count": int64(0), "m": 0.0, "a": nil},
+		{"d": "2019-02-01", "s": "x", "count": int64(0), "m": 1.0, "a": nil},
+		{"d": "2019-02-01", "s": "y", "count": int64(3), "m": 3.0, "a": 3.0},
+		{"d": "2019-03-01", "s": "x", "count": int64(2), "m": 3.0, "a": 2.0},
+		{"d": "2019-03-01", "s": "y", "count": int64(0), "m": 3.0, "a": nil},
+	}, r.Rows)
+}
+
+func TestRecordReportFillGapsLimit(t *testing.T) {
+	var (
+		req  = require.New(t)
+		rows = []map[string]interface{}{
+			{"d": "0001-01-01", "count": int64(1)},
+			{"d": "2019-01-01", "count": int64(1)},
+		}
+
+		r = &RecordReport{
+			Dimensions: []*RecordReportDimension{{Alias: "d", Bucket: "day"}},
+			Rows:       append([]map[string]interface{}{}, rows...),
+		}
+	)
+
+	r.FillGaps()
+	req.Equal(rows, r.Rows)
+
+	r.Dimensions[0].Bucket = "year"
+	r.FillGaps()
+	req.Len(r.Rows, 2019)
+	req.Equal("0001-01-01", r.Rows[0]["d"])
+}
+
+func TestRecordReportTop(t *testing.T) {
+	var (
+		req = require.New(t)
+		r   = &RecordReport{
+			Dimensions: []*RecordReportDimension{{Alias: "d"}},
+			Metrics:    []*RecordReportMetric{{Alias: "m", Aggregate: "SUM"}, {Alias: "x", Aggregate: "MAX"}, {Alias: "a", Aggregate: "AVG"}},
+			Rows: []map[string]interface{}{
+				{"d": "a", "count": int64(1), "m": 1.0, "x": 1.0, "a": 1.0},
+				{"d": "b", "count": int64(1), "m": 5.0, "x": 5.0, "a": 5.0},
+				{"d": "c", "count": int64(2), "m": 3.0, "x": 2.0, "a": 1.5},
+				{"d": "e", "count": int64(1), "m": 4.0, "x": 4.0, "a": 4.0},
+			},
+		}
+	)
+
+	r.Top(2)
+	req.Len(r.Rows, 3)
+	req.Equal("b", r.Rows[0]["d"])
+	req.Equal("e", r.Rows[1]["d"])
+	req.Equal(map[string]interface{}{"d": RecordReportOther, "count": 3.0, "m": 4.0, "x": 2.0, "a": nil}, r.Rows[2])
+}
+
+func TestRecordReportMerge(t *testing.T) {
+	var (
+		req = require.New(t)
+		r   = &RecordReport{
+			Dimensions: []*RecordReportDimension{{Alias: "d"}},
+			Metrics:    []*RecordReportMetric{{Alias: "m0", Aggregate: "SUM"}},
+			Rows: []map[string]interface{}{
+				{"d": nil, "count": int64(1), "m0": 1.0},
+				{"d": "b", "count": int64(2), "m0": 2.0},
+			},
+		}
+	)
+
+	r.Merge(&RecordReport{
+		Dimensions: []*RecordReportDimension{{Alias: "d"}},
+		Metrics:    []*RecordReportMetric{{Alias: "m1", Aggregate: "COUNTD"}},
+		Rows: []map[string]interface{}{
+			{"d": "a", "count": int64(5), "m1": 5.0},
+			{"d": "b", "count": int64(6), "m1": 6.0},
+		},
+	})
+
+	req.Len(r.Metrics, 2)
+	req.Equal([]map[string]interface{}{
+		{"d": nil, "count": int64(1), "m0": 1.0, "m1": 0.0},
+		{"d": "a", "count": int64(0), "m1": 5.0, "m0": 0.0},
+		{"d": "b", "count": int64(2), "m0": 2.0, "m1": 6.0},
+	}, r.Rows)
+}
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/cortezaproject/corteza-server/pkg/rh"
+)
+
+type (
+	// RecordRevision is a stored row in the `record_revision` table
+	//
+	// Each create, update, delete or restore of a record produces one revision
+	RecordRevision struct {
+		ID          uint64 `db:"id"            json:"revisionID,string"`
+		NamespaceID uint64 `db:"rel_namespace" json:"namespaceID,string"`
+		ModuleID    uint64 `db:"rel_module"    json:"moduleID,string"`
+		RecordID    uint64 `db:"rel_record"    json:"recordID,string"`
+		Revision    uint   `db:"revision"      json:"revision"`
+		Operation   string `db:"operation"     json:"operation"`
+
+		Changes  RecordRevisionChangeSet `db:"changes"  json:"changes"`
+		Snapshot RecordValueSet          `db:"snapshot" json:"-"`
+
+		CreatedAt time.Time `db:"created_at" json:"createdAt"`
+		CreatedBy uint64    `db:"created_by" json:"createdBy,string"`
+	}
+
+	// RecordRevisionChange holds old & new values of one field
+	RecordRevisionChange struct {
+		Field string   `json:"field"`
+		Old   []string `json:"old"`
+		New   []string `json:"new"`
+	}
+
+	RecordRevisionChangeSet []*RecordRevisionChange
+
+	RecordRevisionFilter struct {
+		NamespaceID uint64 `json:"namespaceID,string"`
+		ModuleID    uint64 `json:"moduleID,string,omitempty"`
+		RecordID    uint64 `json:"recordID,string"`
+
+		// Standard paging fields & helpers
+		rh.PageFilter
+	}
+)
+
+const (
+	RecordRevisionCreate   = "create"
+	RecordRevisionUpdate   = "update"
+	RecordRevisionDelete   = "delete"
+	RecordRevisionRestore  = "restore"
+	RecordRevisionUndelete = "undelete"
+)
+
+// NewRecordRevisionChanges compares two value sets and returns list of changed fields
+//
+// Fields are listed in the order they first appear in old and then in new set
+func NewRecordRevisionChanges(old, new RecordValueSet) (cc RecordRevisionChangeSet) {
+	var (
+		names = make([]string, 0)
+		seen  = map[string]bool{}
+	)
+
+	for _, v := range append(append(RecordValueSet{}, old...), new...) {
+		if !seen[v.Name] {
+			seen[v.Name] = true
+			names = append(names, v.Name)
+		}
+	}
+
+	for _, name := range names {
+		var (
+			o = old.FilterByName(name).values()
+			n = new.FilterByName(name).values()
+		)
+
+		if equalStrings(o, n) {
+			continue
+		}
+
+		cc = append(cc, &RecordRevisionChange{Field: name, Old: o, New: n})
+	}
+
+	return cc
+}
+
+// FilterByField returns changes of fields that pass the check
+func (set RecordRevisionChangeSet) FilterByField(fn func(field string) bool) (cc RecordRevisionChangeSet) {
+	cc = RecordRevisionChangeSet{}
+
+	for i := range set {
+		if fn(set[i].Field) {
+			cc = append(cc, set[i])
+		}
+	}
+
+	return
+}
+
+func (set *RecordRevisionChangeSet) Scan(value interface{}) error {
+	//lint:ignore S1034 This typecast is intentional, we need to get []byte out of a []uint8
+	switch value.(type) {
+	case nil:
+		*set = RecordRevisionChangeSet{}
+	case []uint8:
+		if err := json.Unmarshal(value.([]byte), set); err != nil {
+			return errors.Wrapf(err, "Can not scan '%v' into RecordRevisionChangeSet", value)
+		}
+	}
+
+	return nil
+}
+
+func (set RecordRevisionChangeSet) Value() (driver.Value, error) {
+	if set == nil {
+		set = RecordRevisionChangeSet{}
+	}
+
+	return json.Marshal(set)
+}
+
+// values returns plain values from the set (ordered as in the set)
+func (set RecordValueSet) values() []string {
+	var vv = make([]string, len(set))
+	for i := range set {
+		vv[i] = set[i].Value
+	}
+
+	return vv
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewRecordRevisionChanges(t *testing.T) {
+	tests := []struct {
+		name string
+		old  RecordValueSet
+		new  RecordValueSet
+		want RecordRevisionChangeSet
+	}{
+		{
+			name: "no changes",
+			old:  RecordValueSet{{Name: "n", Value: "v"}},
+			new:  RecordValueSet{{Name: "n", Value: "v"}},
+			want: nil,
+		},
+		{
+			name: "created",
+			old:  nil,
+			new:  RecordValueSet{{Name: "n", Value: "v"}},
+			want: RecordRevisionChangeSet{{Field: "n", Old: []string{}, New: []string{"v"}}},
+		},
+		{
+			name: "changed and removed",
+			old:  RecordValueSet{{Name: "a", Value: "1"}, {Name: "b", Value: "2"}, {Name: "c", Value: "3"}},
+			new:  RecordValueSet{{Name: "a", Value: "1"}, {Name: "b", Value: "4"}},
+			want: RecordRevisionChangeSet{
+				{Field: "b", Old: []string{"2"}, New: []string{"4"}},
+				{Field: "c", Old: []string{"3"}, New: []string{}},
+			},
+		},
+		{
+			name: "multi-value reordered",
+			old:  RecordValueSet{{Name: "m", Value: "x", Place: 0}, {Name: "m", Value: "y", Place: 1}},
+			new:  RecordValueSet{{Name: "m", Value: "y", Place: 0}, {Name: "m", Value: "x", Place: 1}},
+			want: RecordRevisionChangeSet{{Field: "m", Old: []string{"x", "y"}, New: []string{"y", "x"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewRecordRevisionChanges(tt.old, tt.new); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewRecordRevisionChanges() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+type (
+	// RecordValueError describes a problem with one specific record value
+	RecordValueError struct {
+		Field   string                 `json:"field"`
+		Place   uint                   `json:"place"`
+		Kind    string                 `json:"kind"`
+		Message string                 `json:"message"`
+		Meta    map[string]interface{} `json:"meta,omitempty"`
+	}
+
+	// RecordValueErrorSet holds all value errors found while validating a record
+	//
+	// It satisfies error interface so it can be passed around as any other error
+	// while still keeping the details for the client
+	RecordValueErrorSet struct {
+		Set []RecordValueError `json:"set"`
+	}
+)
+
+// Push appends one or more value errors to the set
+func (v *RecordValueErrorSet) Push(err ...RecordValueError) {
+	v.Set = append(v.Set, err...)
+}
+
+// IsValid returns true when there are no value errors in the set
+func (v *RecordValueErrorSet) IsValid() bool {
+	return v == nil || len(v.Set) == 0
+}
+
+// HasField returns true when there are errors for the given field
+func (v *RecordValueErrorSet) HasField(name string) bool {
+	if v == nil {
+		return false
+	}
+
+	for _, e := range v.Set {
+		if e.Field == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v *RecordValueErrorSet) Error() string {
+	if v.IsValid() {
+		return "no record value errors"
+	}
+
+	var ff = make([]string, len(v.Set))
+	for i, e := range v.Set {
+		ff[i] = e.String()
+	}
+
+	return fmt.Sprintf("invalid record values: %s", strings.Join(ff, "; "))
+}
+
+func (e RecordValueError) String() string {
+	return fmt.Sprintf("%s[%d]: %s", e.Field, e.Place, e.Message)
+}
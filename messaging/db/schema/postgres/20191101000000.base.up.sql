@@ -0,0 +1,160 @@
+-- PostgreSQL schema, equivalent to all MySQL migrations up to 20191008125405
+
+CREATE TABLE messaging_channel (
+  id                BIGINT       NOT NULL,
+  name              TEXT         NOT NULL, -- display name of the channel
+  topic             TEXT         NOT NULL,
+  meta              JSONB        NOT NULL,
+
+  type              VARCHAR(16)  NOT NULL DEFAULT 'public', -- private, public, group
+  membership_policy VARCHAR(16)  NOT NULL DEFAULT '',       -- featured, forced or empty
+
+  rel_organisation  BIGINT       NOT NULL,
+  rel_creator       BIGINT       NOT NULL,
+
+  created_at        TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at        TIMESTAMPTZ      NULL,
+  archived_at       TIMESTAMPTZ      NULL,
+  deleted_at        TIMESTAMPTZ      NULL, -- channel soft delete
+
+  rel_last_message  BIGINT       NOT NULL DEFAULT 0,
+
+  PRIMARY KEY (id)
+);
+
+CREATE TABLE messaging_channel_member (
+  rel_channel       BIGINT       NOT NULL REFERENCES messaging_channel (id),
+  rel_user          BIGINT       NOT NULL,
+
+  type              VARCHAR(16)  NOT NULL DEFAULT 'member', -- owner, member, invitee
+  flag              VARCHAR(16)  NOT NULL DEFAULT '',       -- pinned, hidden, ignored or empty
+
+  created_at        TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at        TIMESTAMPTZ      NULL,
+
+  PRIMARY KEY (rel_channel, rel_user)
+);
+
+CREATE TABLE messaging_unread (
+  rel_channel       BIGINT       NOT NULL,
+  rel_reply_to      BIGINT       NOT NULL DEFAULT 0,
+  rel_user          BIGINT       NOT NULL,
+  rel_last_message  BIGINT       NOT NULL DEFAULT 0,
+  count             INTEGER      NOT NULL DEFAULT 0,
+
+  PRIMARY KEY (rel_channel, rel_reply_to, rel_user)
+);
+
+CREATE TABLE messaging_message (
+  id                BIGINT       NOT NULL,
+  type              TEXT,
+  message           TEXT         NOT NULL,
+  meta              JSONB,
+  rel_user          BIGINT       NOT NULL,
+  rel_channel       BIGINT       NOT NULL REFERENCES messaging_channel (id),
+  reply_to          BIGINT       NOT NULL DEFAULT 0,
+  replies           INTEGER      NOT NULL DEFAULT 0,
+
+  created_at        TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at        TIMESTAMPTZ      NULL,
+  deleted_at        TIMESTAMPTZ      NULL,
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX messaging_message_rel_channel ON messaging_message (rel_channel);
+CREATE INDEX messaging_message_reply_to    ON messaging_message (reply_to);
+
+CREATE TABLE messaging_message_flag (
+  id                BIGINT       NOT NULL,
+  rel_channel       BIGINT       NOT NULL,
+  rel_message       BIGINT       NOT NULL,
+  rel_user          BIGINT       NOT NULL,
+  flag              TEXT,
+
+  created_at        TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (id)
+);
+
+CREATE TABLE messaging_mention (
+  id                BIGINT       NOT NULL,
+  rel_channel       BIGINT       NOT NULL,
+  rel_message       BIGINT       NOT NULL,
+  rel_user          BIGINT       NOT NULL,
+  rel_mentioned_by  BIGINT       NOT NULL,
+
+  created_at        TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX messaging_mention_rel_mentioned_by ON messaging_mention (rel_mentioned_by);
+
+CREATE TABLE messaging_attachment (
+  id                BIGINT       NOT NULL,
+  rel_user          BIGINT       NOT NULL,
+
+  url               VARCHAR(512),
+  preview_url       VARCHAR(512),
+
+  size              INTEGER,
+  mimetype          VARCHAR(255),
+  name              TEXT,
+
+  meta              JSONB,
+
+  created_at        TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at        TIMESTAMPTZ      NULL,
+  deleted_at        TIMESTAMPTZ      NULL,
+
+  PRIMARY KEY (id)
+);
+
+CREATE TABLE messaging_message_attachment (
+  rel_message       BIGINT       NOT NULL,
+  rel_attachment    BIGINT       NOT NULL,
+
+  PRIMARY KEY (rel_message)
+);
+
+CREATE TABLE messaging_webhook (
+  id                BIGINT       NOT NULL,
+  kind              VARCHAR(8)   NOT NULL, -- incoming, outgoing
+  token             VARCHAR(255) NOT NULL, -- authentication token
+  rel_owner         BIGINT       NOT NULL, -- webhook owner user ID
+  rel_user          BIGINT       NOT NULL, -- webhook message user ID
+  rel_channel       BIGINT       NOT NULL, -- channel ID
+  outgoing_trigger  VARCHAR(32)  NOT NULL, -- outgoing command trigger
+  outgoing_url      VARCHAR(255) NOT NULL, -- URL for POST request
+
+  created_at        TIMESTAMPTZ  NOT NULL,
+  updated_at        TIMESTAMPTZ      NULL,
+  deleted_at        TIMESTAMPTZ      NULL,
+
+  PRIMARY KEY (id)
+);
+
+CREATE UNIQUE INDEX uid_messaging_webhook_outgoing_trigger ON messaging_webhook (outgoing_trigger);
+CREATE INDEX messaging_webhook_rel_owner   ON messaging_webhook (rel_owner);
+CREATE INDEX messaging_webhook_rel_channel ON messaging_webhook (rel_channel);
+
+CREATE TABLE messaging_permission_rules (
+  rel_role          BIGINT       NOT NULL,
+  resource          VARCHAR(128) NOT NULL,
+  operation         VARCHAR(128) NOT NULL,
+  access            SMALLINT     NOT NULL,
+
+  PRIMARY KEY (rel_role, resource, operation)
+);
+
+CREATE TABLE messaging_settings (
+  rel_owner         BIGINT       NOT NULL DEFAULT 0, -- Value owner, 0 for global settings
+  name              VARCHAR(200) NOT NULL,           -- Unique set of setting keys
+  value             JSONB,                           -- Setting value
+
+  updated_at        TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP, -- When was the value updated
+  updated_by        BIGINT       NOT NULL DEFAULT 0,                 -- Who created/updated the value
+
+  PRIMARY KEY (name, rel_owner)
+);
@@ -6,7 +6,6 @@ import (
 	"github.com/go-chi/chi"
 	_ "github.com/joho/godotenv/autoload"
 	"github.com/spf13/cobra"
-	"github.com/titpetric/factory"
 
 	"github.com/cortezaproject/corteza-server/messaging/commands"
 	migrate "github.com/cortezaproject/corteza-server/messaging/db"
@@ -15,6 +14,7 @@ import (
 	"github.com/cortezaproject/corteza-server/messaging/websocket"
 	"github.com/cortezaproject/corteza-server/pkg/cli"
 	"github.com/cortezaproject/corteza-server/pkg/cli/options"
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
 )
 
 const (
@@ -91,7 +91,7 @@ func Configure() *cli.Config {
 
 		ProvisionMigrateDatabase: cli.Runners{
 			func(ctx context.Context, cmd *cobra.Command, c *cli.Config) error {
-				var db, err = factory.Database.Get(messaging)
+				var db, err = dbx.Get(messaging)
 				if err != nil {
 					return err
 				}
@@ -118,7 +118,7 @@ func (r attachment) CreateAttachment(mod *types.Attachment) (*types.Attachment,
 
 	mod.CreatedAt = time.Now()
 
-	return mod, r.db().Insert(r.table(), mod)
+	return mod, rh.Insert(r.db(), r.table(), mod)
 }
 
 func (r attachment) DeleteAttachmentByID(ID uint64) error {
@@ -131,5 +131,5 @@ func (r attachment) BindAttachment(attachmentId, messageId uint64) error {
 		RelMessage    uint64 `db:"rel_message"`
 	}{attachmentId, messageId}
 
-	return r.db().Insert(r.tableMessage(), bond)
+	return rh.Insert(r.db(), r.tableMessage(), bond)
 }
@@ -160,7 +160,7 @@ func (r channel) Create(mod *types.Channel) (*types.Channel, error) {
 		mod.Type = types.ChannelTypePublic
 	}
 
-	return mod, r.db().Insert("messaging_channel", mod)
+	return mod, rh.Insert(r.db(), "messaging_channel", mod)
 }
 
 func (r channel) Update(mod *types.Channel) (*types.Channel, error) {
@@ -99,7 +99,7 @@ func (r channelMember) queryExactMembers(memberIDs ...uint64) squirrel.SelectBui
 		GroupBy("cm.rel_channel").
 		Having(squirrel.Eq{
 			"COUNT(*)": len(memberIDs),
-			"CONCAT(GROUP_CONCAT(cm.rel_user ORDER BY 1 ASC SEPARATOR ','),',')": membersConcat,
+			"CONCAT(" + r.dialect().GroupConcat("cm.rel_user", ",") + ", ',')": membersConcat,
 		})
 }
 
@@ -123,7 +123,7 @@ func (r *channelMember) Create(mod *types.ChannelMember) (*types.ChannelMember,
 	rh.SetCurrentTimeRounded(&mod.CreatedAt)
 	mod.UpdatedAt = nil
 
-	return mod, r.db().Insert("messaging_channel_member", mod)
+	return mod, rh.Insert(r.db(), "messaging_channel_member", mod)
 }
 
 // Update modifies existing channel membership record
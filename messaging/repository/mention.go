@@ -77,7 +77,7 @@ func (r mention) findAllBy(cnd squirrel.Sqlizer) (mm types.MentionSet, err error
 func (r mention) Create(m *types.Mention) (*types.Mention, error) {
 	m.ID = factory.Sonyflake.NextID()
 	m.CreatedAt = time.Now()
-	return m, r.db().Insert(r.table(), m)
+	return m, rh.Insert(r.db(), r.table(), m)
 }
 
 func (r mention) DeleteByMessageID(ID uint64) error {
@@ -302,13 +302,13 @@ func (r *message) Create(mod *types.Message) (*types.Message, error) {
 	mod.ID = factory.Sonyflake.NextID()
 	rh.SetCurrentTimeRounded(&mod.CreatedAt)
 
-	return mod, r.db().Insert("messaging_message", mod)
+	return mod, rh.Insert(r.db(), "messaging_message", mod)
 }
 
 func (r *message) Update(mod *types.Message) (*types.Message, error) {
 	rh.SetCurrentTimeRounded(&mod.UpdatedAt)
 
-	return mod, r.db().Replace("messaging_message", mod)
+	return mod, rh.Upsert(r.db(), "messaging_message", mod, "id")
 }
 
 func (r *message) BindAvatar(in *types.Message, avatar io.Reader) (*types.Message, error) {
@@ -117,7 +117,7 @@ func (r messageFlag) FindByMessageIDs(IDs ...uint64) (set types.MessageFlagSet,
 func (r messageFlag) Create(mod *types.MessageFlag) (*types.MessageFlag, error) {
 	mod.ID = factory.Sonyflake.NextID()
 	mod.CreatedAt = time.Now()
-	return mod, r.db().Insert(r.table(), mod)
+	return mod, rh.Insert(r.db(), r.table(), mod)
 }
 
 func (r messageFlag) DeleteByID(ID uint64) error {
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/titpetric/factory"
@@ -43,11 +44,8 @@ const (
                                   SET count = count - 1
                                 WHERE rel_channel = ? AND rel_reply_to = ? AND count > 0`
 
-	sqlResetCount = `REPLACE INTO messaging_unread (rel_channel, rel_reply_to, rel_user, count) VALUES (?, ?, ?, 0)`
-
-	sqlUnreadPresetChannel = `INSERT IGNORE INTO messaging_unread (rel_channel, rel_reply_to, rel_user) VALUES (?, ?, ?)`
-	sqlUnreadPresetThreads = `INSERT IGNORE INTO messaging_unread (rel_channel, rel_reply_to, rel_user) 
-                     SELECT rel_channel, id, ? 
+	// User ID placeholder is wrapped in a (dialect specific) cast
+	sqlUnreadPresetThreadsSource = `SELECT rel_channel, id, %s 
                        FROM messaging_message 
                       WHERE rel_channel = ?
                         AND replies > 0`
@@ -62,6 +60,11 @@ func (r unread) table() string {
 	return "messaging_unread"
 }
 
+// presetColumns are used when unread entries are prepared for (new) channel members
+func (r unread) presetColumns() []string {
+	return []string{"rel_channel", "rel_reply_to", "rel_user"}
+}
+
 // With context...
 func (r *unread) With(ctx context.Context, db *factory.DB) UnreadRepository {
 	return &unread{
@@ -172,7 +175,7 @@ func (r unread) Preset(channelID, threadID uint64, userIDs ...uint64) (err error
 			continue
 		}
 
-		_, err = r.db().Exec(sqlUnreadPresetChannel, channelID, threadID, userID)
+		_, err = r.db().Exec(r.dialect().InsertIgnoreQuery(r.table(), r.presetColumns(), "VALUES (?, ?, ?)"), channelID, threadID, userID)
 
 		if err != nil {
 			return
@@ -180,7 +183,11 @@ func (r unread) Preset(channelID, threadID uint64, userIDs ...uint64) (err error
 
 		if threadID == 0 {
 			// Preset for all threads in the channel
-			_, err = r.db().Exec(sqlUnreadPresetThreads, userID, channelID)
+			_, err = r.db().Exec(r.dialect().InsertIgnoreQuery(
+				r.table(),
+				r.presetColumns(),
+				fmt.Sprintf(sqlUnreadPresetThreadsSource, r.dialect().CastAsNumber("?")),
+			), userID, channelID)
 
 			if err != nil {
 				return
@@ -201,7 +208,7 @@ func (r *unread) Record(userID, channelID, threadID, lastReadMessageID uint64, c
 		Count:         count,
 	}
 
-	return r.db().Replace("messaging_unread", mod)
+	return rh.Upsert(r.db(), "messaging_unread", mod, "rel_channel", "rel_reply_to", "rel_user")
 }
 
 // Inc increments unread message count on a channel/thread for all but one user
@@ -220,7 +227,15 @@ func (r *unread) Dec(channelID, threadID, userID uint64) (err error) {
 	if err != nil {
 		return err
 	}
-	_, err = r.db().Exec(sqlResetCount, channelID, threadID, userID)
+	_, err = r.db().Exec(
+		r.dialect().UpsertQuery(
+			r.table(),
+			[]string{"rel_channel", "rel_reply_to", "rel_user", "count"},
+			[]string{"rel_channel", "rel_reply_to", "rel_user"},
+			"VALUES (?, ?, ?, 0)",
+		),
+		channelID, threadID, userID,
+	)
 	if err != nil {
 		return err
 	}
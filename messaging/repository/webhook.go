@@ -49,13 +49,13 @@ func (r *webhook) Create(webhook *types.Webhook) (*types.Webhook, error) {
 	webhook.ID = factory.Sonyflake.NextID()
 	webhook.CreatedAt = time.Now()
 
-	return webhook, errors.WithStack(r.db().Insert(r.webhook, webhook))
+	return webhook, errors.WithStack(rh.Insert(r.db(), r.webhook, webhook))
 }
 
 func (r *webhook) Update(webhook *types.Webhook) (*types.Webhook, error) {
 	rh.SetCurrentTimeRounded(&webhook.UpdatedAt)
 
-	return webhook, errors.WithStack(r.db().Replace(r.webhook, webhook))
+	return webhook, errors.WithStack(rh.Upsert(r.db(), r.webhook, webhook, "id"))
 }
 
 func (r *webhook) Get(webhookID uint64) (*types.Webhook, error) {
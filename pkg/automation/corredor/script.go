@@ -1,6 +1,12 @@
 package corredor
 
 import (
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
 	"github.com/cortezaproject/corteza-server/pkg/automation"
 )
 
@@ -21,3 +27,30 @@ func FromScript(s *automation.Script) *Script {
 		Async:   s.Async,
 	}
 }
+
+// Output returns output of the script (console log) that Corredor
+// sends with the response in "output" header or trailer
+func Output(mm ...metadata.MD) string {
+	var out []string
+	for _, md := range mm {
+		out = append(out, md.Get("output")...)
+	}
+
+	return strings.Join(out, "\n")
+}
+
+// IsTransient checks if call failed because Corredor was not available
+// (or did not respond in time); such calls can be retried
+func IsTransient(err error) bool {
+	s, ok := status.FromError(err)
+	if !ok || err == nil {
+		return false
+	}
+
+	switch s.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,67 @@
+package automation
+
+// 	Hello! This file is auto-generated.
+
+type (
+
+	// ExecutionSet slice of Execution
+	//
+	// This type is auto-generated.
+	ExecutionSet []*Execution
+)
+
+// Walk iterates through every slice item and calls w(Execution) err
+//
+// This function is auto-generated.
+func (set ExecutionSet) Walk(w func(*Execution) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(Execution) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set ExecutionSet) Filter(f func(*Execution) (bool, error)) (out ExecutionSet, err error) {
+	var ok bool
+	out = ExecutionSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
+// FindByID finds items from slice by its ID property
+//
+// This function is auto-generated.
+func (set ExecutionSet) FindByID(ID uint64) *Execution {
+	for i := range set {
+		if set[i].ID == ID {
+			return set[i]
+		}
+	}
+
+	return nil
+}
+
+// IDs returns a slice of uint64s from all items in the set
+//
+// This function is auto-generated.
+func (set ExecutionSet) IDs() (IDs []uint64) {
+	IDs = make([]uint64, len(set))
+
+	for i := range set {
+		IDs[i] = set[i].ID
+	}
+
+	return
+}
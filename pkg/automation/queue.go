@@ -0,0 +1,109 @@
+package automation
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx/types"
+
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+	"github.com/cortezaproject/corteza-server/pkg/rh"
+)
+
+type (
+	// Execution is a queued (async, after-trigger) execution of the script
+	//
+	// Executions are kept in the queue until they succeed; failed executions
+	// are retried until script's max attempts are reached and then moved to
+	// the dead-letter list
+	Execution struct {
+		ID uint64 `json:"executionID,string" db:"id"`
+
+		ScriptID uint64 `json:"scriptID,string" db:"rel_script"`
+
+		Resource string `json:"resource" db:"resource"`
+		Event    string `json:"event" db:"event"`
+
+		// Resource specific data (record, module...) the script is executed with
+		Payload types.JSONText `json:"payload" db:"payload"`
+
+		// Invoker of the script and its roles (space separated)
+		UserID    uint64 `json:"userID,string,omitempty" db:"rel_user"`
+		UserRoles string `json:"-" db:"user_roles"`
+
+		Attempts  uint   `json:"attempts" db:"attempts"`
+		LastError string `json:"lastError,omitempty" db:"last_error"`
+
+		CreatedAt     time.Time `json:"createdAt" db:"created_at"`
+		NextAttemptAt time.Time `json:"nextAttemptAt" db:"next_attempt_at"`
+
+		// Set when execution is moved to the dead-letter list
+		FailedAt *time.Time `json:"failedAt,omitempty" db:"failed_at"`
+	}
+
+	ExecutionFilter struct {
+		ScriptID uint64 `json:"scriptID,string"`
+
+		// Dead-lettered executions instead of queued
+		DeadLetter bool `json:"deadLetter"`
+
+		// Standard paging fields & helpers
+		rh.PageFilter
+	}
+
+	// QueueExecutor executes queued executions
+	//
+	// Errors wrapped with Retryable are retried
+	QueueExecutor interface {
+		ExecuteQueued(ctx context.Context, script *Script, e *Execution) error
+	}
+
+	retryableError struct {
+		error
+	}
+)
+
+// Retryable marks error after which execution of the script can be retried
+// (corredor not available, timeout...)
+func Retryable(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return retryableError{err}
+}
+
+// IsRetryable checks if error was marked as retryable
+func IsRetryable(err error) bool {
+	_, ok := err.(retryableError)
+	return ok
+}
+
+// SetIdentity sets invoker of the execution
+func (e *Execution) SetIdentity(i auth.Identifiable) {
+	var rr = make([]string, len(i.Roles()))
+	for r, roleID := range i.Roles() {
+		rr[r] = strconv.FormatUint(roleID, 10)
+	}
+
+	e.UserID, e.UserRoles = i.Identity(), strings.Join(rr, " ")
+}
+
+// Identity returns invoker of the execution
+func (e Execution) Identity() auth.Identifiable {
+	var rr = make([]uint64, 0)
+	for _, r := range strings.Fields(e.UserRoles) {
+		if roleID, err := strconv.ParseUint(r, 10, 64); err == nil {
+			rr = append(rr, roleID)
+		}
+	}
+
+	return auth.NewIdentity(e.UserID, rr...)
+}
+
+// IsDeadLetter - execution failed permanently
+func (e Execution) IsDeadLetter() bool {
+	return e.FailedAt != nil
+}
@@ -0,0 +1,142 @@
+package automation
+
+import (
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+	"github.com/titpetric/factory"
+
+	"github.com/cortezaproject/corteza-server/pkg/rh"
+)
+
+type (
+	// repository serves as a db storage layer for queued script executions
+	queueRepository struct {
+		// sql table reference
+		dbTablePrefix string
+	}
+)
+
+func QueueRepository(dbTablePrefix string) *queueRepository {
+	return &queueRepository{
+		dbTablePrefix: dbTablePrefix,
+	}
+}
+
+func (r queueRepository) table() string {
+	return r.dbTablePrefix + "_automation_script_queue"
+}
+
+func (r queueRepository) columns() []string {
+	return []string{
+		"id",
+		"rel_script",
+		"resource",
+		"event",
+		"payload",
+		"rel_user",
+		"user_roles",
+		"attempts",
+		"last_error",
+		"created_at",
+		"next_attempt_at",
+		"failed_at",
+	}
+}
+
+func (r *queueRepository) query() squirrel.SelectBuilder {
+	return squirrel.
+		Select(r.columns()...).
+		From(r.table())
+}
+
+func (r *queueRepository) findByID(db *factory.DB, executionID uint64) (rval *Execution, err error) {
+	var (
+		query = r.query().
+			Where("id = ?", executionID)
+	)
+
+	rval = &Execution{}
+
+	if err = rh.IsFound(rh.FetchOne(db, query, rval), rval.ID > 0, errors.New("execution not found")); err != nil {
+		return nil, err
+	}
+
+	return rval, nil
+}
+
+// find returns queued or dead-lettered executions (oldest first)
+func (r *queueRepository) find(db *factory.DB, filter ExecutionFilter) (set ExecutionSet, f ExecutionFilter, err error) {
+	f = filter
+
+	query := r.query()
+
+	if f.ScriptID > 0 {
+		query = query.Where("rel_script = ?", f.ScriptID)
+	}
+
+	if f.DeadLetter {
+		query = query.Where("failed_at IS NOT NULL")
+	} else {
+		query = query.Where("failed_at IS NULL")
+	}
+
+	if f.Count, err = rh.Count(db, query); err != nil || f.Count == 0 {
+		return
+	}
+
+	query = query.OrderBy("created_at", "id")
+
+	return set, f, rh.FetchPaged(db, query, f.Page, f.PerPage, &set)
+}
+
+// findDue returns executions with due attempts
+func (r *queueRepository) findDue(db *factory.DB, now time.Time, limit uint64) (set ExecutionSet, err error) {
+	query := r.query().
+		Where("failed_at IS NULL").
+		Where(squirrel.LtOrEq{"next_attempt_at": now}).
+		OrderBy("next_attempt_at", "id").
+		Limit(limit)
+
+	return set, rh.FetchAll(db, query, &set)
+}
+
+// claim postpones next attempt of the execution
+//
+// Returns false when execution was already claimed (by another instance)
+func (r *queueRepository) claim(db *factory.DB, e *Execution, until time.Time) (bool, error) {
+	rsp, err := squirrel.ExecWith(db, squirrel.
+		Update(r.table()).
+		Set("next_attempt_at", until).
+		Where(squirrel.Eq{"id": e.ID, "next_attempt_at": e.NextAttemptAt, "failed_at": nil}))
+
+	if err != nil {
+		return false, err
+	}
+
+	if n, err := rsp.RowsAffected(); err != nil || n == 0 {
+		return false, err
+	}
+
+	e.NextAttemptAt = until
+	return true, nil
+}
+
+func (r *queueRepository) create(db *factory.DB, e *Execution) (err error) {
+	e.ID = factory.Sonyflake.NextID()
+	return rh.Insert(db, r.table(), e)
+}
+
+func (r *queueRepository) update(db *factory.DB, e *Execution) (err error) {
+	return rh.UpdateColumns(db, r.table(), rh.Set{
+		"attempts":        e.Attempts,
+		"last_error":      e.LastError,
+		"next_attempt_at": e.NextAttemptAt,
+		"failed_at":       e.FailedAt,
+	}, squirrel.Eq{"id": e.ID})
+}
+
+func (r *queueRepository) delete(db *factory.DB, executionID uint64) error {
+	return rh.Delete(db, r.table(), squirrel.Eq{"id": executionID})
+}
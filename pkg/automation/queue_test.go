@@ -0,0 +1,85 @@
+package automation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/auth"
+)
+
+func TestScript_Backoff(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       Script
+		attempt uint
+		want    time.Duration
+	}{
+		{"default", Script{}, 1, time.Second * 30},
+		{"doubled", Script{}, 3, time.Minute * 2},
+		{"script's own", Script{RetryBackoff: 500}, 2, time.Second},
+		{"capped", Script{}, 20, maxRetryBackoff},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.s.Backoff(time.Second*30, tt.attempt); got != tt.want {
+				t.Errorf("expecting %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestScript_Attempts(t *testing.T) {
+	if a := (Script{}).Attempts(5); a != 5 {
+		t.Errorf("expecting default attempts, got %d", a)
+	}
+
+	if a := (Script{MaxAttempts: 2}).Attempts(5); a != 2 {
+		t.Errorf("expecting script's attempts, got %d", a)
+	}
+
+	if a := (Script{}).Attempts(0); a != 1 {
+		t.Errorf("expecting at least one attempt, got %d", a)
+	}
+}
+
+func TestScript_RunTimeout(t *testing.T) {
+	if d := (Script{}).RunTimeout(time.Second); d != time.Second {
+		t.Errorf("expecting default timeout, got %v", d)
+	}
+
+	if d := (Script{Timeout: 1500}).RunTimeout(time.Second); d != time.Millisecond*1500 {
+		t.Errorf("expecting script's timeout, got %v", d)
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	var err = errors.New("unavailable")
+
+	if IsRetryable(err) {
+		t.Error("expecting error not to be retryable")
+	}
+
+	if !IsRetryable(Retryable(err)) {
+		t.Error("expecting error to be retryable")
+	}
+
+	if Retryable(nil) != nil {
+		t.Error("expecting nil error")
+	}
+}
+
+func TestExecution_Identity(t *testing.T) {
+	var e = &Execution{}
+
+	e.SetIdentity(auth.NewIdentity(42, 1, 2))
+	if e.UserID != 42 || e.UserRoles != "1 2" {
+		t.Errorf("unexpected invoker %d (%q)", e.UserID, e.UserRoles)
+	}
+
+	i := e.Identity()
+	if i.Identity() != 42 || len(i.Roles()) != 2 || i.Roles()[1] != 2 {
+		t.Errorf("unexpected identity %d (%v)", i.Identity(), i.Roles())
+	}
+}
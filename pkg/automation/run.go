@@ -0,0 +1,115 @@
+package automation
+
+import (
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/rh"
+)
+
+type (
+	// Run is a log entry of one script execution
+	Run struct {
+		ID uint64 `json:"runID,string" db:"id"`
+
+		ScriptID uint64 `json:"scriptID,string" db:"rel_script"`
+
+		// Trigger that caused the run; 0 for test runs
+		// and for scripts without a matching trigger
+		TriggerID uint64 `json:"triggerID,string,omitempty" db:"rel_trigger"`
+
+		Resource string `json:"resource" db:"resource"`
+		Event    string `json:"event" db:"event"`
+
+		// Record that script was running on (compose record scripts)
+		RecordID uint64 `json:"recordID,string,omitempty" db:"rel_record"`
+
+		// User that invoked the script (not the user script is running as)
+		UserID uint64 `json:"userID,string,omitempty" db:"rel_user"`
+
+		Outcome RunOutcome `json:"outcome" db:"outcome"`
+		Error   string     `json:"error,omitempty" db:"error"`
+
+		// Output of the script (truncated)
+		Output string `json:"output,omitempty" db:"output"`
+
+		StartedAt time.Time `json:"startedAt" db:"started_at"`
+
+		// Duration of the run in milliseconds
+		Duration uint64 `json:"duration" db:"duration"`
+	}
+
+	RunFilter struct {
+		ScriptID uint64     `json:"scriptID,string"`
+		RecordID uint64     `json:"recordID,string,omitempty"`
+		Outcome  RunOutcome `json:"outcome,omitempty"`
+
+		// Standard paging fields & helpers
+		rh.PageFilter
+	}
+
+	RunOutcome string
+)
+
+const (
+	RunOutcomeSuccess RunOutcome = "success"
+
+	// Script returned no value and stopped the operation
+	RunOutcomeAborted RunOutcome = "aborted"
+
+	// Script failed (or could not be run)
+	RunOutcomeError RunOutcome = "error"
+
+	// Default number of characters of script output that are kept
+	DefaultRunOutputLimit = 4096
+)
+
+// Start returns new run of the script for the trigger of the given event
+//
+// Trigger is looked up on the script's (runnable) triggers
+func (s *Script) Start(resource, event string) *Run {
+	var run = &Run{
+		ScriptID:  s.ID,
+		Resource:  resource,
+		Event:     event,
+		StartedAt: time.Now(),
+	}
+
+	for _, t := range s.triggers {
+		if t.Resource != resource {
+			continue
+		}
+
+		// Deferred runs are also made by interval triggers
+		if t.Event == event || (event == EVENT_TYPE_DEFERRED && t.IsInterval()) {
+			run.TriggerID, run.Event = t.ID, t.Event
+			break
+		}
+	}
+
+	return run
+}
+
+// Finish sets outcome (from the given error) and duration of the run
+func (r *Run) Finish(outcome RunOutcome, err error) {
+	r.Duration = uint64(time.Since(r.StartedAt) / time.Millisecond)
+	r.Outcome = outcome
+
+	if err != nil {
+		r.Outcome, r.Error = RunOutcomeError, err.Error()
+	}
+}
+
+// truncate shortens output and error message to the given number of characters
+func (r *Run) truncate(limit int) {
+	if limit <= 0 {
+		return
+	}
+
+	if rr := []rune(r.Output); len(rr) > limit {
+		r.Output = string(rr[:limit])
+	}
+
+	if rr := []rune(r.Error); len(rr) > limit {
+		r.Error = string(rr[:limit])
+	}
+}
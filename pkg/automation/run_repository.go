@@ -0,0 +1,88 @@
+package automation
+
+import (
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/titpetric/factory"
+
+	"github.com/cortezaproject/corteza-server/pkg/rh"
+)
+
+type (
+	// repository serves as a db storage layer for script run log
+	runRepository struct {
+		// sql table reference
+		dbTablePrefix string
+	}
+)
+
+func RunRepository(dbTablePrefix string) *runRepository {
+	return &runRepository{
+		dbTablePrefix: dbTablePrefix,
+	}
+}
+
+func (r runRepository) table() string {
+	return r.dbTablePrefix + "_automation_script_run"
+}
+
+func (r runRepository) columns() []string {
+	return []string{
+		"id",
+		"rel_script",
+		"rel_trigger",
+		"resource",
+		"event",
+		"rel_record",
+		"rel_user",
+		"outcome",
+		"error",
+		"output",
+		"started_at",
+		"duration",
+	}
+}
+
+func (r *runRepository) query() squirrel.SelectBuilder {
+	return squirrel.
+		Select(r.columns()...).
+		From(r.table())
+}
+
+// find returns runs (latest first) that match the filter
+func (r *runRepository) find(db *factory.DB, filter RunFilter) (set RunSet, f RunFilter, err error) {
+	f = filter
+
+	query := r.query()
+
+	if f.ScriptID > 0 {
+		query = query.Where("rel_script = ?", f.ScriptID)
+	}
+
+	if f.RecordID > 0 {
+		query = query.Where("rel_record = ?", f.RecordID)
+	}
+
+	if f.Outcome != "" {
+		query = query.Where("outcome = ?", f.Outcome)
+	}
+
+	if f.Count, err = rh.Count(db, query); err != nil || f.Count == 0 {
+		return
+	}
+
+	query = query.OrderBy("started_at DESC", "id DESC")
+
+	return set, f, rh.FetchPaged(db, query, f.Page, f.PerPage, &set)
+}
+
+func (r *runRepository) create(db *factory.DB, run *Run) (err error) {
+	run.ID = factory.Sonyflake.NextID()
+	return rh.Insert(db, r.table(), run)
+}
+
+// deleteStartedBefore removes runs that started before the given time
+func (r *runRepository) deleteStartedBefore(db *factory.DB, t time.Time) error {
+	return rh.Delete(db, r.table(), squirrel.Lt{"started_at": t})
+}
@@ -0,0 +1,88 @@
+package automation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestScript_Start(t *testing.T) {
+	var s = &Script{
+		ID: 1,
+		triggers: TriggerSet{
+			{ID: 10, Resource: "compose:record", Event: "beforeCreate"},
+			{ID: 11, Resource: "compose:record", Event: "afterUpdate"},
+			{ID: 12, Resource: "compose:record", Event: EVENT_TYPE_INTERVAL},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		resource  string
+		event     string
+		triggerID uint64
+		wantEvent string
+	}{
+		{"matching trigger", "compose:record", "afterUpdate", 11, "afterUpdate"},
+		{"other resource", "system:mail", "afterUpdate", 0, "afterUpdate"},
+		{"no trigger", "compose:record", "manual", 0, "manual"},
+		{"deferred by interval", "compose:record", EVENT_TYPE_DEFERRED, 12, EVENT_TYPE_INTERVAL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			run := s.Start(tt.resource, tt.event)
+
+			if run.ScriptID != s.ID {
+				t.Errorf("expecting script ID %d, got %d", s.ID, run.ScriptID)
+			}
+
+			if run.TriggerID != tt.triggerID {
+				t.Errorf("expecting trigger ID %d, got %d", tt.triggerID, run.TriggerID)
+			}
+
+			if run.Event != tt.wantEvent {
+				t.Errorf("expecting event %q, got %q", tt.wantEvent, run.Event)
+			}
+
+			if run.StartedAt.IsZero() {
+				t.Error("expecting start time to be set")
+			}
+		})
+	}
+}
+
+func TestRun_Finish(t *testing.T) {
+	var run = &Run{}
+
+	run.Finish(RunOutcomeAborted, nil)
+	if run.Outcome != RunOutcomeAborted || run.Error != "" {
+		t.Errorf("unexpected outcome %q (%q)", run.Outcome, run.Error)
+	}
+
+	run.Finish(RunOutcomeSuccess, errors.New("failed"))
+	if run.Outcome != RunOutcomeError || run.Error != "failed" {
+		t.Errorf("unexpected outcome %q (%q)", run.Outcome, run.Error)
+	}
+}
+
+func TestRun_truncate(t *testing.T) {
+	var run = &Run{
+		Output: strings.Repeat("č", 10),
+		Error:  "short",
+	}
+
+	run.truncate(0)
+	if run.Output != strings.Repeat("č", 10) {
+		t.Errorf("expecting output to be kept, got %q", run.Output)
+	}
+
+	run.truncate(6)
+	if run.Output != strings.Repeat("č", 6) {
+		t.Errorf("expecting output to be truncated, got %q", run.Output)
+	}
+
+	if run.Error != "short" {
+		t.Errorf("expecting error to be kept, got %q", run.Error)
+	}
+}
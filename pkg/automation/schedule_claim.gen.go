@@ -0,0 +1,67 @@
+package automation
+
+// 	Hello! This file is auto-generated.
+
+type (
+
+	// ScheduleClaimSet slice of ScheduleClaim
+	//
+	// This type is auto-generated.
+	ScheduleClaimSet []*ScheduleClaim
+)
+
+// Walk iterates through every slice item and calls w(ScheduleClaim) err
+//
+// This function is auto-generated.
+func (set ScheduleClaimSet) Walk(w func(*ScheduleClaim) error) (err error) {
+	for i := range set {
+		if err = w(set[i]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Filter iterates through every slice item, calls f(ScheduleClaim) (bool, err) and return filtered slice
+//
+// This function is auto-generated.
+func (set ScheduleClaimSet) Filter(f func(*ScheduleClaim) (bool, error)) (out ScheduleClaimSet, err error) {
+	var ok bool
+	out = ScheduleClaimSet{}
+	for i := range set {
+		if ok, err = f(set[i]); err != nil {
+			return
+		} else if ok {
+			out = append(out, set[i])
+		}
+	}
+
+	return
+}
+
+// FindByID finds items from slice by its ID property
+//
+// This function is auto-generated.
+func (set ScheduleClaimSet) FindByID(ID uint64) *ScheduleClaim {
+	for i := range set {
+		if set[i].ID == ID {
+			return set[i]
+		}
+	}
+
+	return nil
+}
+
+// IDs returns a slice of uint64s from all items in the set
+//
+// This function is auto-generated.
+func (set ScheduleClaimSet) IDs() (IDs []uint64) {
+	IDs = make([]uint64, len(set))
+
+	for i := range set {
+		IDs[i] = set[i].ID
+	}
+
+	return
+}
@@ -0,0 +1,57 @@
+package automation
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cortezaproject/corteza-server/pkg/rh"
+)
+
+type (
+	// ScheduleClaim is a claim of one scheduled (interval or deferred) run of the script
+	//
+	// All instances pick scheduled scripts every minute but only the instance
+	// that claims the run (script, minute & key are unique) executes it.
+	// Runs are executed at most once; claim of the instance that stopped
+	// before the run was finished is not taken over by other instances.
+	// Claims are kept for auditing
+	ScheduleClaim struct {
+		ID uint64 `json:"claimID,string" db:"id"`
+
+		ScriptID uint64 `json:"scriptID,string" db:"rel_script"`
+
+		// Minute the run was scheduled for
+		Slot time.Time `json:"slot" db:"slot"`
+
+		// Relative condition (see RelativeCondition.Key) of the run;
+		// empty for timestamps and intervals
+		Key string `json:"key,omitempty" db:"claim_key"`
+
+		// Instance (host & process) that claimed and executed the run
+		ClaimedBy string    `json:"claimedBy" db:"claimed_by"`
+		ClaimedAt time.Time `json:"claimedAt" db:"claimed_at"`
+	}
+
+	ScheduleClaimFilter struct {
+		ScriptID uint64 `json:"scriptID,string"`
+
+		// Standard paging fields & helpers
+		rh.PageFilter
+	}
+)
+
+// Key identifies relative condition in schedule claims
+func (c RelativeCondition) Key() string {
+	return fmt.Sprintf("%d:%s:%s", c.ModuleID, c.Field, c.offset)
+}
+
+// instanceID identifies this process in schedule claims
+func instanceID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+
+	return fmt.Sprintf("%s:%d", host, os.Getpid())
+}
@@ -0,0 +1,105 @@
+package automation
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/titpetric/factory"
+
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
+	"github.com/cortezaproject/corteza-server/pkg/rh"
+)
+
+type (
+	// scheduleClaimStorer stores claims of scheduled runs, see scheduleClaimRepository
+	scheduleClaimStorer interface {
+		find(db *factory.DB, filter ScheduleClaimFilter) (ScheduleClaimSet, ScheduleClaimFilter, error)
+		claim(db *factory.DB, c *ScheduleClaim) (bool, error)
+		deleteClaimedBefore(db *factory.DB, t time.Time) error
+	}
+
+	// repository serves as a db storage layer for claims of scheduled runs
+	scheduleClaimRepository struct {
+		// sql table reference
+		dbTablePrefix string
+	}
+)
+
+func ScheduleClaimRepository(dbTablePrefix string) *scheduleClaimRepository {
+	return &scheduleClaimRepository{
+		dbTablePrefix: dbTablePrefix,
+	}
+}
+
+func (r scheduleClaimRepository) table() string {
+	return r.dbTablePrefix + "_automation_schedule_claim"
+}
+
+func (r scheduleClaimRepository) columns() []string {
+	return []string{
+		"id",
+		"rel_script",
+		"slot",
+		"claim_key",
+		"claimed_by",
+		"claimed_at",
+	}
+}
+
+func (r *scheduleClaimRepository) query() squirrel.SelectBuilder {
+	return squirrel.
+		Select(r.columns()...).
+		From(r.table())
+}
+
+// find returns claims (latest first) that match the filter
+func (r *scheduleClaimRepository) find(db *factory.DB, filter ScheduleClaimFilter) (set ScheduleClaimSet, f ScheduleClaimFilter, err error) {
+	f = filter
+
+	query := r.query()
+
+	if f.ScriptID > 0 {
+		query = query.Where("rel_script = ?", f.ScriptID)
+	}
+
+	if f.Count, err = rh.Count(db, query); err != nil || f.Count == 0 {
+		return
+	}
+
+	query = query.OrderBy("slot DESC", "id DESC")
+
+	return set, f, rh.FetchPaged(db, query, f.Page, f.PerPage, &set)
+}
+
+// claim stores the claim unless the same run was already claimed (by another instance)
+//
+// Relies on unique index on script, slot & key; returns false when claim was not stored
+func (r *scheduleClaimRepository) claim(db *factory.DB, c *ScheduleClaim) (bool, error) {
+	c.ID = factory.Sonyflake.NextID()
+
+	var (
+		cols   = r.columns()
+		values = make([]string, len(cols))
+	)
+
+	for i := range cols {
+		values[i] = ":" + cols[i]
+	}
+
+	rsp, err := db.NamedExec(
+		dbx.DialectOf(db).InsertIgnoreQuery(r.table(), cols, "VALUES ("+strings.Join(values, ", ")+")"),
+		c,
+	)
+
+	if err != nil {
+		return false, err
+	}
+
+	n, err := rsp.RowsAffected()
+	return n > 0, err
+}
+
+func (r *scheduleClaimRepository) deleteClaimedBefore(db *factory.DB, t time.Time) error {
+	return rh.Delete(db, r.table(), squirrel.Lt{"claimed_at": t})
+}
@@ -15,6 +15,17 @@ type (
 		scriptID   uint64
 		timestamps []time.Time
 		intervals  []cronexpr.Expression
+
+		// conditions relative to date fields of records
+		relative []RelativeCondition
+	}
+
+	// script with relative condition and interval of date field values
+	// that are due this minute
+	relativePick struct {
+		scriptID  uint64
+		condition RelativeCondition
+		from, to  time.Time
 	}
 )
 
@@ -43,6 +54,15 @@ func buildScheduleList(runables ScriptSet) scheduledSet {
 				continue
 			}
 
+			if t.IsRelative() {
+				// Records are looked up when condition is picked
+				if c, err := t.RelativeCondition(); err == nil {
+					sch.relative = append(sch.relative, *c)
+				}
+
+				continue
+			}
+
 			if ts, err := time.Parse(time.RFC3339, t.Condition); err == nil {
 				ts = ts.Truncate(time.Minute)
 				if ts.Before(n) {
@@ -72,7 +92,7 @@ func buildScheduleList(runables ScriptSet) scheduledSet {
 
 		// If there is anything useful in the schedule,
 		// add it to the list
-		if len(sch.timestamps)+len(sch.intervals) > 0 {
+		if len(sch.timestamps)+len(sch.intervals)+len(sch.relative) > 0 {
 			set = append(set, sch)
 		}
 
@@ -88,8 +108,12 @@ func buildScheduleList(runables ScriptSet) scheduledSet {
 // Script is executed only once, even if
 // is scheduled multiple times,
 func (set scheduledSet) pick() []uint64 {
+	return set.pickAt(now())
+}
+
+// picks out all candidates that are scheduled in the given minute
+func (set scheduledSet) pickAt(n time.Time) []uint64 {
 	uu := []uint64{}
-	n := now()
 	thisMinute := n.Truncate(pickInterval)
 	for _, s := range set {
 		for _, t := range s.timestamps {
@@ -112,3 +136,21 @@ func (set scheduledSet) pick() []uint64 {
 
 	return uu
 }
+
+// scans scheduled set and picks out all relative conditions
+// with interval of date field values that are due in the given minute
+//
+// Records are not planned in advance; they are looked up (by the runner)
+// with their current values so changed records are re-planned
+func (set scheduledSet) pickRelative(n time.Time) []relativePick {
+	pp := []relativePick{}
+	thisMinute := n.Truncate(pickInterval)
+	for _, s := range set {
+		for _, c := range s.relative {
+			from, to := c.Window(thisMinute, pickInterval)
+			pp = append(pp, relativePick{scriptID: s.scriptID, condition: c, from: from, to: to})
+		}
+	}
+
+	return pp
+}
@@ -64,6 +64,20 @@ func TestScheduleBuilder(t *testing.T) {
 				schedule{scriptID: 2, intervals: ss2ii("0 0 * * * * *")},
 			},
 		},
+		{name: "relative",
+			ss: ScriptSet{
+				&Script{ID: 1, Enabled: true, triggers: TriggerSet{
+					&Trigger{Enabled: true, Event: EVENT_TYPE_DEFERRED, Condition: `{"moduleID":"42","field":"contractEnd","offset":"-72h"}`},
+					&Trigger{Enabled: true, Event: EVENT_TYPE_DEFERRED, Condition: `{"moduleID":"42"}`},
+				}},
+			},
+
+			sch: scheduledSet{
+				schedule{scriptID: 1, relative: []RelativeCondition{
+					{ModuleID: 42, Field: "contractEnd", Offset: "-72h", offset: -72 * time.Hour},
+				}},
+			},
+		},
 	}
 
 	n, _ := time.Parse(time.RFC3339, "2000-01-01T00:00:00+02:00")
@@ -129,3 +143,49 @@ func TestSchedulePicker(t *testing.T) {
 		})
 	}
 }
+
+func TestScheduleRelativePicker(t *testing.T) {
+	var (
+		sch = scheduledSet{
+			schedule{scriptID: 1, relative: []RelativeCondition{
+				{ModuleID: 42, Field: "contractEnd", offset: -72 * time.Hour},
+				{ModuleID: 42, Field: "meetingStart", offset: time.Hour},
+			}},
+			schedule{scriptID: 2, timestamps: ss2tt("2000-01-01T00:01:00+02:00")},
+		}
+
+		out = []relativePick{
+			{scriptID: 1, condition: sch[0].relative[0], from: ss2tt("2000-01-04T00:01:00+02:00")[0], to: ss2tt("2000-01-04T00:02:00+02:00")[0]},
+			{scriptID: 1, condition: sch[0].relative[1], from: ss2tt("1999-12-31T23:01:00+02:00")[0], to: ss2tt("1999-12-31T23:02:00+02:00")[0]},
+		}
+	)
+
+	n, _ := time.Parse(time.RFC3339, "2000-01-01T00:01:50+02:00")
+
+	picked := sch.pickRelative(n)
+	if len(picked) != len(out) {
+		t.Fatalf("Result do not match %v %v", picked, out)
+	}
+
+	for i, p := range picked {
+		if p.scriptID != out[i].scriptID || p.condition != out[i].condition || !p.from.Equal(out[i].from) || !p.to.Equal(out[i].to) {
+			t.Errorf("Result do not match %v %v", p, out[i])
+		}
+	}
+}
+
+func TestRelativeCondition_Key(t *testing.T) {
+	c, err := ParseRelativeCondition(`{"moduleID":"42","field":"contractEnd","offset":"-72h"}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Key() != "42:contractEnd:-72h0m0s" {
+		t.Errorf("Unexpected key %q", c.Key())
+	}
+
+	// Same offset, differently written, claims the same run
+	if d, _ := ParseRelativeCondition(`{"moduleID":"42","field":"contractEnd","offset":"-4320m"}`); d.Key() != c.Key() {
+		t.Errorf("Keys do not match %q %q", d.Key(), c.Key())
+	}
+}
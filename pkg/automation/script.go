@@ -42,6 +42,14 @@ type (
 		// Is it critical to run this script successfully?
 		Critical bool `json:"critical" db:"critical"`
 
+		// How many times is queued (async, after-trigger) execution of the script
+		// attempted when corredor is not available; 0 for the default
+		MaxAttempts uint `json:"maxAttempts" db:"max_attempts"`
+
+		// Delay before the first retry (in milliseconds), doubled on
+		// each next retry; 0 for the default
+		RetryBackoff uint `json:"retryBackoff" db:"retry_backoff"`
+
 		Enabled bool `json:"enabled" db:"enabled"`
 
 		CreatedAt time.Time  `db:"created_at" json:"createdAt"`
@@ -99,6 +107,11 @@ const (
 	STMS_REPLACE
 )
 
+const (
+	// Longest delay between attempts of queued execution
+	maxRetryBackoff = time.Hour * 6
+)
+
 var (
 	ErrAutomationScriptInvalid     = errors.New("AutomationScriptInvalid")
 	ErrAutomationScriptMissingUser = errors.New("AutomationScriptMissingUser")
@@ -181,6 +194,49 @@ func (s Script) RunAsInvoker() bool {
 	return s.RunAs == 0
 }
 
+// RunTimeout returns script's timeout or the given default when timeout is not set
+func (s Script) RunTimeout(def time.Duration) time.Duration {
+	if s.Timeout > 0 {
+		return time.Duration(s.Timeout) * time.Millisecond
+	}
+
+	return def
+}
+
+// Attempts returns max number of attempts of queued execution or the given default
+func (s Script) Attempts(def uint) uint {
+	if s.MaxAttempts > 0 {
+		return s.MaxAttempts
+	}
+
+	if def > 0 {
+		return def
+	}
+
+	return 1
+}
+
+// Backoff returns delay before the next attempt of the queued execution
+//
+// Base delay (script's RetryBackoff or the given default) is doubled after
+// each failed attempt
+func (s Script) Backoff(def time.Duration, attempt uint) time.Duration {
+	var d = def
+	if s.RetryBackoff > 0 {
+		d = time.Duration(s.RetryBackoff) * time.Millisecond
+	}
+
+	for i := uint(1); i < attempt && d < maxRetryBackoff; i++ {
+		d *= 2
+	}
+
+	if d > maxRetryBackoff {
+		return maxRetryBackoff
+	}
+
+	return d
+}
+
 // AddTrigger appends one or more triggers to internal list of triggers on script struct
 //
 // We do not do any compatibility check (See Script.CheckCompatibility());
@@ -40,6 +40,8 @@ func (r scriptRepository) columns() []string {
 		"run_in_ua",
 		"timeout",
 		"critical",
+		"max_attempts",
+		"retry_backoff",
 		"enabled",
 		"created_at",
 		"created_by",
@@ -129,7 +131,7 @@ func (r *scriptRepository) findRunnable(db *factory.DB) (ScriptSet, error) {
 
 func (r *scriptRepository) create(db *factory.DB, s *Script) (err error) {
 	s.ID = factory.Sonyflake.NextID()
-	return db.Insert(r.table(), s)
+	return rh.Insert(db, r.table(), s)
 }
 
 func (r *scriptRepository) update(db *factory.DB, s *Script) (err error) {
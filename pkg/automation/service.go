@@ -36,6 +36,9 @@ type (
 
 		srepo *scriptRepository
 		trepo *triggerRepository
+		rrepo *runRepository
+		qrepo *queueRepository
+		crepo scheduleClaimStorer
 
 		db *factory.DB
 	}
@@ -46,6 +49,9 @@ type (
 
 	DeferredAutomationRunner interface {
 		RecordDeferred(ctx context.Context, script *Script, ns *types.Namespace, m *types.Module, r *types.Record) (err error)
+
+		// Runs script for all records with date field values (see RelativeCondition) in the given interval
+		RecordDeferredRelative(ctx context.Context, script *Script, c RelativeCondition, from, to time.Time) (err error)
 	}
 
 	TokenMaker func(context.Context, uint64) (string, error)
@@ -59,11 +65,34 @@ type (
 		DB            *factory.DB
 		TokenMaker    TokenMaker
 		DbTablePrefix string
+
+		// Script runs older than this are removed from the run log (0 keeps all runs)
+		RunLogRetention time.Duration
+
+		// Number of characters of script output and error that are logged
+		RunLogOutputLimit int
+
+		// How often are queued executions checked
+		QueueInterval time.Duration
+
+		// Max attempts and base retry delay for scripts without their own settings
+		RetryMaxAttempts uint
+		RetryBackoff     time.Duration
+
+		// Identifies this instance in claims of scheduled runs (defaults to host & process ID)
+		InstanceID string
 	}
 )
 
 const (
 	watchInterval = time.Hour
+
+	// Max number of queued executions that are picked at once
+	queueBatchSize = 50
+
+	// Claimed execution is picked again after this time (when
+	// instance that claimed it did not finish it)
+	queueClaimTimeout = time.Minute * 10
 )
 
 // Service initializes service{} struct
@@ -82,12 +111,19 @@ func Service(c AutomationServiceConfig) (svc *service) {
 
 		srepo: ScriptRepository(c.DbTablePrefix),
 		trepo: TriggerRepository(c.DbTablePrefix),
+		rrepo: RunRepository(c.DbTablePrefix),
+		qrepo: QueueRepository(c.DbTablePrefix),
+		crepo: ScheduleClaimRepository(c.DbTablePrefix),
 
 		db: c.DB,
 
 		f: make(chan bool, 64),
 	}
 
+	if svc.c.InstanceID == "" {
+		svc.c.InstanceID = instanceID()
+	}
+
 	// Reload ASAP
 	svc.Reload()
 	return
@@ -101,12 +137,16 @@ func (svc *service) Watch(ctx context.Context) {
 
 		var ticker = time.NewTicker(watchInterval)
 		defer ticker.Stop()
+
+		svc.cleanupRuns(ctx)
+
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case <-ticker.C:
 				svc.reload(ctx)
+				svc.cleanupRuns(ctx)
 			case <-svc.f:
 				for len(svc.f) > 0 {
 					// Drain just before we reload
@@ -121,16 +161,35 @@ func (svc *service) Watch(ctx context.Context) {
 }
 
 // RunScheduled runs scheduled scripts periodically
+//
+// Every instance picks scheduled scripts but only the one that
+// claims the run executes it (see claimScheduled)
 func (svc *service) WatchScheduled(ctx context.Context, r DeferredAutomationRunner) {
 	go func() {
-		var ticker = time.NewTicker(time.Minute)
+		defer sentry.Recover()
+
+		var (
+			ticker  = time.NewTicker(time.Minute)
+			cleanup = time.NewTicker(watchInterval)
+		)
+
 		defer ticker.Stop()
+		defer cleanup.Stop()
+
 		for {
 			select {
 			case <-ctx.Done():
 				return
+			case <-cleanup.C:
+				svc.cleanupScheduleClaims(ctx)
 			case <-ticker.C:
-				for _, scriptID := range svc.scheduled.pick() {
+				slot := now().Truncate(pickInterval)
+
+				for _, scriptID := range svc.scheduled.pickAt(slot) {
+					if !svc.claimScheduled(ctx, scriptID, slot, "") {
+						continue
+					}
+
 					// @todo parallelize this
 					svc.logger.Debug(
 						"Running scheduled script",
@@ -150,6 +209,27 @@ func (svc *service) WatchScheduled(ctx context.Context, r DeferredAutomationRunn
 						)
 					}
 				}
+
+				for _, p := range svc.scheduled.pickRelative(slot) {
+					if !svc.claimScheduled(ctx, p.scriptID, slot, p.condition.Key()) {
+						continue
+					}
+
+					svc.logger.Debug(
+						"Running scheduled script for records",
+						zap.Uint64("scriptID", p.scriptID),
+						zap.Uint64("moduleID", p.condition.ModuleID),
+						zap.String("field", p.condition.Field),
+					)
+					err := r.RecordDeferredRelative(ctx, svc.runnables.FindByID(p.scriptID), p.condition, p.from, p.to)
+					if err != nil {
+						svc.logger.Error(
+							"Script failed to run",
+							zap.Uint64("scriptID", p.scriptID),
+							zap.Error(err),
+						)
+					}
+				}
 			}
 		}
 	}()
@@ -157,6 +237,136 @@ func (svc *service) WatchScheduled(ctx context.Context, r DeferredAutomationRunn
 	svc.logger.Debug("scheduled runner initialized")
 }
 
+// claimScheduled claims scheduled run of the script for this instance
+//
+// Returns false when run was claimed by another instance (or when claim could not be
+// stored); unclaimed runs are skipped so that they are not executed more than once.
+//
+// Scheduled runs are executed at most once: claim is never released or taken over,
+// so a run is lost when the instance that claimed it stops before (or while) running
+// the script. Taking the run over could execute (part of) the script twice
+func (svc *service) claimScheduled(ctx context.Context, scriptID uint64, slot time.Time, key string) bool {
+	var c = &ScheduleClaim{
+		ScriptID:  scriptID,
+		Slot:      slot.UTC(),
+		Key:       key,
+		ClaimedBy: svc.c.InstanceID,
+		ClaimedAt: time.Now(),
+	}
+
+	ok, err := svc.crepo.claim(svc.db.With(ctx), c)
+	if err != nil {
+		svc.logger.Error("could not claim scheduled run", zap.Uint64("scriptID", scriptID), zap.Error(err))
+		return false
+	}
+
+	if !ok {
+		svc.logger.Debug("scheduled run claimed by another instance", zap.Uint64("scriptID", scriptID))
+	}
+
+	return ok
+}
+
+// WatchQueue periodically executes queued executions with due attempts
+func (svc *service) WatchQueue(ctx context.Context, x QueueExecutor) {
+	if svc.c.QueueInterval <= 0 {
+		svc.logger.Debug("queue runner disabled")
+		return
+	}
+
+	go func() {
+		defer sentry.Recover()
+
+		var ticker = time.NewTicker(svc.c.QueueInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				svc.processQueue(ctx, x)
+			}
+		}
+	}()
+
+	svc.logger.Debug("queue runner initialized")
+}
+
+// processQueue executes queued executions with due attempts
+//
+// Executions are claimed before they are executed so that
+// other instances do not execute them at the same time
+func (svc *service) processQueue(ctx context.Context, x QueueExecutor) {
+	var (
+		db      = svc.db.With(ctx)
+		ee, err = svc.qrepo.findDue(db, time.Now(), queueBatchSize)
+	)
+
+	if err != nil {
+		svc.logger.Error("could not load queued executions", zap.Error(err))
+		return
+	}
+
+	for _, e := range ee {
+		if ok, err := svc.qrepo.claim(db, e, time.Now().Add(queueClaimTimeout)); err != nil {
+			svc.logger.Error("could not claim queued execution", zap.Uint64("executionID", e.ID), zap.Error(err))
+			continue
+		} else if !ok {
+			continue
+		}
+
+		svc.execute(ctx, x, e)
+	}
+}
+
+// execute executes queued execution and removes it from the queue on success
+//
+// Failed execution is retried (after backoff delay) when error is retryable and
+// script's max attempts are not reached; otherwise it is moved to the dead-letter list
+func (svc *service) execute(ctx context.Context, x QueueExecutor, e *Execution) {
+	var (
+		db  = svc.db.With(ctx)
+		log = svc.logger.With(zap.Uint64("executionID", e.ID), zap.Uint64("scriptID", e.ScriptID))
+		err error
+		now time.Time
+	)
+
+	svc.l.Lock()
+	script := svc.runnables.FindByID(e.ScriptID)
+	svc.l.Unlock()
+
+	if script == nil {
+		err = errors.New("script is not runnable")
+	} else {
+		err = x.ExecuteQueued(ctx, script, e)
+	}
+
+	if err == nil {
+		if err = svc.qrepo.delete(db, e.ID); err != nil {
+			log.Error("could not remove execution from queue", zap.Error(err))
+		}
+
+		return
+	}
+
+	now = time.Now()
+	e.Attempts++
+	e.LastError = err.Error()
+
+	if IsRetryable(err) && e.Attempts < script.Attempts(svc.c.RetryMaxAttempts) {
+		e.NextAttemptAt = now.Add(script.Backoff(svc.c.RetryBackoff, e.Attempts))
+		log.Info("queued execution failed, retrying", zap.Uint("attempts", e.Attempts), zap.Error(err))
+	} else {
+		e.FailedAt = &now
+		log.Warn("queued execution failed, moved to dead-letter list", zap.Uint("attempts", e.Attempts), zap.Error(err))
+	}
+
+	if err = svc.qrepo.update(db, e); err != nil {
+		log.Error("could not update queued execution", zap.Error(err))
+	}
+}
+
 func (svc *service) Reload() {
 	go func() {
 		select {
@@ -249,6 +459,91 @@ func (svc service) FindRunnableScripts(resource, event string, cc ...TriggerCond
 	)
 }
 
+// LogRun stores run of the script into the run log
+//
+// Output and error message are truncated
+func (svc service) LogRun(ctx context.Context, run *Run) error {
+	run.truncate(svc.c.RunLogOutputLimit)
+	return errors.Wrap(svc.rrepo.create(svc.db.With(ctx), run), "could not log script run")
+}
+
+// FindRuns returns logged runs of the script
+func (svc service) FindRuns(ctx context.Context, f RunFilter) (RunSet, RunFilter, error) {
+	return svc.rrepo.find(svc.db.With(ctx), f)
+}
+
+// Enqueue adds script execution to the queue
+//
+// Execution is written through the given handle (so it can be a part of the caller's
+// transaction) or service's own connection when no handle is given. It is attempted
+// on the next queue check
+func (svc service) Enqueue(ctx context.Context, db *factory.DB, e *Execution) error {
+	e.CreatedAt = time.Now()
+	e.NextAttemptAt = e.CreatedAt
+	e.Attempts, e.FailedAt = 0, nil
+
+	if db == nil {
+		db = svc.db
+	}
+
+	return errors.Wrap(svc.qrepo.create(db.With(ctx), e), "could not queue script execution")
+}
+
+func (svc service) FindExecutionByID(ctx context.Context, executionID uint64) (*Execution, error) {
+	return svc.qrepo.findByID(svc.db.With(ctx), executionID)
+}
+
+// FindExecutions returns queued or dead-lettered executions
+func (svc service) FindExecutions(ctx context.Context, f ExecutionFilter) (ExecutionSet, ExecutionFilter, error) {
+	return svc.qrepo.find(svc.db.With(ctx), f)
+}
+
+// RequeueExecution moves dead-lettered execution back to the queue
+//
+// Attempts are reset; last error is kept until the next attempt
+func (svc service) RequeueExecution(ctx context.Context, e *Execution) error {
+	if !e.IsDeadLetter() {
+		return errors.New("execution is not dead-lettered")
+	}
+
+	e.Attempts, e.FailedAt, e.NextAttemptAt = 0, nil, time.Now()
+	return svc.qrepo.update(svc.db.With(ctx), e)
+}
+
+// DeleteExecution removes execution from queue or dead-letter list
+func (svc service) DeleteExecution(ctx context.Context, e *Execution) error {
+	return svc.qrepo.delete(svc.db.With(ctx), e.ID)
+}
+
+// FindScheduleClaims returns claims of scheduled runs
+func (svc service) FindScheduleClaims(ctx context.Context, f ScheduleClaimFilter) (ScheduleClaimSet, ScheduleClaimFilter, error) {
+	return svc.crepo.find(svc.db.With(ctx), f)
+}
+
+// cleanupScheduleClaims removes claims older than (run log) retention period
+func (svc service) cleanupScheduleClaims(ctx context.Context) {
+	if svc.c.RunLogRetention <= 0 {
+		return
+	}
+
+	err := svc.crepo.deleteClaimedBefore(svc.db.With(ctx), time.Now().Add(-svc.c.RunLogRetention))
+	if err != nil {
+		svc.logger.Error("could not remove old schedule claims", zap.Error(err))
+	}
+}
+
+// cleanupRuns removes runs older than retention period
+func (svc service) cleanupRuns(ctx context.Context) {
+	if svc.c.RunLogRetention <= 0 {
+		return
+	}
+
+	err := svc.rrepo.deleteStartedBefore(svc.db.With(ctx), time.Now().Add(-svc.c.RunLogRetention))
+	if err != nil {
+		svc.logger.Error("could not remove old script runs", zap.Error(err))
+	}
+}
+
 func (svc service) FindScriptByID(ctx context.Context, scriptID uint64) (*Script, error) {
 	return svc.srepo.findByID(svc.db.With(ctx), scriptID)
 }
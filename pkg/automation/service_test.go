@@ -0,0 +1,108 @@
+package automation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/titpetric/factory"
+	"go.uber.org/zap"
+)
+
+type (
+	// testScheduleClaims mimics unique index on script, slot & key
+	testScheduleClaims struct {
+		l  sync.Mutex
+		cc map[string]*ScheduleClaim
+	}
+)
+
+func (r *testScheduleClaims) find(*factory.DB, ScheduleClaimFilter) (ScheduleClaimSet, ScheduleClaimFilter, error) {
+	return nil, ScheduleClaimFilter{}, nil
+}
+
+func (r *testScheduleClaims) claim(_ *factory.DB, c *ScheduleClaim) (bool, error) {
+	r.l.Lock()
+	defer r.l.Unlock()
+
+	var k = fmt.Sprintf("%d/%d/%s", c.ScriptID, c.Slot.Unix(), c.Key)
+	if _, ok := r.cc[k]; ok {
+		return false, nil
+	}
+
+	r.cc[k] = c
+	return true, nil
+}
+
+func (r *testScheduleClaims) deleteClaimedBefore(*factory.DB, time.Time) error {
+	return nil
+}
+
+func TestService_claimScheduled(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		claims = &testScheduleClaims{cc: map[string]*ScheduleClaim{}}
+		slot   = time.Date(2019, 11, 29, 10, 0, 0, 0, time.UTC)
+
+		instance = func(ID string) *service {
+			return &service{
+				logger: zap.NewNop(),
+				c:      AutomationServiceConfig{InstanceID: ID},
+				crepo:  claims,
+				db:     &factory.DB{},
+			}
+		}
+
+		wins int32
+		wg   sync.WaitGroup
+	)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(svc *service) {
+			defer wg.Done()
+
+			// Same run (slot in another timezone)
+			if svc.claimScheduled(ctx, 1, slot.In(time.FixedZone("CET", 3600)), "") {
+				atomic.AddInt32(&wins, 1)
+			}
+		}(instance(fmt.Sprintf("instance-%d", i)))
+	}
+
+	wg.Wait()
+
+	if wins != 1 {
+		t.Fatalf("expecting exactly one instance to claim the run, got %d", wins)
+	}
+
+	if len(claims.cc) != 1 {
+		t.Fatalf("expecting one stored claim, got %d", len(claims.cc))
+	}
+
+	for _, c := range claims.cc {
+		if c.ClaimedBy == "" || !c.Slot.Equal(slot) || c.Slot.Location() != time.UTC {
+			t.Errorf("unexpected claim %+v", c)
+		}
+	}
+
+	var svc = instance("other")
+
+	if svc.claimScheduled(ctx, 1, slot, "") {
+		t.Error("expecting claimed run not to be claimed again")
+	}
+
+	if !svc.claimScheduled(ctx, 1, slot, "1:due:-1h0m0s") {
+		t.Error("expecting run with another key to be claimed")
+	}
+
+	if !svc.claimScheduled(ctx, 1, slot.Add(time.Minute), "") {
+		t.Error("expecting run in another slot to be claimed")
+	}
+
+	if !svc.claimScheduled(ctx, 2, slot, "") {
+		t.Error("expecting run of another script to be claimed")
+	}
+}
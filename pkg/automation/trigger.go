@@ -1,8 +1,10 @@
 package automation
 
 import (
+	"encoding/json"
 	"errors"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cortezaproject/corteza-server/pkg/rh"
@@ -33,6 +35,12 @@ type (
 		// It is caller's responsibility to encode, decode and verify conditions
 		Condition string `json:"condition" db:"event_condition"`
 
+		// Additional condition (ql expression) that is checked before the script is run
+		//
+		// It is caller's responsibility to evaluate the expression
+		// (for records, see compose's automation runner)
+		Expression string `json:"expression,omitempty" db:"expression"`
+
 		ScriptID uint64 `json:"scriptID,string" db:"rel_script"`
 
 		// Is trigger enabled or disabled?
@@ -61,6 +69,21 @@ type (
 	}
 
 	TriggerConditionChecker func(string) bool
+
+	// RelativeCondition is a condition of deferred trigger, relative to a date field of records
+	//
+	// Script is run for each record of the module, at the time in the date field
+	// shifted by the offset ("-72h" is 3 days before, "1h" is one hour after).
+	//
+	// Encoded (as trigger condition) in JSON:
+	//   {"moduleID":"42","field":"contractEnd","offset":"-72h"}
+	RelativeCondition struct {
+		ModuleID uint64 `json:"moduleID,string"`
+		Field    string `json:"field"`
+		Offset   string `json:"offset,omitempty"`
+
+		offset time.Duration
+	}
 )
 
 const (
@@ -97,6 +120,54 @@ func (t Trigger) Uint64Condition() (o uint64) {
 	return
 }
 
+// IsRelative checks if deferred trigger is relative to a date field of records
+func (t Trigger) IsRelative() bool {
+	return t.IsDeferred() && strings.HasPrefix(strings.TrimSpace(t.Condition), "{")
+}
+
+// RelativeCondition decodes condition of the deferred trigger that is relative
+// to a date field of records
+func (t Trigger) RelativeCondition() (*RelativeCondition, error) {
+	if !t.IsRelative() {
+		return nil, errors.New("trigger is not relative to a date field")
+	}
+
+	return ParseRelativeCondition(t.Condition)
+}
+
+// ParseRelativeCondition decodes and verifies JSON encoded relative condition
+func ParseRelativeCondition(condition string) (c *RelativeCondition, err error) {
+	c = &RelativeCondition{}
+
+	if err = json.Unmarshal([]byte(condition), c); err != nil {
+		return nil, err
+	}
+
+	if c.ModuleID == 0 {
+		return nil, errors.New("relative condition without module")
+	}
+
+	if c.Field == "" {
+		return nil, errors.New("relative condition without field")
+	}
+
+	if c.Offset != "" {
+		if c.offset, err = time.ParseDuration(c.Offset); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// Window returns interval of date field values that are due at the given time
+//
+// Values from (inclusive) and to (exclusive) are shifted by the (inverted) offset
+func (c RelativeCondition) Window(at time.Time, d time.Duration) (from, to time.Time) {
+	from = at.Add(-c.offset)
+	return from, from.Add(d)
+}
+
 // HasMatch checks if any og the triggers in a set matches the given parameters
 func (set TriggerSet) HasMatch(m Trigger, ff ...TriggerConditionChecker) bool {
 withTriggers:
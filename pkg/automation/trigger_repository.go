@@ -32,6 +32,7 @@ func (r triggerRepository) columns() []string {
 		"resource",
 		"event",
 		"event_condition",
+		"expression",
 		"rel_script",
 		"enabled",
 		"created_at",
@@ -113,7 +114,7 @@ func (r *triggerRepository) replace(db *factory.DB, t *Trigger) (err error) {
 		t.ID = dup.ID
 	}
 
-	return db.Replace(r.table(), t)
+	return rh.Upsert(db, r.table(), t, "id")
 }
 
 func (r *triggerRepository) deleteByScriptID(db *factory.DB, scriptID uint64) (err error) {
@@ -136,11 +137,18 @@ func (r *triggerRepository) checkDuplicate(db *factory.DB, t *Trigger) (*Trigger
 		IncDeleted: false,
 	})
 
-	if err != nil || len(tt) == 0 {
+	if err != nil {
 		return nil, err
 	}
 
-	return tt[0], nil
+	// Triggers with different expressions are not duplicates
+	for _, dup := range tt {
+		if dup.Expression == t.Expression {
+			return dup, nil
+		}
+	}
+
+	return nil, nil
 }
 
 func (r *triggerRepository) mergeSet(db *factory.DB, tms triggersMergeStrategy, scriptID uint64, tt TriggerSet) error {
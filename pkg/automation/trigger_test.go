@@ -2,6 +2,7 @@ package automation
 
 import (
 	"testing"
+	"time"
 )
 
 func TestTriggerSet_HasMatch(t *testing.T) {
@@ -44,3 +45,42 @@ func TestTriggerSet_HasMatch(t *testing.T) {
 		})
 	}
 }
+
+func TestTrigger_RelativeCondition(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition string
+		offset    time.Duration
+		wantErr   bool
+	}{
+		{name: "before", condition: `{"moduleID":"42","field":"contractEnd","offset":"-72h"}`, offset: -72 * time.Hour},
+		{name: "after", condition: `{"moduleID":"42","field":"meetingStart","offset":"1h"}`, offset: time.Hour},
+		{name: "no offset", condition: `{"moduleID":"42","field":"meetingStart"}`},
+		{name: "no module", condition: `{"field":"meetingStart"}`, wantErr: true},
+		{name: "no field", condition: `{"moduleID":"42"}`, wantErr: true},
+		{name: "invalid offset", condition: `{"moduleID":"42","field":"meetingStart","offset":"3 days"}`, wantErr: true},
+		{name: "invalid json", condition: `{"moduleID":42`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trigger := Trigger{Event: EVENT_TYPE_DEFERRED, Condition: tt.condition}
+			if !trigger.IsRelative() {
+				t.Fatalf("expecting relative trigger")
+			}
+
+			c, err := trigger.RelativeCondition()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RelativeCondition() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err == nil && c.offset != tt.offset {
+				t.Errorf("RelativeCondition() offset = %v, want %v", c.offset, tt.offset)
+			}
+		})
+	}
+
+	if (Trigger{Event: EVENT_TYPE_DEFERRED, Condition: "2000-01-01T00:02:00+02:00"}).IsRelative() {
+		t.Errorf("timestamp condition should not be relative")
+	}
+}
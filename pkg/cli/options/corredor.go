@@ -20,6 +20,23 @@ type (
 		ApiBaseURLSystem    string `env:"CORREDOR_API_BASE_URL_SYSTEM"`
 		ApiBaseURLMessaging string `env:"CORREDOR_API_BASE_URL_MESSAGING"`
 		ApiBaseURLCompose   string `env:"CORREDOR_API_BASE_URL_COMPOSE"`
+
+		// Timeout of scripts without their own timeout
+		DefaultTimeout time.Duration `env:"CORREDOR_DEFAULT_TIMEOUT"`
+
+		// How often are queued (async, after-trigger) script executions checked
+		QueueInterval time.Duration `env:"CORREDOR_QUEUE_INTERVAL"`
+
+		// Max attempts and base retry delay of queued executions
+		// for scripts without their own settings
+		RetryMaxAttempts int           `env:"CORREDOR_RETRY_MAX_ATTEMPTS"`
+		RetryBackoff     time.Duration `env:"CORREDOR_RETRY_BACKOFF"`
+
+		// Script runs older than this are removed from the run log
+		RunLogRetention time.Duration `env:"CORREDOR_RUN_LOG_RETENTION"`
+
+		// Script output (and error) is truncated to this number of characters
+		RunLogOutputLimit int `env:"CORREDOR_RUN_LOG_OUTPUT_LIMIT"`
 	}
 )
 
@@ -29,6 +46,14 @@ func Corredor(pfix string) (o *CorredorOpt) {
 		Addr:            "corredor:80",
 		MaxBackoffDelay: time.Minute,
 		Log:             false,
+
+		DefaultTimeout:   time.Second * 5,
+		QueueInterval:    time.Second * 5,
+		RetryMaxAttempts: 5,
+		RetryBackoff:     time.Second * 30,
+
+		RunLogRetention:   time.Hour * 24 * 30,
+		RunLogOutputLimit: 4096,
 	}
 
 	fill(o, pfix)
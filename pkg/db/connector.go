@@ -2,9 +2,13 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"regexp"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 	"github.com/titpetric/factory"
 	"github.com/titpetric/factory/logger"
@@ -16,20 +20,110 @@ import (
 
 var (
 	dsnMasker = regexp.MustCompile("(.)(?:.*)(.):(.)(?:.*)(.)@")
+
+	// Guards connections, connectionDSNs and connectionDialects; services
+	// connect (and use their connections) concurrently
+	connectionsLock sync.RWMutex
+
+	// Connections that can not be handled by factory (non-MySQL)
+	connections = map[string]*factory.DB{}
+
+	// DSNs of all named connections, see SameDatabase
+	connectionDSNs = map[string]string{}
 )
 
+// ParseDSN returns dialect and driver-ready DSN
+//
+// DSNs with postgres:// or postgresql:// scheme select PostgreSQL,
+// everything else (optionally prefixed with mysql://) is handled as MySQL DSN.
+func ParseDSN(dsn string) (dialect string, _ string) {
+	switch {
+	case strings.HasPrefix(dsn, "postgres://"), strings.HasPrefix(dsn, "postgresql://"):
+		return DialectPostgres, dsn
+	case strings.HasPrefix(dsn, "mysql://"):
+		return DialectMySQL, dsn[len("mysql://"):]
+	default:
+		return DialectMySQL, dsn
+	}
+}
+
+// Get returns named connection that was established with TryToConnect
+func Get(name string) (*factory.DB, error) {
+	connectionsLock.RLock()
+	db, ok := connections[name]
+	connectionsLock.RUnlock()
+
+	if ok {
+		return db, nil
+	}
+
+	return factory.Database.Get(name)
+}
+
+// MustGet is the same as Get, except it will panic on connection error
+func MustGet(name string) *factory.DB {
+	db, err := Get(name)
+	if err != nil {
+		panic(err)
+	}
+
+	return db
+}
+
+// SameDatabase checks if both named connections were established with the same DSN
+//
+// Tables of both connections can then be used in the same query
+func SameDatabase(a, b string) bool {
+	connectionsLock.RLock()
+	defer connectionsLock.RUnlock()
+
+	dsnA, okA := connectionDSNs[a]
+	dsnB, okB := connectionDSNs[b]
+	return okA && okB && dsnA == dsnB
+}
+
+func connect(name, dialect, dsn string) (*factory.DB, error) {
+	if dialect != DialectPostgres {
+		return factory.Database.Get(name)
+	}
+
+	connectionsLock.Lock()
+	defer connectionsLock.Unlock()
+
+	if db, ok := connections[name]; ok {
+		return db, nil
+	}
+
+	handle, err := sqlx.Connect(postgresDriver, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	connections[name] = (&factory.DB{DB: handle, TxOpts: &sql.TxOptions{}}).With(context.Background())
+	return connections[name], nil
+}
+
 func TryToConnect(ctx context.Context, log *zap.Logger, name string, opt options.DBOpt) (db *factory.DB, err error) {
-	factory.Database.Add(name, opt.DSN)
+	dialect, dsn := ParseDSN(opt.DSN)
+
+	if dialect == DialectMySQL {
+		factory.Database.Add(name, dsn)
+	}
+
+	connectionsLock.Lock()
+	connectionDialects[name] = dialects[dialect]
+	connectionDSNs[name] = opt.DSN
+	connectionsLock.Unlock()
 
 	var (
 		connErrCh = make(chan error, 1)
 	)
 
 	// We'll not add this to the general log because we do not want to carry it with us for every query.
-	dsnField := zap.String("dsn", dsnMasker.ReplaceAllString(opt.DSN, "$1****$2:$3****$4@"))
+	dsnField := zap.String("dsn", dsnMasker.ReplaceAllString(dsn, "$1****$2:$3****$4@"))
 
 	// This logger is also used inside profiler
-	log = log.Named("database").With(zap.String("name", name))
+	log = log.Named("database").With(zap.String("name", name), zap.String("dialect", dialect))
 
 	defer close(connErrCh)
 
@@ -54,7 +148,7 @@ func TryToConnect(ctx context.Context, log *zap.Logger, name string, opt options
 				return
 			}
 
-			db, err = factory.Database.Get(name)
+			db, err = connect(name, dialect, dsn)
 			if err != nil {
 				log.Warn(
 					"could not connect to the database",
@@ -0,0 +1,203 @@
+package db
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/titpetric/factory"
+
+	"github.com/cortezaproject/corteza-server/pkg/ql"
+)
+
+type (
+	// Dialect hides differences between supported SQL databases
+	//
+	// Repositories should use it whenever they need to produce
+	// SQL that is not portable (upserts, casting, date functions...)
+	Dialect interface {
+		// Name of the dialect (mysql, postgres)
+		Name() string
+
+		// Insert inserts payload (struct with db tags) into the table
+		Insert(db *factory.DB, table string, payload interface{}) error
+
+		// Upsert inserts or replaces payload (struct with db tags) in the table
+		//
+		// Keys are used to detect conflicts (usually primary key columns)
+		Upsert(db *factory.DB, table string, payload interface{}, keys ...string) error
+
+		// UpsertQuery returns insert-or-replace statement for the given columns
+		// and source (VALUES (...) or SELECT ...)
+		UpsertQuery(table string, columns, keys []string, source string) string
+
+		// InsertIgnoreQuery returns insert statement that silently skips
+		// rows that would cause a duplicate key conflict
+		InsertIgnoreQuery(table string, columns []string, source string) string
+
+		// CastAsNumber returns expression that converts (string) value to number
+		CastAsNumber(expr string) string
+
+		// CastAsDateTime returns expression that converts (string) value to date & time
+		CastAsDateTime(expr string) string
+
+		// NumericValue returns expression for comparing string-stored value with numbers
+		NumericValue(expr string) string
+
+		// TypedColumn returns SQL type of the column for the generic type (see ColumnType*)
+		TypedColumn(typ string) string
+
+		// TypedValue returns expression that converts (string) value to the generic column type
+		//
+		// Values that can not be converted yield NULL (instead of an error or a warning
+		// that aborts the statement)
+		TypedValue(expr, typ string) string
+
+		// IndexedColumn returns column for the index definition
+		IndexedColumn(col, typ string) string
+
+		// OrderBy returns order-by term that sorts NULL values before all
+		// other values (as the smallest value, in both directions)
+		OrderBy(expr string, desc bool) string
+
+		// DayTimestamp returns expression that truncates datetime to a day and
+		// converts it to unix timestamp
+		DayTimestamp(expr string) string
+
+		// GroupConcat returns aggregation expression that concatenates ordered
+		// values with separator
+		GroupConcat(expr, separator string) string
+
+		// QlOperator and QlFunction translate parsed ql nodes to dialect specific SQL
+		QlOperator(op ql.Operator) (ql.Operator, error)
+		QlFunction(fn ql.Function) (ql.Function, error)
+
+		// LockQuery and UnlockQuery return statements that take and release named
+		// session lock (see Lock); both select 1 on success
+		LockQuery(name string) (string, []interface{})
+		UnlockQuery(name string) (string, []interface{})
+	}
+)
+
+const (
+	DialectMySQL    = "mysql"
+	DialectPostgres = "postgres"
+)
+
+// Generic column types, translated to SQL by dialects
+const (
+	ColumnTypeText     = "text"
+	ColumnTypeNumber   = "number"
+	ColumnTypeDateTime = "datetime"
+	ColumnTypeID       = "id"
+)
+
+// Patterns of (string) values that can be converted to generic column types;
+// other values are converted to NULL (see TypedValue)
+const (
+	typedNumberPattern   = `^[-+]?[0-9]{1,28}([.][0-9]*)?$`
+	typedDateTimePattern = `^[0-9]{4}-[0-9]{2}-[0-9]{2}([ T][0-9]{2}:[0-9]{2}(:[0-9]{2}([.][0-9]+)?)?)?(Z|[-+][0-9]{2}:?[0-9]{2})?$`
+	typedIDPattern       = `^[0-9]{1,19}$`
+)
+
+var (
+	dialects = map[string]Dialect{
+		DialectMySQL:    &mysqlDialect{},
+		DialectPostgres: &postgresDialect{},
+	}
+
+	// Keeps dialects for all named connections, see TryToConnect
+	connectionDialects = map[string]Dialect{}
+)
+
+// DialectOf returns dialect of the given db handle
+//
+// MySQL is assumed when handle is not initialized
+func DialectOf(dbh *factory.DB) Dialect {
+	if dbh != nil && dbh.DB != nil && dbh.DriverName() == postgresDriver {
+		return dialects[DialectPostgres]
+	}
+
+	return dialects[DialectMySQL]
+}
+
+// DialectFor returns dialect of the named connection
+//
+// MySQL is assumed when connection is not known
+func DialectFor(name string) Dialect {
+	connectionsLock.RLock()
+	defer connectionsLock.RUnlock()
+
+	if d, ok := connectionDialects[name]; ok {
+		return d
+	}
+
+	return dialects[DialectMySQL]
+}
+
+// payloadColumns returns all columns (db tags) from the payload struct
+func payloadColumns(payload interface{}) (cols []string) {
+	v := reflect.ValueOf(payload)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		tag := strings.Split(v.Type().Field(i).Tag.Get("db"), ",")[0]
+		if tag != "" && tag != "-" {
+			cols = append(cols, tag)
+		}
+	}
+
+	return
+}
+
+// namedPlaceholders converts list of columns to list of named (:col) placeholders
+func namedPlaceholders(cols []string) string {
+	pp := make([]string, len(cols))
+	for i := range cols {
+		pp[i] = ":" + cols[i]
+	}
+
+	return "VALUES (" + strings.Join(pp, ", ") + ")"
+}
+
+func insertQuery(verb, table string, columns []string, source string) string {
+	return fmt.Sprintf("%s %s (%s) %s", verb, table, strings.Join(columns, ", "), source)
+}
+
+// dateTruncUnit returns (lowercase) unit of DATE_TRUNC(unit, expr)
+//
+// Dates are truncated to the first day of day, week (monday), month, quarter or year
+func dateTruncUnit(fn ql.Function) (string, error) {
+	if len(fn.Arguments) != 2 {
+		return "", fmt.Errorf("%s expects two arguments", fn.Name)
+	}
+
+	unit, ok := fn.Arguments[0].(ql.String)
+	if !ok {
+		return "", fmt.Errorf("%s expects string unit", fn.Name)
+	}
+
+	switch u := strings.ToLower(unit.Value); u {
+	case "day", "week", "month", "quarter", "year":
+		return u, nil
+	}
+
+	return "", fmt.Errorf("unsupported %s unit %q", fn.Name, unit.Value)
+}
+
+// qlCast wraps the node into CAST(<node> AS <typ>)
+func qlCast(n ql.ASTNode, typ string) ql.ASTNode {
+	return ql.Function{Name: "CAST", Arguments: ql.ASTSet{ql.ASTNodes{n, ql.Keyword{Keyword: " AS " + typ}}}}
+}
+
+// qlNullIf converts empty strings in the column to NULL
+func qlNullIf(n ql.ASTNode) ql.ASTNode {
+	if _, ok := n.(ql.Ident); !ok {
+		// Only identifiers (columns) can hold empty strings
+		return n
+	}
+
+	return ql.Function{Name: "NULLIF", Arguments: ql.ASTSet{n, ql.String{Value: ""}}}
+}
@@ -0,0 +1,187 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/titpetric/factory"
+
+	"github.com/cortezaproject/corteza-server/pkg/ql"
+)
+
+type (
+	mysqlDialect struct{}
+)
+
+func (mysqlDialect) Name() string {
+	return DialectMySQL
+}
+
+func (mysqlDialect) Insert(db *factory.DB, table string, payload interface{}) error {
+	return db.Insert(table, payload)
+}
+
+func (mysqlDialect) Upsert(db *factory.DB, table string, payload interface{}, _ ...string) error {
+	return db.Replace(table, payload)
+}
+
+func (mysqlDialect) UpsertQuery(table string, columns, _ []string, source string) string {
+	return insertQuery("REPLACE INTO", table, columns, source)
+}
+
+func (mysqlDialect) InsertIgnoreQuery(table string, columns []string, source string) string {
+	return insertQuery("INSERT IGNORE INTO", table, columns, source)
+}
+
+func (mysqlDialect) CastAsNumber(expr string) string {
+	return fmt.Sprintf("CAST(%s AS SIGNED)", expr)
+}
+
+func (mysqlDialect) CastAsDateTime(expr string) string {
+	return fmt.Sprintf("CAST(%s AS DATETIME)", expr)
+}
+
+// NumericValue returns expression as-is, MySQL handles string to number conversion
+func (mysqlDialect) NumericValue(expr string) string {
+	return expr
+}
+
+func (mysqlDialect) TypedColumn(typ string) string {
+	switch typ {
+	case ColumnTypeNumber:
+		return "DECIMAL(38,10)"
+	case ColumnTypeDateTime:
+		return "DATETIME"
+	case ColumnTypeID:
+		return "BIGINT UNSIGNED"
+	default:
+		return "TEXT"
+	}
+}
+
+// TypedValue casts only values that match the pattern of the type; in strict mode
+// MySQL aborts inserts when cast of an invalid value produces a warning
+//
+// Date & time values are cut to "YYYY-MM-DD hh:mm:ss", time zone is ignored
+func (mysqlDialect) TypedValue(expr, typ string) string {
+	switch typ {
+	case ColumnTypeNumber:
+		return fmt.Sprintf("CASE WHEN %[1]s REGEXP '%[2]s' THEN CAST(%[1]s AS DECIMAL(38,10)) END", expr, typedNumberPattern)
+	case ColumnTypeDateTime:
+		return fmt.Sprintf(
+			"CASE WHEN %[1]s REGEXP '%[2]s' THEN CAST(REPLACE(LEFT(%[1]s, "+
+				"IF(%[1]s REGEXP '^.{10}[ T][0-9]{2}:[0-9]{2}:', 19, IF(LENGTH(%[1]s) < 16, 10, 16))"+
+				"), 'T', ' ') AS DATETIME) END",
+			expr, typedDateTimePattern,
+		)
+	case ColumnTypeID:
+		return fmt.Sprintf("CASE WHEN %[1]s REGEXP '%[2]s' THEN CAST(%[1]s AS UNSIGNED) END", expr, typedIDPattern)
+	default:
+		return expr
+	}
+}
+
+// IndexedColumn limits length of indexed text columns, MySQL can not index them otherwise
+func (mysqlDialect) IndexedColumn(col, typ string) string {
+	if typ == ColumnTypeText {
+		return col + "(191)"
+	}
+
+	return col
+}
+
+// OrderBy returns term as-is, MySQL treats NULLs as the smallest values
+func (mysqlDialect) OrderBy(expr string, desc bool) string {
+	if desc {
+		return expr + " DESC"
+	}
+
+	return expr + " ASC"
+}
+
+func (mysqlDialect) DayTimestamp(expr string) string {
+	return fmt.Sprintf("UNIX_TIMESTAMP(DATE(%s))", expr)
+}
+
+func (mysqlDialect) GroupConcat(expr, separator string) string {
+	return fmt.Sprintf("GROUP_CONCAT(%s ORDER BY 1 ASC SEPARATOR '%s')", expr, separator)
+}
+
+func (mysqlDialect) QlOperator(op ql.Operator) (ql.Operator, error) {
+	return op, nil
+}
+
+// QlFunction translates functions that are supported by ql but not by MySQL
+//
+// MySQL has no percentile aggregate; PERCENTILE collects all values
+// into JSON array and percentile must be calculated from it by the caller.
+// DATE_TRUNC is emulated with date formatting
+func (mysqlDialect) QlFunction(fn ql.Function) (ql.Function, error) {
+	switch strings.ToUpper(fn.Name) {
+	case "PERCENTILE":
+		if len(fn.Arguments) != 2 {
+			return fn, fmt.Errorf("%s expects two arguments", fn.Name)
+		}
+
+		fn = ql.Function{Name: "JSON_ARRAYAGG", Arguments: ql.ASTSet{
+			qlCast(qlNullIf(fn.Arguments[0]), "DECIMAL(38,10)"),
+		}}
+
+	case "DATE_TRUNC":
+		unit, err := dateTruncUnit(fn)
+		if err != nil {
+			return fn, err
+		}
+
+		var expr = fn.Arguments[1]
+
+		switch unit {
+		case "day":
+			fn = mysqlDateFormat(expr, "%Y-%m-%d")
+		case "week":
+			fn = mysqlDateFormat(ql.Function{Name: "DATE_SUB", Arguments: ql.ASTSet{
+				expr,
+				ql.ASTNodes{
+					ql.Keyword{Keyword: "INTERVAL "},
+					ql.Function{Name: "WEEKDAY", Arguments: ql.ASTSet{expr}},
+					ql.Keyword{Keyword: " DAY"},
+				},
+			}}, "%Y-%m-%d")
+		case "month":
+			fn = mysqlDateFormat(expr, "%Y-%m-01")
+		case "quarter":
+			fn = ql.Function{Name: "CONCAT", Arguments: ql.ASTSet{
+				ql.Function{Name: "YEAR", Arguments: ql.ASTSet{expr}},
+				ql.String{Value: "-"},
+				ql.Function{Name: "LPAD", Arguments: ql.ASTSet{
+					ql.ASTNodes{
+						ql.Function{Name: "QUARTER", Arguments: ql.ASTSet{expr}},
+						ql.Keyword{Keyword: " * 3 - 2"},
+					},
+					ql.Number{Value: "2"},
+					ql.String{Value: "0"},
+				}},
+				ql.String{Value: "-01"},
+			}}
+		case "year":
+			fn = mysqlDateFormat(expr, "%Y-01-01")
+		}
+	}
+
+	return fn, nil
+}
+
+func mysqlDateFormat(expr ql.ASTNode, format string) ql.Function {
+	return ql.Function{Name: "DATE_FORMAT", Arguments: ql.ASTSet{expr, ql.String{Value: format}}}
+}
+
+// LockQuery waits (without timeout) for the named lock
+//
+// Names are shared by all databases on the server
+func (mysqlDialect) LockQuery(name string) (string, []interface{}) {
+	return "SELECT GET_LOCK(?, -1)", []interface{}{name}
+}
+
+func (mysqlDialect) UnlockQuery(name string) (string, []interface{}) {
+	return "SELECT RELEASE_LOCK(?)", []interface{}{name}
+}
@@ -0,0 +1,330 @@
+package db
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"github.com/titpetric/factory"
+
+	"github.com/cortezaproject/corteza-server/pkg/ql"
+)
+
+type (
+	postgresDialect struct{}
+)
+
+var (
+	// MySQL DATE_FORMAT() specifiers and their TO_CHAR() counterparts
+	postgresDateFormat = map[rune]string{
+		'Y': "YYYY",
+		'y': "YY",
+		'm': "MM",
+		'c': "FMMM",
+		'd': "DD",
+		'e': "FMDD",
+		'H': "HH24",
+		'k': "FMHH24",
+		'h': "HH12",
+		'I': "HH12",
+		'l': "FMHH12",
+		'i': "MI",
+		's': "SS",
+		'S': "SS",
+		'f': "US",
+		'p': "AM",
+		'M': "FMMonth",
+		'b': "Mon",
+		'W': "FMDay",
+		'a': "Dy",
+		'j': "DDD",
+		'U': "WW",
+		'u': "IW",
+		'v': "IW",
+		'V': "WW",
+		'x': "IYYY",
+		'X': "YYYY",
+		'w': "D",
+		'%': "%",
+	}
+)
+
+func (postgresDialect) Name() string {
+	return DialectPostgres
+}
+
+func (postgresDialect) Insert(db *factory.DB, table string, payload interface{}) error {
+	var cols = payloadColumns(payload)
+	_, err := db.NamedExec(insertQuery("INSERT INTO", table, cols, namedPlaceholders(cols)), payload)
+	return err
+}
+
+func (d postgresDialect) Upsert(db *factory.DB, table string, payload interface{}, keys ...string) error {
+	var cols = payloadColumns(payload)
+	_, err := db.NamedExec(d.UpsertQuery(table, cols, keys, namedPlaceholders(cols)), payload)
+	return err
+}
+
+func (postgresDialect) UpsertQuery(table string, columns, keys []string, source string) string {
+	var (
+		set   = make([]string, 0, len(columns))
+		isKey = make(map[string]bool)
+	)
+
+	for _, k := range keys {
+		isKey[k] = true
+	}
+
+	for _, c := range columns {
+		if !isKey[c] {
+			set = append(set, c+" = EXCLUDED."+c)
+		}
+	}
+
+	q := insertQuery("INSERT INTO", table, columns, source) +
+		" ON CONFLICT (" + strings.Join(keys, ", ") + ")"
+
+	if len(set) == 0 {
+		return q + " DO NOTHING"
+	}
+
+	return q + " DO UPDATE SET " + strings.Join(set, ", ")
+}
+
+func (postgresDialect) InsertIgnoreQuery(table string, columns []string, source string) string {
+	return insertQuery("INSERT INTO", table, columns, source) + " ON CONFLICT DO NOTHING"
+}
+
+func (postgresDialect) CastAsNumber(expr string) string {
+	return fmt.Sprintf("CAST(NULLIF(%s, '') AS NUMERIC)", expr)
+}
+
+func (postgresDialect) CastAsDateTime(expr string) string {
+	return fmt.Sprintf("CAST(NULLIF(%s, '') AS TIMESTAMP)", expr)
+}
+
+// NumericValue casts the expression, PostgreSQL does not compare strings with numbers
+func (d postgresDialect) NumericValue(expr string) string {
+	return d.CastAsNumber(expr)
+}
+
+func (postgresDialect) TypedColumn(typ string) string {
+	switch typ {
+	case ColumnTypeNumber:
+		return "NUMERIC"
+	case ColumnTypeDateTime:
+		return "TIMESTAMP"
+	case ColumnTypeID:
+		return "BIGINT"
+	default:
+		return "TEXT"
+	}
+}
+
+// TypedValue casts only values that match the pattern of the type, PostgreSQL fails on invalid casts
+func (postgresDialect) TypedValue(expr, typ string) string {
+	switch typ {
+	case ColumnTypeNumber:
+		return fmt.Sprintf("CASE WHEN %[1]s ~ '%[2]s' THEN CAST(%[1]s AS NUMERIC) END", expr, typedNumberPattern)
+	case ColumnTypeDateTime:
+		return fmt.Sprintf("CASE WHEN %[1]s ~ '%[2]s' THEN CAST(%[1]s AS TIMESTAMP) END", expr, typedDateTimePattern)
+	case ColumnTypeID:
+		return fmt.Sprintf("CASE WHEN %[1]s ~ '%[2]s' THEN CAST(%[1]s AS BIGINT) END", expr, typedIDPattern)
+	default:
+		return expr
+	}
+}
+
+func (postgresDialect) IndexedColumn(col, _ string) string {
+	return col
+}
+
+// OrderBy adds explicit NULLS FIRST/LAST, PostgreSQL treats NULLs as the largest values
+func (postgresDialect) OrderBy(expr string, desc bool) string {
+	if desc {
+		return expr + " DESC NULLS LAST"
+	}
+
+	return expr + " ASC NULLS FIRST"
+}
+
+func (postgresDialect) DayTimestamp(expr string) string {
+	return fmt.Sprintf("CAST(EXTRACT(EPOCH FROM DATE_TRUNC('day', %s)) AS BIGINT)", expr)
+}
+
+func (postgresDialect) GroupConcat(expr, separator string) string {
+	return fmt.Sprintf("STRING_AGG(CAST(%s AS TEXT), '%s' ORDER BY %s ASC)", expr, separator, expr)
+}
+
+// QlOperator makes LIKE case-insensitive (MySQL does that with collation)
+func (postgresDialect) QlOperator(op ql.Operator) (ql.Operator, error) {
+	switch strings.ToUpper(op.Kind) {
+	case "LIKE":
+		op.Kind = "ILIKE"
+	case "NOT LIKE":
+		op.Kind = "NOT ILIKE"
+	}
+
+	return op, nil
+}
+
+// QlFunction translates MySQL functions that are supported by ql to PostgreSQL counterparts
+func (postgresDialect) QlFunction(fn ql.Function) (ql.Function, error) {
+	switch strings.ToUpper(fn.Name) {
+	case "SUM", "AVG":
+		for a := range fn.Arguments {
+			fn.Arguments[a] = qlCast(qlNullIf(fn.Arguments[a]), "NUMERIC")
+		}
+
+	case "STD":
+		fn.Name = "STDDEV_POP"
+		for a := range fn.Arguments {
+			fn.Arguments[a] = qlCast(qlNullIf(fn.Arguments[a]), "NUMERIC")
+		}
+
+	case "YEAR", "QUARTER", "MONTH", "WEEK", "DAY", "HOUR", "MINUTE":
+		if len(fn.Arguments) != 1 {
+			return fn, errors.Errorf("%s expects one argument", fn.Name)
+		}
+
+		fn = ql.Function{Name: "EXTRACT", Arguments: ql.ASTSet{ql.ASTNodes{
+			ql.Keyword{Keyword: strings.ToUpper(fn.Name) + " FROM "},
+			qlCast(fn.Arguments[0], "TIMESTAMP"),
+		}}}
+
+	case "DATE":
+		if len(fn.Arguments) != 1 {
+			return fn, errors.Errorf("%s expects one argument", fn.Name)
+		}
+
+		fn.Arguments[0] = qlCast(fn.Arguments[0], "TIMESTAMP")
+
+	case "DATE_FORMAT":
+		if len(fn.Arguments) != 2 {
+			return fn, errors.Errorf("%s expects two arguments", fn.Name)
+		}
+
+		format, ok := fn.Arguments[1].(ql.String)
+		if !ok {
+			return fn, errors.Errorf("%s expects string format", fn.Name)
+		}
+
+		format.Value = pgDateFormat(format.Value)
+
+		fn = ql.Function{Name: "TO_CHAR", Arguments: ql.ASTSet{
+			qlCast(fn.Arguments[0], "TIMESTAMP"),
+			format,
+		}}
+
+	case "PERCENTILE":
+		if len(fn.Arguments) != 2 {
+			return fn, errors.Errorf("%s expects two arguments", fn.Name)
+		}
+
+		// Function without a name is rendered as expression in parenthesis
+		fn = ql.Function{Arguments: ql.ASTSet{ql.ASTNodes{
+			ql.Keyword{Keyword: "PERCENTILE_CONT("},
+			fn.Arguments[1],
+			ql.Keyword{Keyword: ") WITHIN GROUP (ORDER BY "},
+			qlCast(qlNullIf(fn.Arguments[0]), "NUMERIC"),
+			ql.Keyword{Keyword: ")"},
+		}}}
+
+	case "DATE_TRUNC":
+		unit, err := dateTruncUnit(fn)
+		if err != nil {
+			return fn, err
+		}
+
+		fn = ql.Function{Name: "TO_CHAR", Arguments: ql.ASTSet{
+			ql.Function{Name: "DATE_TRUNC", Arguments: ql.ASTSet{
+				ql.String{Value: unit},
+				qlCast(fn.Arguments[1], "TIMESTAMP"),
+			}},
+			ql.String{Value: "YYYY-MM-DD"},
+		}}
+
+	case "DATE_ADD", "DATE_SUB":
+		if len(fn.Arguments) != 2 {
+			return fn, errors.Errorf("%s expects two arguments", fn.Name)
+		}
+
+		interval, ok := fn.Arguments[1].(ql.Interval)
+		if !ok {
+			return fn, errors.Errorf("%s expects interval", fn.Name)
+		}
+
+		if strings.Contains(interval.Unit, "_") {
+			return fn, errors.Errorf("unsupported interval unit %q", interval.Unit)
+		}
+
+		op := " + "
+		if strings.ToUpper(fn.Name) == "DATE_SUB" {
+			op = " - "
+		}
+
+		// Function without a name is rendered as expression in parenthesis
+		fn = ql.Function{Arguments: ql.ASTSet{ql.ASTNodes{
+			qlCast(fn.Arguments[0], "TIMESTAMP"),
+			ql.Keyword{Keyword: op},
+			ql.Ident{Value: "CAST(? AS INTERVAL)", Args: []interface{}{interval.Value + " " + interval.Unit}},
+		}}}
+	}
+
+	return fn, nil
+}
+
+// pgDateFormat converts MySQL's DATE_FORMAT() format to TO_CHAR() format
+func pgDateFormat(in string) (out string) {
+	var (
+		rr      = []rune(in)
+		literal = ""
+	)
+
+	flush := func() {
+		if literal != "" {
+			out += `"` + literal + `"`
+			literal = ""
+		}
+	}
+
+	for i := 0; i < len(rr); i++ {
+		if rr[i] == '%' && i+1 < len(rr) {
+			if f, ok := postgresDateFormat[rr[i+1]]; ok {
+				flush()
+				out += f
+				i++
+				continue
+			}
+		}
+
+		if unicode.IsLetter(rr[i]) {
+			// Letters need to be quoted so that TO_CHAR does not interpret them
+			literal += string(rr[i])
+			continue
+		}
+
+		flush()
+		out += string(rr[i])
+	}
+
+	flush()
+	return
+}
+
+// LockQuery waits for the advisory lock; name is hashed to the (numeric) lock key
+func (postgresDialect) LockQuery(name string) (string, []interface{}) {
+	return "SELECT 1 FROM (SELECT pg_advisory_lock(?)) AS l", []interface{}{advisoryLockKey(name)}
+}
+
+func (postgresDialect) UnlockQuery(name string) (string, []interface{}) {
+	return "SELECT CASE WHEN pg_advisory_unlock(?) THEN 1 ELSE 0 END", []interface{}{advisoryLockKey(name)}
+}
+
+func advisoryLockKey(name string) int64 {
+	var h = fnv.New64a()
+	_, _ = h.Write([]byte(name))
+	return int64(h.Sum64())
+}
@@ -0,0 +1,59 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"github.com/titpetric/factory"
+)
+
+// Lock takes named lock that is shared by all instances connected to the same database,
+// waiting until it is released by the current holder
+//
+// Lock is held by the session of a dedicated connection (not by the transaction)
+// until the returned unlock function is called
+func Lock(ctx context.Context, db *factory.DB, name string) (unlock func() error, err error) {
+	var (
+		d    = DialectOf(db)
+		conn *sql.Conn
+
+		query, args = d.LockQuery(name)
+	)
+
+	if conn, err = db.Conn(ctx); err != nil {
+		return nil, errors.Wrap(err, "could not get connection for lock")
+	}
+
+	if err = sessionLock(ctx, conn, query, args); err != nil {
+		_ = conn.Close()
+		return nil, errors.Wrapf(err, "could not take lock %q", name)
+	}
+
+	return func() error {
+		defer conn.Close()
+
+		query, args := d.UnlockQuery(name)
+
+		// Lock must be released even when the context is already cancelled
+		if err := sessionLock(context.Background(), conn, query, args); err != nil {
+			return errors.Wrapf(err, "could not release lock %q", name)
+		}
+
+		return nil
+	}, nil
+}
+
+func sessionLock(ctx context.Context, conn *sql.Conn, query string, args []interface{}) error {
+	var ok sql.NullInt64
+
+	if err := conn.QueryRowContext(ctx, query, args...).Scan(&ok); err != nil {
+		return err
+	}
+
+	if ok.Int64 != 1 {
+		return errors.New("lock not held")
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+type (
+	// postgres driver wraps lib/pq and rebinds MySQL style (?) placeholders
+	// to PostgreSQL style ($1, $2...) ones.
+	//
+	// This way all queries produced by factory, sqlx & squirrel
+	// (with default placeholder format) work with both databases.
+	postgres struct {
+		pq.Driver
+	}
+
+	postgresConn struct {
+		driver.Conn
+	}
+)
+
+const (
+	postgresDriver = "corteza-postgres"
+)
+
+func init() {
+	sql.Register(postgresDriver, &postgres{})
+}
+
+func (d *postgres) Open(dsn string) (driver.Conn, error) {
+	conn, err := d.Driver.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &postgresConn{conn}, nil
+}
+
+func (c *postgresConn) Prepare(query string) (driver.Stmt, error) {
+	return c.Conn.Prepare(rebind(query))
+}
+
+func (c *postgresConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if cpc, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return cpc.PrepareContext(ctx, rebind(query))
+	}
+
+	return c.Prepare(query)
+}
+
+func (c *postgresConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if qc, ok := c.Conn.(driver.QueryerContext); ok {
+		return qc.QueryContext(ctx, rebind(query), args)
+	}
+
+	return nil, driver.ErrSkip
+}
+
+func (c *postgresConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if ec, ok := c.Conn.(driver.ExecerContext); ok {
+		return ec.ExecContext(ctx, rebind(query), args)
+	}
+
+	return nil, driver.ErrSkip
+}
+
+func (c *postgresConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if cbt, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return cbt.BeginTx(ctx, opts)
+	}
+
+	return c.Conn.Begin()
+}
+
+func (c *postgresConn) Ping(ctx context.Context) error {
+	if p, ok := c.Conn.(driver.Pinger); ok {
+		return p.Ping(ctx)
+	}
+
+	return nil
+}
+
+// rebind replaces all ? placeholders with $n
+//
+// Quoted strings and identifiers are left intact.
+func rebind(query string) string {
+	if !strings.Contains(query, "?") {
+		return query
+	}
+
+	var (
+		out   = strings.Builder{}
+		n     = 0
+		quote rune
+	)
+
+	out.Grow(len(query) + 10)
+
+	for _, r := range query {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '?':
+			n++
+			out.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+
+		out.WriteRune(r)
+	}
+
+	return out.String()
+}
@@ -23,7 +23,7 @@ type (
 	//  - if one of the roles has wildcard ALLOW / DENY rule this is then the final check
 	//  - we check everyone role rules for each resource
 	//  - if everyone role has wildcard ALLOW / DENY rule this is then the final check
-	//  - fallback access check is added at the end
+	//  - fallback access check (or fallback expression, see Fallback) is added at the end
 	//
 	// Resulting SQL check SHOULD reflect rules check ("overall flow" in the header of
 	// ruleset_checks.go file)
@@ -39,7 +39,8 @@ type (
 			Check(res Resource, op Operation, roles ...uint64) (v Access)
 		}
 
-		fallback Access
+		fallback     Access
+		fallbackExpr squirrel.Sqlizer
 
 		superuser bool
 		roles     []uint64
@@ -51,6 +52,16 @@ func (rf *ResourceFilter) Build(pkColName string) *ResourceFilter {
 	return rf
 }
 
+// Fallback sets expression that is used instead of fallback access
+// for resources without any (allow or deny) rules
+//
+// This allows resource-level rules to be combined with other
+// conditions, ownership for example
+func (rf *ResourceFilter) Fallback(expr squirrel.Sqlizer) *ResourceFilter {
+	rf.fallbackExpr = expr
+	return rf
+}
+
 func (rf ResourceFilter) ToSql() (sql string, args []interface{}, err error) {
 	if rf.superuser {
 		return "TRUE", nil, nil
@@ -116,8 +127,10 @@ func (rf ResourceFilter) ToSql() (sql string, args []interface{}, err error) {
 		}
 	}
 
-	// Fallback access
-	if rf.fallback == Deny {
+	// Fallback expression or access
+	if rf.fallbackExpr != nil {
+		return build(rf.fallbackExpr)
+	} else if rf.fallback == Deny {
 		return build(expFALSE)
 	} else {
 		return build(expTRUE)
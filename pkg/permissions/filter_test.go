@@ -30,6 +30,12 @@ func TestResourceFilter_Build(t *testing.T) {
 		squirrel.DebugSqlizer(rf),
 	)
 
+	rf.Fallback(squirrel.Eq{"owner": 42})
+	req.Equal(
+		`COALESCE((SELECT access = 1 FROM ptbl WHERE operation = 'read' AND resource = CONCAT('res:', pkcol) AND rel_role IN ('123') ORDER BY access LIMIT 1), (SELECT access = 1 FROM ptbl WHERE operation = 'read' AND resource = CONCAT('res:', pkcol) AND rel_role IN ('1') ORDER BY access LIMIT 1), owner = '42')`,
+		squirrel.DebugSqlizer(rf),
+	)
+
 	rf.chk = &ServiceDenyAll{}
 	req.Equal(
 		`COALESCE((SELECT access = 1 FROM ptbl WHERE operation = 'read' AND resource = CONCAT('res:', pkcol) AND rel_role IN ('123') ORDER BY access LIMIT 1), FALSE)`,
@@ -6,6 +6,8 @@ import (
 	"github.com/Masterminds/squirrel"
 	"github.com/pkg/errors"
 	"github.com/titpetric/factory"
+
+	"github.com/cortezaproject/corteza-server/pkg/rh"
 )
 
 type (
@@ -83,7 +85,7 @@ func (r *repository) Store(deleteSet, updateSet RuleSet) (err error) {
 
 		if len(updateSet) > 0 {
 			err = updateSet.Walk(func(rule *Rule) error {
-				return r.dbh.Replace(r.dbTable, rule)
+				return rh.Upsert(r.dbh, r.dbTable, rule, "rel_role", "resource", "operation")
 			})
 			if err != nil {
 				return err
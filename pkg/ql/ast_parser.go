@@ -13,6 +13,7 @@ type (
 
 		OnIdent    IdentHandler
 		OnFunction FunctionHandler
+		OnOperator OperatorHandler
 
 		// parenthesis level control
 		level uint
@@ -20,6 +21,7 @@ type (
 
 	IdentHandler    func(ident Ident) (Ident, error)
 	FunctionHandler func(ident Function) (Function, error)
+	OperatorHandler func(op Operator) (Operator, error)
 )
 
 // NewParser returns a new instance of Parser.
@@ -27,6 +29,7 @@ func NewParser() *Parser {
 	p := &Parser{
 		OnIdent:    func(ident Ident) (Ident, error) { return ident, nil },
 		OnFunction: func(ident Function) (Function, error) { return ident, nil },
+		OnOperator: func(op Operator) (Operator, error) { return op, nil },
 	}
 
 	return p
@@ -130,7 +133,24 @@ func (p *Parser) peekIfAlias() bool {
 	return f.Is(IDENT) && strings.ToUpper(f.literal) == "AS" && s.Is(IDENT)
 }
 
+// parseExpr parses expression and passes all (merged) operators to OnOperator handler
 func (p *Parser) parseExpr(t Token) (list ASTNodes, err error) {
+	if list, err = p.parseExprNodes(t); err != nil {
+		return nil, err
+	}
+
+	for i := range list {
+		if op, ok := list[i].(Operator); ok {
+			if list[i], err = p.OnOperator(op); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return
+}
+
+func (p *Parser) parseExprNodes(t Token) (list ASTNodes, err error) {
 	goto checkToken
 
 next:
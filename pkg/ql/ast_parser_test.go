@@ -364,3 +364,40 @@ func TestAstParser_IdentModifier(t *testing.T) {
 		}
 	}
 }
+
+func TestAstParser_OperatorModifier(t *testing.T) {
+	var tests = []struct {
+		in  string
+		sql string
+	}{
+		{
+			in:  `foo LIKE 'bar'`,
+			sql: `foo ILIKE ?`,
+		},
+		{
+			in:  `foo NOT LIKE 'bar' AND (baz LIKE 'bar')`,
+			sql: `foo NOT ILIKE ? AND (baz ILIKE ?)`,
+		},
+	}
+
+	p := NewParser()
+	p.OnOperator = func(op Operator) (Operator, error) {
+		switch op.Kind {
+		case "LIKE":
+			op.Kind = "ILIKE"
+		case "NOT LIKE":
+			op.Kind = "NOT ILIKE"
+		}
+		return op, nil
+	}
+
+	for i, test := range tests {
+		if tree, err := p.ParseExpression(test.in); err != nil {
+			t.Fatalf("%d. unexpected error: %v", i, err)
+		} else if sql, _, err := tree.ToSql(); err != nil {
+			t.Fatalf("%d. unexpected error: %v", i, err)
+		} else if test.sql != sql {
+			t.Errorf("%d. sql does not match:\n\n expected: %s\n      got: %s\n\n", i, test.sql, sql)
+		}
+	}
+}
@@ -0,0 +1,294 @@
+package ql
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type (
+	// IdentResolver returns value of the identifier (float64, string or nil)
+	IdentResolver func(ident string) (interface{}, error)
+
+	evalFunction func(args []interface{}) (interface{}, error)
+)
+
+var (
+	// Functions supported by Evaluate, keyed by (upper-cased) name
+	evalFunctions = map[string]evalFunction{
+		"CONCAT":   evalConcat,
+		"COALESCE": evalCoalesce,
+		"ROUND":    evalRound,
+		"ABS":      evalNumeric(math.Abs),
+		"FLOOR":    evalNumeric(math.Floor),
+		"CEIL":     evalNumeric(math.Ceil),
+		"LOWER":    evalString(strings.ToLower),
+		"UPPER":    evalString(strings.ToUpper),
+		"TRIM":     evalString(strings.TrimSpace),
+	}
+)
+
+// Evaluate calculates value of the parsed expression
+//
+// Supports arithmetic operators (+, -, *, /), parentheses and a few functions
+// (CONCAT, COALESCE, ROUND, ABS, FLOOR, CEIL, LOWER, UPPER, TRIM).
+// Values are float64, string or nil; + concatenates when one of the operands is a string.
+//
+// Like in SQL, arithmetic with nil (and division by zero) results in nil
+func Evaluate(n ASTNode, resolve IdentResolver) (interface{}, error) {
+	switch n := n.(type) {
+	case Null:
+		return nil, nil
+	case Number:
+		return strconv.ParseFloat(n.Value, 64)
+	case String:
+		return n.Value, nil
+	case Ident:
+		return resolve(n.Value)
+	case Function:
+		return evalFunctionCall(n, resolve)
+	case ASTNodes:
+		return evalNodes(n, resolve)
+	case ASTSet:
+		if len(n) == 1 {
+			return Evaluate(n[0], resolve)
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported expression %q", n)
+}
+
+func evalFunctionCall(f Function, resolve IdentResolver) (interface{}, error) {
+	fn, ok := evalFunctions[strings.ToUpper(f.Name)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported function %q", f.Name)
+	}
+
+	args := make([]interface{}, len(f.Arguments))
+	for i, a := range f.Arguments {
+		var err error
+		if args[i], err = Evaluate(a, resolve); err != nil {
+			return nil, err
+		}
+	}
+
+	return fn(args)
+}
+
+// evalNodes evaluates operands and operators
+//
+// Multiplication & division are evaluated first
+func evalNodes(nn ASTNodes, resolve IdentResolver) (out interface{}, err error) {
+	var (
+		operands = []interface{}{}
+		ops      = []string{}
+		unary    = []string{}
+	)
+
+	for _, n := range nn {
+		if op, ok := n.(Operator); ok {
+			kinds := strings.Fields(op.Kind)
+			if len(operands) > len(ops) {
+				// Binary operator; merged operators that follow are unary (a * -b)
+				ops, kinds = append(ops, kinds[0]), kinds[1:]
+			}
+
+			unary = append(unary, kinds...)
+			continue
+		}
+
+		if len(operands) > len(ops) {
+			return nil, fmt.Errorf("missing operator before %q", n)
+		}
+
+		var v interface{}
+		if v, err = Evaluate(n, resolve); err != nil {
+			return
+		}
+
+		for i := len(unary) - 1; i >= 0; i-- {
+			switch unary[i] {
+			case "+":
+			case "-":
+				if v, err = evalArithmetic("*", v, -1.0); err != nil {
+					return
+				}
+			default:
+				return nil, fmt.Errorf("unsupported operator %q", unary[i])
+			}
+		}
+
+		operands, unary = append(operands, v), nil
+	}
+
+	if len(operands) == 0 || len(operands) == len(ops) {
+		return nil, fmt.Errorf("incomplete expression")
+	}
+
+	var (
+		terms = []interface{}{operands[0]}
+		addOp = []string{}
+	)
+
+	for i, op := range ops {
+		switch op {
+		case "*", "/":
+			if terms[len(terms)-1], err = evalArithmetic(op, terms[len(terms)-1], operands[i+1]); err != nil {
+				return
+			}
+		case "+", "-":
+			terms, addOp = append(terms, operands[i+1]), append(addOp, op)
+		default:
+			return nil, fmt.Errorf("unsupported operator %q", op)
+		}
+	}
+
+	out = terms[0]
+	for i, op := range addOp {
+		if out, err = evalArithmetic(op, out, terms[i+1]); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+func evalArithmetic(op string, a, b interface{}) (interface{}, error) {
+	if op == "+" {
+		_, as := a.(string)
+		_, bs := b.(string)
+		if as || bs {
+			return evalConcat([]interface{}{a, b})
+		}
+	}
+
+	if a == nil || b == nil {
+		return nil, nil
+	}
+
+	x, err := evalToNumber(a)
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := evalToNumber(b)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case "+":
+		return x + y, nil
+	case "-":
+		return x - y, nil
+	case "*":
+		return x * y, nil
+	case "/":
+		if y == 0 {
+			return nil, nil
+		}
+
+		return x / y, nil
+	}
+
+	return nil, fmt.Errorf("unsupported operator %q", op)
+}
+
+func evalToNumber(v interface{}) (float64, error) {
+	switch v := v.(type) {
+	case float64:
+		return v, nil
+	case string:
+		if f, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+			return f, nil
+		}
+	}
+
+	return 0, fmt.Errorf("expecting number, got %q", v)
+}
+
+// EvalToString formats evaluated value; nil is returned as an empty string
+func EvalToString(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func evalConcat(args []interface{}) (interface{}, error) {
+	var out strings.Builder
+	for _, a := range args {
+		out.WriteString(EvalToString(a))
+	}
+
+	return out.String(), nil
+}
+
+func evalCoalesce(args []interface{}) (interface{}, error) {
+	for _, a := range args {
+		if a != nil {
+			return a, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func evalRound(args []interface{}) (interface{}, error) {
+	if len(args) == 0 || len(args) > 2 {
+		return nil, fmt.Errorf("ROUND expects 1 or 2 arguments")
+	}
+
+	var precision float64
+	if len(args) == 2 && args[1] != nil {
+		var err error
+		if precision, err = evalToNumber(args[1]); err != nil {
+			return nil, err
+		}
+	}
+
+	return evalNumeric(func(f float64) float64 {
+		p := math.Pow(10, precision)
+		return math.Round(f*p) / p
+	})(args[:1])
+}
+
+func evalNumeric(fn func(float64) float64) evalFunction {
+	return func(args []interface{}) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("expecting 1 argument")
+		}
+
+		if args[0] == nil {
+			return nil, nil
+		}
+
+		f, err := evalToNumber(args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		return fn(f), nil
+	}
+}
+
+func evalString(fn func(string) string) evalFunction {
+	return func(args []interface{}) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("expecting 1 argument")
+		}
+
+		if args[0] == nil {
+			return nil, nil
+		}
+
+		return fn(EvalToString(args[0])), nil
+	}
+}
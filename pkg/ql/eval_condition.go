@@ -0,0 +1,452 @@
+package ql
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type (
+	// condition is a flattened condition expression that is evaluated
+	// with precedence (from lowest): OR, AND, NOT, comparison, +/-, * and /
+	condition struct {
+		tokens []conditionToken
+		pos    int
+	}
+
+	conditionToken struct {
+		op      string
+		unary   bool
+		operand bool
+		value   interface{}
+	}
+)
+
+var (
+	conditionComparisons = map[string]bool{
+		"=": true, "!=": true, "<>": true, "<": true, "<=": true, ">": true, ">=": true,
+		"LIKE": true, "NOT LIKE": true, "IS": true, "IS NOT": true, "IN": true, "NOT IN": true,
+	}
+)
+
+// EvaluateCondition checks if the parsed condition is true
+//
+// Besides arithmetic (see Evaluate) it supports comparison operators (=, !=, <>, <, <=, >, >=),
+// LIKE and NOT LIKE (case-insensitive, with % and _ wildcards), IN and NOT IN, IS and IS NOT
+// and logical operators (AND, OR, NOT).
+//
+// Like in SQL, comparison with nil is unknown and unknown condition is not true;
+// unlike in SQL, IS and IS NOT compare any two values (a IS NOT b is true when only one of them is nil)
+func EvaluateCondition(n ASTNode, resolve IdentResolver) (bool, error) {
+	v, err := evalCondition(n, resolve)
+	if err != nil {
+		return false, err
+	}
+
+	b, known, err := conditionBool(v)
+	return known && b, err
+}
+
+func evalCondition(n ASTNode, resolve IdentResolver) (interface{}, error) {
+	switch n := n.(type) {
+	case ASTNodes:
+		c, err := flattenCondition(n, resolve)
+		if err != nil {
+			return nil, err
+		}
+
+		return c.eval()
+	case ASTSet:
+		if len(n) == 1 {
+			return evalCondition(n[0], resolve)
+		}
+	}
+
+	return Evaluate(n, resolve)
+}
+
+// flattenCondition evaluates operands and splits merged operators to binary and unary ones
+func flattenCondition(nn ASTNodes, resolve IdentResolver) (c *condition, err error) {
+	c = &condition{}
+
+	var afterOperand = func() bool {
+		return len(c.tokens) > 0 && c.tokens[len(c.tokens)-1].operand
+	}
+
+	for i, n := range nn {
+		if op, ok := n.(Operator); ok {
+			var kind = strings.ToUpper(strings.Join(strings.Fields(op.Kind), " "))
+
+			if afterOperand() {
+				switch {
+				case conditionComparisons[kind]:
+					c.tokens = append(c.tokens, conditionToken{op: kind})
+					continue
+				case kind == "NOT" && i+1 < len(nn) && isInFunction(nn[i+1]):
+					// Handled with the IN that follows
+					continue
+				}
+			}
+
+			kinds := strings.Fields(kind)
+			if afterOperand() {
+				// Binary operator; merged operators that follow are unary (a AND NOT b)
+				c.tokens, kinds = append(c.tokens, conditionToken{op: kinds[0]}), kinds[1:]
+			}
+
+			for _, k := range kinds {
+				c.tokens = append(c.tokens, conditionToken{op: k, unary: true})
+			}
+
+			continue
+		}
+
+		if afterOperand() {
+			if !isInFunction(n) {
+				return nil, fmt.Errorf("missing operator before %q", n)
+			}
+
+			// a IN (b, c) is parsed as an ident followed by IN function
+			var (
+				set = n.(Function).Arguments
+				op  = "IN"
+				vv  = make([]interface{}, len(set))
+			)
+
+			if i > 0 {
+				if prev, ok := nn[i-1].(Operator); ok && strings.EqualFold(strings.TrimSpace(prev.Kind), "NOT") {
+					op = "NOT IN"
+				}
+			}
+
+			for a := range set {
+				if vv[a], err = evalCondition(set[a], resolve); err != nil {
+					return nil, err
+				}
+			}
+
+			c.tokens = append(c.tokens, conditionToken{op: op}, conditionToken{operand: true, value: vv})
+			continue
+		}
+
+		var v interface{}
+		if v, err = evalCondition(n, resolve); err != nil {
+			return nil, err
+		}
+
+		c.tokens = append(c.tokens, conditionToken{operand: true, value: v})
+	}
+
+	if len(c.tokens) == 0 || !c.tokens[len(c.tokens)-1].operand {
+		return nil, fmt.Errorf("incomplete expression")
+	}
+
+	return c, nil
+}
+
+func isInFunction(n ASTNode) bool {
+	f, ok := n.(Function)
+	return ok && strings.ToUpper(f.Name) == "IN"
+}
+
+func (c *condition) eval() (interface{}, error) {
+	v, err := c.or()
+	if err == nil && c.pos < len(c.tokens) {
+		err = fmt.Errorf("unexpected operator %q", c.tokens[c.pos].op)
+	}
+
+	return v, err
+}
+
+// binary returns next binary operator if it is one of the given operators
+func (c *condition) binary(ops ...string) (string, bool) {
+	if c.pos >= len(c.tokens) || c.tokens[c.pos].operand || c.tokens[c.pos].unary {
+		return "", false
+	}
+
+	for _, op := range ops {
+		if c.tokens[c.pos].op == op {
+			c.pos++
+			return op, true
+		}
+	}
+
+	return "", false
+}
+
+func (c *condition) or() (interface{}, error) {
+	a, err := c.and()
+	for err == nil {
+		if _, ok := c.binary("OR"); !ok {
+			break
+		}
+
+		var b interface{}
+		if b, err = c.and(); err == nil {
+			a, err = conditionLogic("OR", a, b)
+		}
+	}
+
+	return a, err
+}
+
+func (c *condition) and() (interface{}, error) {
+	a, err := c.not()
+	for err == nil {
+		if _, ok := c.binary("AND"); !ok {
+			break
+		}
+
+		var b interface{}
+		if b, err = c.not(); err == nil {
+			a, err = conditionLogic("AND", a, b)
+		}
+	}
+
+	return a, err
+}
+
+func (c *condition) not() (interface{}, error) {
+	if c.pos < len(c.tokens) && c.tokens[c.pos].unary && c.tokens[c.pos].op == "NOT" {
+		c.pos++
+
+		v, err := c.not()
+		if err != nil {
+			return nil, err
+		}
+
+		b, known, err := conditionBool(v)
+		if err != nil || !known {
+			return nil, err
+		}
+
+		return !b, nil
+	}
+
+	return c.comparison()
+}
+
+func (c *condition) comparison() (interface{}, error) {
+	a, err := c.sum()
+	for err == nil && c.pos < len(c.tokens) {
+		var op = c.tokens[c.pos].op
+		if c.tokens[c.pos].unary || !conditionComparisons[op] {
+			break
+		}
+
+		c.pos++
+
+		var b interface{}
+		if b, err = c.sum(); err == nil {
+			a, err = conditionCompare(op, a, b)
+		}
+	}
+
+	return a, err
+}
+
+func (c *condition) sum() (interface{}, error) {
+	a, err := c.product()
+	for err == nil {
+		op, ok := c.binary("+", "-")
+		if !ok {
+			break
+		}
+
+		var b interface{}
+		if b, err = c.product(); err == nil {
+			a, err = evalArithmetic(op, a, b)
+		}
+	}
+
+	return a, err
+}
+
+func (c *condition) product() (interface{}, error) {
+	a, err := c.operand()
+	for err == nil {
+		op, ok := c.binary("*", "/")
+		if !ok {
+			break
+		}
+
+		var b interface{}
+		if b, err = c.operand(); err == nil {
+			a, err = evalArithmetic(op, a, b)
+		}
+	}
+
+	return a, err
+}
+
+func (c *condition) operand() (interface{}, error) {
+	if c.pos >= len(c.tokens) {
+		return nil, fmt.Errorf("incomplete expression")
+	}
+
+	t := c.tokens[c.pos]
+	c.pos++
+
+	switch {
+	case t.operand:
+		return t.value, nil
+	case t.unary && t.op == "-":
+		v, err := c.operand()
+		if err != nil {
+			return nil, err
+		}
+
+		return evalArithmetic("*", v, -1.0)
+	case t.unary && t.op == "+":
+		return c.operand()
+	}
+
+	return nil, fmt.Errorf("unsupported operator %q", t.op)
+}
+
+// conditionBool converts value to boolean; known is false for nil
+func conditionBool(v interface{}) (b bool, known bool, err error) {
+	switch v := v.(type) {
+	case nil:
+		return false, false, nil
+	case bool:
+		return v, true, nil
+	case float64:
+		return v != 0, true, nil
+	}
+
+	return false, false, fmt.Errorf("expecting boolean, got %q", v)
+}
+
+// conditionLogic combines values with three-valued logic (like SQL)
+func conditionLogic(op string, a, b interface{}) (interface{}, error) {
+	x, xKnown, err := conditionBool(a)
+	if err != nil {
+		return nil, err
+	}
+
+	y, yKnown, err := conditionBool(b)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case op == "AND" && ((xKnown && !x) || (yKnown && !y)):
+		return false, nil
+	case op == "OR" && ((xKnown && x) || (yKnown && y)):
+		return true, nil
+	case !xKnown || !yKnown:
+		return nil, nil
+	}
+
+	return op == "AND", nil
+}
+
+func conditionCompare(op string, a, b interface{}) (interface{}, error) {
+	switch op {
+	case "IS":
+		return conditionEqual(a, b), nil
+	case "IS NOT":
+		return !conditionEqual(a, b), nil
+	}
+
+	if a == nil {
+		return nil, nil
+	}
+
+	switch op {
+	case "IN", "NOT IN":
+		vv, ok := b.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expecting list of values for %s", op)
+		}
+
+		for _, v := range vv {
+			if v != nil && conditionEqual(a, v) {
+				return op == "IN", nil
+			}
+		}
+
+		return op != "IN", nil
+	}
+
+	if b == nil {
+		return nil, nil
+	}
+
+	switch op {
+	case "LIKE", "NOT LIKE":
+		match, err := conditionLike(EvalToString(a), EvalToString(b))
+		return match == (op == "LIKE"), err
+	}
+
+	var c = conditionOrder(a, b)
+
+	switch op {
+	case "=":
+		return c == 0, nil
+	case "!=", "<>":
+		return c != 0, nil
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">":
+		return c > 0, nil
+	case ">=":
+		return c >= 0, nil
+	}
+
+	return nil, fmt.Errorf("unsupported operator %q", op)
+}
+
+func conditionEqual(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return conditionOrder(a, b) == 0
+}
+
+// conditionOrder compares values as numbers when both are numeric or as strings
+func conditionOrder(a, b interface{}) int {
+	if x, err := evalToNumber(a); err == nil {
+		if y, err := evalToNumber(b); err == nil {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+
+			return 0
+		}
+	}
+
+	return strings.Compare(EvalToString(a), EvalToString(b))
+}
+
+// conditionLike matches value with (case-insensitive) LIKE pattern
+func conditionLike(value, pattern string) (bool, error) {
+	var expr strings.Builder
+	expr.WriteString("(?is)^")
+	for _, r := range pattern {
+		switch r {
+		case '%':
+			expr.WriteString(".*")
+		case '_':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+
+	expr.WriteString("$")
+
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return false, err
+	}
+
+	return re.MatchString(value), nil
+}
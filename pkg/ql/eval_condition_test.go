@@ -0,0 +1,78 @@
+package ql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateCondition(t *testing.T) {
+	var (
+		values = map[string]interface{}{
+			"stage":     "Won",
+			"old.stage": "Lead",
+			"amount":    1200.0,
+			"qty":       "3",
+			"name":      "Acme Inc.",
+			"empty":     nil,
+		}
+
+		resolve = func(ident string) (interface{}, error) {
+			if v, ok := values[ident]; ok {
+				return v, nil
+			}
+
+			return nil, fmt.Errorf("unknown %q", ident)
+		}
+
+		tc = []struct {
+			expr string
+			out  bool
+			err  string
+		}{
+			{expr: "stage = 'Won'", out: true},
+			{expr: "stage <> 'Won'", out: false},
+			{expr: "stage = 'Won' AND old.stage != 'Won'", out: true},
+			{expr: "stage = 'Lost' OR amount > 1000", out: true},
+			{expr: "amount >= 1000 + 200", out: true},
+			{expr: "amount * 2 < 2000", out: false},
+			{expr: "qty = 3", out: true},
+			{expr: "qty > 10", out: false},
+			{expr: "amount > 0 AND NOT stage = 'Won'", out: false},
+			{expr: "amount > 0 AND NOT (stage = 'Lost')", out: true},
+			{expr: "stage = 'Won' AND NOT (amount < 100)", out: true},
+			{expr: "name LIKE 'acme%'", out: true},
+			{expr: "name NOT LIKE '%inc_'", out: false},
+			{expr: "stage IN ('Won', 'Lost')", out: true},
+			{expr: "stage NOT IN ('Won', 'Lost')", out: false},
+			{expr: "stage IS NOT old.stage", out: true},
+			{expr: "stage IS stage", out: true},
+			{expr: "empty IS NULL", out: true},
+			{expr: "empty IS NOT NULL", out: false},
+			{expr: "stage IS NOT empty", out: true},
+			{expr: "empty = 'Won'", out: false},
+			{expr: "empty != 'Won'", out: false},
+			{expr: "stage = 'Won' AND NOT empty = 'Won'", out: false},
+			{expr: "stage = 'Lost' OR NOT empty = 'Won'", out: false},
+			{expr: "empty = 'Won' OR stage = 'Won'", out: true},
+			{expr: "missing = 1", err: `unknown "missing"`},
+			{expr: "stage AND amount", err: `expecting boolean, got "Won"`},
+			{expr: "stage 'Won'", err: `missing operator before "\"Won\""`},
+		}
+	)
+
+	for _, c := range tc {
+		n, err := NewParser().ParseExpression(c.expr)
+		require.NoError(t, err, c.expr)
+
+		out, err := EvaluateCondition(n, resolve)
+		if c.err != "" {
+			require.EqualError(t, err, c.err, c.expr)
+			continue
+		}
+
+		require.NoError(t, err, c.expr)
+		require.Equal(t, c.out, out, c.expr)
+	}
+}
@@ -0,0 +1,73 @@
+package ql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluate(t *testing.T) {
+	var (
+		values = map[string]interface{}{
+			"qty":   3.0,
+			"price": 2.5,
+			"first": "John",
+			"last":  "Doe",
+			"empty": nil,
+		}
+
+		resolve = func(ident string) (interface{}, error) {
+			if v, ok := values[ident]; ok {
+				return v, nil
+			}
+
+			return nil, fmt.Errorf("unknown %q", ident)
+		}
+
+		tc = []struct {
+			expr string
+			out  interface{}
+			err  string
+		}{
+			{expr: "qty * price", out: 7.5},
+			{expr: "qty + price * 2", out: 8.0},
+			{expr: "(qty + price) * 2", out: 11.0},
+			{expr: "qty - 1 - 1", out: 1.0},
+			{expr: "qty * -1", out: -3.0},
+			{expr: "qty / 0", out: nil},
+			{expr: "qty * empty", out: nil},
+			{expr: "first + ' ' + last", out: "John Doe"},
+			{expr: "CONCAT(first, ' ', empty, qty)", out: "John 3"},
+			{expr: "COALESCE(empty, first)", out: "John"},
+			{expr: "ROUND(price * 1.33, 1)", out: 3.3},
+			{expr: "UPPER(last)", out: "DOE"},
+			{expr: "first * 2", err: `expecting number, got "John"`},
+			{expr: "missing + 1", err: `unknown "missing"`},
+			{expr: "NOW()", err: `unsupported function "NOW"`},
+			{expr: "qty = 2", err: `unsupported operator "="`},
+		}
+	)
+
+	for _, c := range tc {
+		p := NewParser()
+		n, err := p.ParseExpression(c.expr)
+		require.NoError(t, err, c.expr)
+
+		out, err := Evaluate(n, resolve)
+		if c.err != "" {
+			require.EqualError(t, err, c.err, c.expr)
+			continue
+		}
+
+		require.NoError(t, err, c.expr)
+		require.Equal(t, c.out, out, c.expr)
+	}
+}
+
+func TestEvalToString(t *testing.T) {
+	require.Equal(t, "", EvalToString(nil))
+	require.Equal(t, "7.5", EvalToString(7.5))
+	require.Equal(t, "42", EvalToString(42.0))
+	require.Equal(t, "foo", EvalToString("foo"))
+}
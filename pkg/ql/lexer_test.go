@@ -31,10 +31,12 @@ func TestScanner_ScanSimple(t *testing.T) {
 		{s: `'escaped \' quote'`, tok: STRING, lit: "escaped ' quote"},
 		{s: `'double \\ escape'`, tok: STRING, lit: "double \\ escape"},
 		{s: `12345`, tok: NUMBER, lit: "12345"},
+		{s: `12.50`, tok: NUMBER, lit: "12.50"},
 
 		// Identifiers
 		{s: `foo`, tok: IDENT, lit: `foo`},
 		{s: `Zx12_3U_-`, tok: IDENT, lit: `Zx12_3U_`},
+		{s: `account.name`, tok: IDENT, lit: `account.name`},
 
 		// Parenthesis
 		{s: `(`, tok: PARENTHESIS_OPEN, lit: `(`},
@@ -0,0 +1,69 @@
+package ql
+
+import (
+	"strings"
+)
+
+// RenameIdent replaces identifier in the expression with another one
+//
+// Paths that start with the identifier (<from>.<field>) are renamed as well.
+// Expression is not parsed; string literals are skipped and everything else
+// (whitespace, formatting) is kept as-is
+func RenameIdent(expr, from, to string) string {
+	var (
+		out   strings.Builder
+		rr    = []rune(expr)
+		quote = []rune(CHAR_WHITELIST_QUOTES)[0]
+	)
+
+	for i := 0; i < len(rr); {
+		switch ch := rr[i]; {
+		case ch == quote:
+			// Copy string literal, including quotes & escaped chars
+			j := i + 1
+			for ; j < len(rr) && rr[j] != quote; j++ {
+				if rr[j] == '\\' {
+					j++
+				}
+			}
+
+			if j < len(rr) {
+				j++
+			}
+
+			out.WriteString(string(rr[i:j]))
+			i = j
+
+		case isLetter(ch):
+			j := i + 1
+			for ; j < len(rr) && (isLetter(rr[j]) || isDigit(rr[j]) || rr[j] == '_' || rr[j] == '.'); j++ {
+			}
+
+			ident := string(rr[i:j])
+			if ident == from {
+				ident = to
+			} else if strings.HasPrefix(ident, from+".") {
+				ident = to + ident[len(from):]
+			}
+
+			out.WriteString(ident)
+			i = j
+
+		case isDigit(ch):
+			// Numbers are consumed whole so that their tails are not
+			// mistaken for identifiers (1e5)
+			j := i + 1
+			for ; j < len(rr) && (isLetter(rr[j]) || isDigit(rr[j])); j++ {
+			}
+
+			out.WriteString(string(rr[i:j]))
+			i = j
+
+		default:
+			out.WriteRune(ch)
+			i++
+		}
+	}
+
+	return out.String()
+}
@@ -0,0 +1,23 @@
+package ql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenameIdent(t *testing.T) {
+	tc := []struct{ expr, out string }{
+		{"foo = 1", "bar = 1"},
+		{"foo", "bar"},
+		{"foo1 = 1 AND foo_bar = foo", "foo1 = 1 AND foo_bar = bar"},
+		{"foo.name LIKE 'foo%' AND x = 'it\\'s foo'", "bar.name LIKE 'foo%' AND x = 'it\\'s foo'"},
+		{"account.foo = 2", "account.foo = 2"},
+		{"QUARTER(foo)   DESC,   foo", "QUARTER(bar)   DESC,   bar"},
+		{"x = 'unclosed foo", "x = 'unclosed foo"},
+	}
+
+	for _, c := range tc {
+		require.Equal(t, c.out, RenameIdent(c.expr, "foo", "bar"), c.expr)
+	}
+}
@@ -71,10 +71,12 @@ func (TokenConsumerIdent) Consume(s RuneReader) Token {
 
 	// Read every subsequent ident character into the buffer.
 	// Non-ident characters and EOF will cause the loop to exit.
+	//
+	// Dots are allowed to support paths (ref.field)
 	for {
 		if ch := s.read(); ch == eof {
 			break
-		} else if !isLetter(ch) && !isDigit(ch) && ch != '_' {
+		} else if !isLetter(ch) && !isDigit(ch) && ch != '_' && ch != '.' {
 			s.unread()
 			break
 		} else {
@@ -130,14 +132,20 @@ func (str TokenConsumerNumber) Test(ch rune) bool {
 }
 
 // Consumes entire number (very naive and simplified)
+//
+// Decimal point is allowed once (1.5)
 func (str TokenConsumerNumber) Consume(s RuneReader) Token {
 	// Create a buffer and read the current character into it.
 	var buf bytes.Buffer
+	var decimal = false
 	buf.WriteRune(s.read())
 
 	for {
 		if ch := s.read(); ch == eof {
 			break
+		} else if ch == '.' && !decimal {
+			decimal = true
+			_, _ = buf.WriteRune(ch)
 		} else if !isDigit(ch) {
 			s.unread()
 			break
@@ -0,0 +1,311 @@
+package rh
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lann/builder"
+	"github.com/pkg/errors"
+	"github.com/titpetric/factory"
+
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
+	"github.com/cortezaproject/corteza-server/pkg/ql"
+)
+
+type (
+	// PagingCursor points to the last (or, when reversed, the first) row of a page
+	//
+	// Holds values of all keyset expressions of that row and
+	// is passed to (and from) API in an encoded (opaque) form
+	PagingCursor struct {
+		values  []interface{}
+		reverse bool
+	}
+
+	// KeysetKey is one of the expressions rows are ordered by
+	KeysetKey struct {
+		Expr string
+		Desc bool
+	}
+
+	// Keyset is a list of expressions rows are ordered by
+	//
+	// Last expression must be unique (primary key)
+	Keyset []KeysetKey
+
+	pagingCursorJSON struct {
+		Values  []interface{} `json:"v"`
+		Reverse bool          `json:"r,omitempty"`
+	}
+)
+
+const (
+	keysetValueTimeLayout = "2006-01-02 15:04:05.999999"
+)
+
+// ParsePagingCursor decodes cursor as returned in the nextPage/prevPage
+//
+// Returns nil for empty string
+func ParsePagingCursor(s string) (*PagingCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var (
+		aux = pagingCursorJSON{}
+	)
+
+	if b, err := base64.RawURLEncoding.DecodeString(s); err != nil {
+		return nil, errors.New("invalid page cursor")
+	} else if err = json.Unmarshal(b, &aux); err != nil || len(aux.Values) == 0 {
+		return nil, errors.New("invalid page cursor")
+	}
+
+	return &PagingCursor{values: aux.Values, reverse: aux.Reverse}, nil
+}
+
+// String returns encoded cursor
+func (c *PagingCursor) String() string {
+	b, _ := json.Marshal(pagingCursorJSON{Values: c.values, Reverse: c.reverse})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func (c *PagingCursor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+func (c *PagingCursor) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if aux, err := ParsePagingCursor(s); err != nil {
+		return err
+	} else if aux != nil {
+		*c = *aux
+	}
+
+	return nil
+}
+
+// KeysetFromColumns converts parsed order-by columns (<expr> [ASC|DESC]) to keyset
+//
+// Unique expression (primary key) is appended when not already among the columns
+func KeysetFromColumns(cc ql.Columns, unique string) (ks Keyset) {
+	var hasUnique bool
+
+	for _, c := range cc {
+		var (
+			nodes = c.Expr
+			key   = KeysetKey{}
+		)
+
+		if l := len(nodes); l > 1 {
+			if kw, ok := nodes[l-1].(ql.Keyword); ok {
+				switch strings.ToUpper(kw.Keyword) {
+				case "DESC":
+					key.Desc = true
+					nodes = nodes[:l-1]
+				case "ASC":
+					nodes = nodes[:l-1]
+				}
+			}
+		}
+
+		key.Expr = strings.TrimSpace(nodes.String())
+		hasUnique = hasUnique || key.Expr == unique
+		ks = append(ks, key)
+	}
+
+	if !hasUnique {
+		ks = append(ks, KeysetKey{Expr: unique})
+	}
+
+	return
+}
+
+// OrderBy returns order-by terms; direction of all keys is flipped when reversed
+//
+// NULLs are always sorted as the smallest values so that Seek can handle them
+// the same way on all databases
+func (ks Keyset) OrderBy(d dbx.Dialect, reverse bool) []string {
+	var oo = make([]string, len(ks))
+	for i, k := range ks {
+		oo[i] = d.OrderBy(k.Expr, k.Desc != reverse)
+	}
+
+	return oo
+}
+
+// Seek returns condition for rows that come after the cursor
+// (or before, for reversed cursors)
+func (ks Keyset) Seek(c *PagingCursor) (squirrel.Sqlizer, error) {
+	if len(c.values) != len(ks) {
+		return nil, errors.New("page cursor does not match sorting")
+	}
+
+	var (
+		or = squirrel.Or{}
+	)
+
+	for i, k := range ks {
+		var (
+			and  = squirrel.And{}
+			desc = k.Desc != c.reverse
+			v    = c.values[i]
+		)
+
+		for j := 0; j < i; j++ {
+			if c.values[j] == nil {
+				and = append(and, squirrel.Expr(ks[j].Expr+" IS NULL"))
+			} else {
+				and = append(and, squirrel.Expr(ks[j].Expr+" = ?", c.values[j]))
+			}
+		}
+
+		switch {
+		case v == nil && desc:
+			// NULL is the smallest value, nothing comes after it
+			continue
+		case v == nil:
+			and = append(and, squirrel.Expr(k.Expr+" IS NOT NULL"))
+		case desc && i == len(ks)-1:
+			// Unique key is never NULL
+			and = append(and, squirrel.Expr(k.Expr+" < ?", v))
+		case desc:
+			and = append(and, squirrel.Or{
+				squirrel.Expr(k.Expr+" < ?", v),
+				squirrel.Expr(k.Expr + " IS NULL"),
+			})
+		default:
+			and = append(and, squirrel.Expr(k.Expr+" > ?", v))
+		}
+
+		or = append(or, and)
+	}
+
+	if len(or) == 0 {
+		// Cursor points at the very end
+		return squirrel.Expr("1 = 0"), nil
+	}
+
+	return or, nil
+}
+
+// FetchCursorPaged fetches paged rows and orders them by keyset
+//
+// When filter holds a cursor, rows that come after it (or before, for
+// reversed cursors) are fetched, otherwise page number is used.
+//
+// Returns flags that tell if there are rows before and after the fetched page
+// so that caller can prepare cursors (see KeysetCursor)
+func FetchCursorPaged(db *factory.DB, q squirrel.SelectBuilder, ks Keyset, f PageFilter, set interface{}) (hasPrev, hasNext bool, err error) {
+	var (
+		cur     = f.PageCursor
+		reverse = cur != nil && cur.reverse
+	)
+
+	q = q.OrderBy(ks.OrderBy(dbx.DialectOf(db), reverse)...)
+
+	if cur != nil {
+		var seek squirrel.Sqlizer
+		if seek, err = ks.Seek(cur); err != nil {
+			return
+		}
+
+		q = q.Where(seek)
+	} else if f.Page > 1 && f.PerPage > 0 {
+		q = q.Offset(uint64((f.Page - 1) * f.PerPage))
+		hasPrev = true
+	}
+
+	if f.PerPage > 0 {
+		// Fetch one more to see if there is another page
+		q = q.Limit(uint64(f.PerPage) + 1)
+	}
+
+	if err = FetchAll(db, q, set); err != nil {
+		return
+	}
+
+	var (
+		rv    = reflect.ValueOf(set).Elem()
+		more  = f.PerPage > 0 && uint(rv.Len()) > f.PerPage
+		swap  = reflect.Swapper(rv.Interface())
+		count = rv.Len()
+	)
+
+	if more {
+		count--
+		rv.Set(rv.Slice(0, count))
+	}
+
+	if reverse {
+		for i := 0; i < count/2; i++ {
+			swap(i, count-1-i)
+		}
+
+		return more, true, nil
+	}
+
+	return hasPrev || cur != nil, more, nil
+}
+
+// KeysetCursor returns cursor that points to the row with the given (unique) key
+//
+// Query (base query, without order, limit and offset) is used to read the values of
+// all keyset expressions of that row
+func KeysetCursor(db *factory.DB, q squirrel.SelectBuilder, ks Keyset, key interface{}, reverse bool) (*PagingCursor, error) {
+	q = builder.Delete(q, "Columns").(squirrel.SelectBuilder)
+	q = builder.Delete(q, "OrderBys").(squirrel.SelectBuilder)
+
+	for _, k := range ks {
+		q = q.Column(k.Expr)
+	}
+
+	q = q.Where(ks[len(ks)-1].Expr+" = ?", key).Limit(1)
+
+	var (
+		qr sqlx.Queryer = db.DB
+		vv []interface{}
+	)
+
+	if db.Tx != nil {
+		qr = db.Tx
+	}
+
+	if sql, args, err := q.ToSql(); err != nil {
+		return nil, err
+	} else if vv, err = qr.QueryRowx(sql, args...).SliceScan(); err != nil {
+		return nil, errors.Wrap(err, "could not read page cursor values")
+	}
+
+	for i := range vv {
+		vv[i] = keysetValue(vv[i])
+	}
+
+	return &PagingCursor{values: vv, reverse: reverse}, nil
+}
+
+// keysetValue converts scanned value to string (or nil) so that it survives encoding
+func keysetValue(v interface{}) interface{} {
+	switch c := v.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return string(c)
+	case string:
+		return c
+	case time.Time:
+		return c.Format(keysetValueTimeLayout)
+	default:
+		return fmt.Sprintf("%v", c)
+	}
+}
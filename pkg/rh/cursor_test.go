@@ -0,0 +1,120 @@
+package rh
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
+	"github.com/cortezaproject/corteza-server/pkg/ql"
+)
+
+func TestPagingCursorEncoding(t *testing.T) {
+	var (
+		r = require.New(t)
+
+		cur = &PagingCursor{values: []interface{}{"foo", nil, "42"}, reverse: true}
+		aux = struct {
+			Next *PagingCursor `json:"next"`
+		}{}
+	)
+
+	dec, err := ParsePagingCursor(cur.String())
+	r.NoError(err)
+	r.Equal(cur, dec)
+
+	b, err := json.Marshal(struct {
+		Next *PagingCursor `json:"next"`
+	}{cur})
+	r.NoError(err)
+	r.NoError(json.Unmarshal(b, &aux))
+	r.Equal(cur, aux.Next)
+
+	dec, err = ParsePagingCursor("")
+	r.NoError(err)
+	r.Nil(dec)
+
+	_, err = ParsePagingCursor("not-a-cursor")
+	r.Error(err)
+}
+
+func TestKeysetFromColumns(t *testing.T) {
+	var (
+		r = require.New(t)
+		p = ql.NewParser()
+	)
+
+	p.OnIdent = func(i ql.Ident) (ql.Ident, error) {
+		i.Value = "t." + i.Value + " "
+		return i, nil
+	}
+
+	cc, err := p.ParseColumns("name DESC, createdAt")
+	r.NoError(err)
+	r.Equal(
+		Keyset{{Expr: "t.name", Desc: true}, {Expr: "t.createdAt"}, {Expr: "t.id"}},
+		KeysetFromColumns(cc, "t.id"),
+	)
+
+	cc, err = p.ParseColumns("id DESC")
+	r.NoError(err)
+	r.Equal(Keyset{{Expr: "t.id", Desc: true}}, KeysetFromColumns(cc, "t.id"))
+}
+
+func TestKeysetSeek(t *testing.T) {
+	var (
+		r  = require.New(t)
+		ks = Keyset{{Expr: "a", Desc: true}, {Expr: "id"}}
+
+		tc = []struct {
+			cur  *PagingCursor
+			sql  string
+			args []interface{}
+		}{
+			{
+				cur:  &PagingCursor{values: []interface{}{"x", "5"}},
+				sql:  "(((a < ? OR a IS NULL)) OR (a = ? AND id > ?))",
+				args: []interface{}{"x", "x", "5"},
+			},
+			{
+				cur:  &PagingCursor{values: []interface{}{"x", "5"}, reverse: true},
+				sql:  "((a > ?) OR (a = ? AND id < ?))",
+				args: []interface{}{"x", "x", "5"},
+			},
+			{
+				cur:  &PagingCursor{values: []interface{}{nil, "5"}},
+				sql:  "((a IS NULL AND id > ?))",
+				args: []interface{}{"5"},
+			},
+			{
+				cur:  &PagingCursor{values: []interface{}{nil, "5"}, reverse: true},
+				sql:  "((a IS NOT NULL) OR (a IS NULL AND id < ?))",
+				args: []interface{}{"5"},
+			},
+		}
+	)
+
+	for _, c := range tc {
+		cnd, err := ks.Seek(c.cur)
+		r.NoError(err)
+
+		sql, args, err := cnd.ToSql()
+		r.NoError(err)
+		r.Equal(c.sql, sql)
+		r.Equal(c.args, args)
+	}
+
+	_, err := ks.Seek(&PagingCursor{values: []interface{}{"5"}})
+	r.Error(err)
+}
+
+func TestKeysetOrderBy(t *testing.T) {
+	var (
+		ks = Keyset{{Expr: "a", Desc: true}, {Expr: "id"}}
+		d  = dbx.DialectFor("")
+	)
+
+	require.Equal(t, []string{"a DESC", "id ASC"}, ks.OrderBy(d, false))
+	require.Equal(t, []string{"a ASC", "id DESC"}, ks.OrderBy(d, true))
+}
@@ -0,0 +1,22 @@
+package rh
+
+import (
+	"github.com/titpetric/factory"
+
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
+)
+
+// Insert inserts payload (struct with db tags) into the table
+//
+// Replacement for factory.DB.Insert that works with all supported databases
+func Insert(db *factory.DB, table string, payload interface{}) error {
+	return dbx.DialectOf(db).Insert(db, table, payload)
+}
+
+// Upsert inserts or replaces payload (struct with db tags)
+//
+// Replacement for factory.DB.Replace that works with all supported databases;
+// keys (usually primary key columns) are used for detecting conflicts.
+func Upsert(db *factory.DB, table string, payload interface{}, keys ...string) error {
+	return dbx.DialectOf(db).Upsert(db, table, payload, keys...)
+}
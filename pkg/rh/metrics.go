@@ -5,6 +5,8 @@ import (
 
 	"github.com/Masterminds/squirrel"
 	"github.com/titpetric/factory"
+
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
 )
 
 // DailyMetrics aids repositories on simple stat building queries
@@ -19,7 +21,7 @@ func DailyMetrics(db *factory.DB, q squirrel.SelectBuilder, field string) (rval
 	)
 
 	q = q.
-		Column(fmt.Sprintf("UNIX_TIMESTAMP(DATE(%s)) timestamp", field)).
+		Column(dbx.DialectOf(db).DayTimestamp(field) + " timestamp").
 		Column("COUNT(*) AS value").
 		Where(fmt.Sprintf("%s IS NOT NULL", field)).
 		GroupBy("timestamp")
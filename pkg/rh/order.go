@@ -26,13 +26,22 @@ func NormalizeSortColumns(sort string) string {
 }
 
 func ParseOrder(order string, valid ...string) (out []string, err error) {
+	var sc ql.Columns
+	if sc, err = ParseOrderColumns(order, valid...); err != nil {
+		return
+	}
+
+	return sc.Strings(), nil
+}
+
+// ParseOrderColumns parses and validates order-by columns
+//
+// Result can be used for building keyset (see KeysetFromColumns)
+func ParseOrderColumns(order string, valid ...string) (sc ql.Columns, err error) {
 	var (
 		// Sort parser
 		sp = ql.NewParser()
 
-		// Sort columns
-		sc ql.Columns
-
 		whitelist = map[string]bool{}
 	)
 
@@ -53,11 +62,5 @@ func ParseOrder(order string, valid ...string) (out []string, err error) {
 		return i, nil
 	}
 
-	if sc, err = sp.ParseColumns(order); err != nil {
-		return
-	}
-
-	out = sc.Strings()
-
-	return
+	return sp.ParseColumns(order)
 }
@@ -11,6 +11,16 @@ type (
 		Page    uint `json:"page"`
 		PerPage uint `json:"perPage"`
 		Count   uint `json:"count"`
+
+		// Cursor paging; page number is ignored when cursor is set
+		//
+		// Next & previous page cursors are set by repositories that support it
+		PageCursor *PagingCursor `json:"pageCursor,omitempty"`
+		NextPage   *PagingCursor `json:"nextPage,omitempty"`
+		PrevPage   *PagingCursor `json:"prevPage,omitempty"`
+
+		// Do not count all matching rows (Count is left at 0)
+		SkipCount bool `json:"skipCount,omitempty"`
 	}
 )
 
@@ -6,10 +6,10 @@ import (
 	"strconv"
 
 	"github.com/spf13/cobra"
-	"github.com/titpetric/factory"
 
 	"github.com/cortezaproject/corteza-server/pkg/auth"
 	"github.com/cortezaproject/corteza-server/pkg/cli"
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
 	"github.com/cortezaproject/corteza-server/system/auth/external"
 	"github.com/cortezaproject/corteza-server/system/repository"
 	"github.com/cortezaproject/corteza-server/system/service"
@@ -73,7 +73,7 @@ func Auth(ctx context.Context, c *cli.Config) *cobra.Command {
 		Run: func(cmd *cobra.Command, args []string) {
 
 			var (
-				db = factory.Database.MustGet("system")
+				db = dbx.MustGet("system")
 
 				userRepo = repository.User(ctx, db)
 				roleRepo = repository.Role(ctx, db)
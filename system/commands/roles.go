@@ -6,9 +6,9 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
-	"github.com/titpetric/factory"
 
 	"github.com/cortezaproject/corteza-server/pkg/cli"
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
 	"github.com/cortezaproject/corteza-server/system/repository"
 	"github.com/cortezaproject/corteza-server/system/types"
 )
@@ -26,7 +26,7 @@ func Roles(ctx context.Context, c *cli.Config) *cobra.Command {
 		Run: func(cmd *cobra.Command, args []string) {
 			// Create role and user repository.
 			var (
-				db = factory.Database.MustGet("system")
+				db = dbx.MustGet("system")
 
 				roleStr, userStr = args[0], args[1]
 
@@ -6,10 +6,10 @@ import (
 	"syscall"
 
 	"github.com/spf13/cobra"
-	"github.com/titpetric/factory"
 	"golang.org/x/crypto/ssh/terminal"
 
 	"github.com/cortezaproject/corteza-server/pkg/cli"
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
 	"github.com/cortezaproject/corteza-server/system/repository"
 	"github.com/cortezaproject/corteza-server/system/service"
 	"github.com/cortezaproject/corteza-server/system/types"
@@ -34,7 +34,7 @@ func Users(ctx context.Context, c *cli.Config) *cobra.Command {
 			c.InitServices(ctx, c)
 
 			var (
-				db = factory.Database.MustGet("system")
+				db = dbx.MustGet("system")
 			)
 
 			userRepo := repository.User(ctx, db)
@@ -74,7 +74,7 @@ func Users(ctx context.Context, c *cli.Config) *cobra.Command {
 			c.InitServices(ctx, c)
 
 			var (
-				db = factory.Database.MustGet("system")
+				db = dbx.MustGet("system")
 
 				userRepo = repository.User(ctx, db)
 				authSvc  = service.Auth(ctx)
@@ -129,7 +129,7 @@ func Users(ctx context.Context, c *cli.Config) *cobra.Command {
 			c.InitServices(ctx, c)
 
 			var (
-				db = factory.Database.MustGet("system")
+				db = dbx.MustGet("system")
 
 				userRepo = repository.User(ctx, db)
 				authSvc  = service.Auth(ctx)
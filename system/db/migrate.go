@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -13,7 +14,10 @@ import (
 	"github.com/titpetric/factory"
 	"go.uber.org/zap"
 
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
+	"github.com/cortezaproject/corteza-server/pkg/rh"
 	"github.com/cortezaproject/corteza-server/system/db/mysql"
+	"github.com/cortezaproject/corteza-server/system/db/postgres"
 )
 
 func statements(contents []byte, err error) ([]string, error) {
@@ -26,7 +30,16 @@ func statements(contents []byte, err error) ([]string, error) {
 func Migrate(db *factory.DB, log *zap.Logger) error {
 	log = log.Named("database.migrations")
 
-	statikFS, err := fs.New(mysql.Asset)
+	var (
+		dialect = dbx.DialectOf(db)
+		asset   = mysql.Asset
+	)
+
+	if dialect.Name() == dbx.DialectPostgres {
+		asset = postgres.Asset
+	}
+
+	statikFS, err := fs.New(asset)
 	if err != nil {
 		return errors.Wrap(err, "error creating statik filesystem")
 	}
@@ -93,7 +106,7 @@ func Migrate(db *factory.DB, log *zap.Logger) error {
 			status.Status = err.Error()
 		}
 		if useLog {
-			if err := db.Replace("migrations", status); err != nil {
+			if err := rh.Upsert(db, "migrations", status, "project", "filename"); err != nil {
 				return errors.Wrap(err, "migration update failed")
 			}
 		}
@@ -104,8 +117,13 @@ func Migrate(db *factory.DB, log *zap.Logger) error {
 		return err
 	}
 
-	db.Exec("LOCK TABLE migrations WRITE;")
-	defer db.Exec("UNLOCK TABLES")
+	// Instances that start at the same time run migrations one after another
+	unlock, err := dbx.Lock(context.Background(), db, "corteza.migrations")
+	if err != nil {
+		return errors.Wrap(err, "could not lock migrations")
+	}
+
+	defer unlock()
 
 	for _, filename := range files {
 		if err := migrate(filename, true); err != nil {
@@ -0,0 +1,2 @@
+ALTER TABLE `sys_automation_trigger`
+  ADD `expression` TEXT NOT NULL COMMENT 'Additional condition (ql expression) checked before the script is run' AFTER `event_condition`;
@@ -0,0 +1,201 @@
+-- PostgreSQL schema, equivalent to all MySQL migrations up to 20191023213030
+
+CREATE TABLE sys_organisation (
+  id               BIGINT       NOT NULL,
+  fqn              TEXT         NOT NULL, -- fully qualified name of the organisation
+  name             TEXT         NOT NULL, -- display name of the organisation
+
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at       TIMESTAMPTZ      NULL,
+  archived_at      TIMESTAMPTZ      NULL,
+  deleted_at       TIMESTAMPTZ      NULL, -- organisation soft delete
+
+  PRIMARY KEY (id)
+);
+
+CREATE TABLE sys_user (
+  id               BIGINT       NOT NULL,
+  email            TEXT         NOT NULL,
+  email_confirmed  BOOLEAN      NOT NULL DEFAULT FALSE,
+  username         TEXT         NOT NULL,
+  name             TEXT         NOT NULL,
+  handle           TEXT         NOT NULL,
+  kind             VARCHAR(8)   NOT NULL DEFAULT '',
+  meta             JSONB        NOT NULL,
+
+  rel_organisation BIGINT       NOT NULL,
+  rel_user_id      BIGINT       NOT NULL,
+
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at       TIMESTAMPTZ      NULL,
+  suspended_at     TIMESTAMPTZ      NULL,
+  deleted_at       TIMESTAMPTZ      NULL, -- user soft delete
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX sys_user_rel_user_id ON sys_user (rel_user_id);
+
+CREATE TABLE sys_role (
+  id               BIGINT       NOT NULL,
+  name             TEXT         NOT NULL, -- display name of the role
+  handle           TEXT         NOT NULL, -- role handle string
+
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at       TIMESTAMPTZ      NULL,
+  archived_at      TIMESTAMPTZ      NULL,
+  deleted_at       TIMESTAMPTZ      NULL, -- role soft delete
+
+  PRIMARY KEY (id)
+);
+
+CREATE TABLE sys_role_member (
+  rel_role         BIGINT       NOT NULL,
+  rel_user         BIGINT       NOT NULL,
+
+  PRIMARY KEY (rel_role, rel_user)
+);
+
+INSERT INTO sys_role (id, name, handle) VALUES
+  (1, 'Everyone', 'everyone'),
+  (2, 'Administrators', 'admins');
+
+CREATE TABLE sys_credentials (
+  id               BIGINT       NOT NULL,
+  rel_owner        BIGINT       NOT NULL,
+  label            TEXT         NOT NULL, -- something we can differentiate credentials by
+  kind             VARCHAR(128) NOT NULL, -- hash, facebook, google, github, linkedin ...
+  credentials      TEXT         NOT NULL, -- crypted/hashed passwords, secrets, social profile ID
+  meta             JSONB        NOT NULL,
+  expires_at       TIMESTAMPTZ      NULL,
+
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at       TIMESTAMPTZ      NULL,
+  deleted_at       TIMESTAMPTZ      NULL, -- credentials soft delete
+  last_used_at     TIMESTAMPTZ      NULL,
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX sys_credentials_rel_owner ON sys_credentials (rel_owner);
+
+CREATE TABLE sys_application (
+  id               BIGINT       NOT NULL,
+  rel_owner        BIGINT       NOT NULL,
+  name             TEXT         NOT NULL, -- something we can differentiate application by
+  enabled          BOOLEAN      NOT NULL,
+
+  unify            JSONB            NULL, -- unify specific settings
+
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at       TIMESTAMPTZ      NULL,
+  deleted_at       TIMESTAMPTZ      NULL, -- application soft delete
+
+  PRIMARY KEY (id)
+);
+
+INSERT INTO sys_application (id, name, enabled, rel_owner, unify) VALUES
+( 1, 'Crust Messaging', true, 0,
+  '{"logo": "/applications/crust.jpg", "icon": "/applications/crust_favicon.png", "url": "/messaging/", "listed": true}'
+),
+( 2, 'Crust Compose', true, 0,
+  '{"logo": "/applications/crust.jpg", "icon": "/applications/crust_favicon.png", "url": "/compose/", "listed": true}'
+),
+( 3, 'Crust Admin Area', true, 0,
+  '{"logo": "/applications/crust.jpg", "icon": "/applications/crust_favicon.png", "url": "/admin/", "listed": true}'
+),
+( 4, 'Corteza Jitsi Bridge', true, 0,
+  '{"logo": "/applications/jitsi.png", "icon": "/applications/jitsi_icon.png", "url": "/bridge/jitsi/", "listed": true}'
+),
+( 5, 'Google Maps', true, 0,
+  '{"logo": "/applications/google_maps.png", "icon": "/applications/google_maps_icon.png", "url": "/bridge/google-maps/", "listed": true}'
+);
+
+CREATE TABLE sys_settings (
+  rel_owner        BIGINT       NOT NULL DEFAULT 0, -- Value owner, 0 for global settings
+  name             VARCHAR(200) NOT NULL,           -- Unique set of setting keys
+  value            JSONB,                           -- Setting value
+
+  updated_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP, -- When was the value updated
+  updated_by       BIGINT       NOT NULL DEFAULT 0,                 -- Who created/updated the value
+
+  PRIMARY KEY (name, rel_owner)
+);
+
+CREATE TABLE sys_permission_rules (
+  rel_role         BIGINT       NOT NULL,
+  resource         VARCHAR(128) NOT NULL,
+  operation        VARCHAR(128) NOT NULL,
+  access           SMALLINT     NOT NULL,
+
+  PRIMARY KEY (rel_role, resource, operation)
+);
+
+CREATE TABLE sys_automation_script (
+  id               BIGINT       NOT NULL,
+  rel_namespace    BIGINT       NOT NULL DEFAULT 0,         -- For compatibility only, not used
+  name             VARCHAR(64)  NOT NULL DEFAULT 'unnamed', -- The name of the script
+  source           TEXT         NOT NULL,                   -- Source code for the script
+  source_ref       VARCHAR(200) NOT NULL,                   -- Where is the script located (if remote)
+  async            BOOLEAN      NOT NULL DEFAULT FALSE,     -- Do we run this script asynchronously?
+  rel_runner       BIGINT       NOT NULL DEFAULT 0,         -- Who is running the script? 0 for invoker
+  run_in_ua        BOOLEAN      NOT NULL DEFAULT FALSE,     -- Run this script inside user-agent environment
+  timeout          INTEGER      NOT NULL DEFAULT 0,         -- Any explicit timeout set for this script (milliseconds)?
+  critical         BOOLEAN      NOT NULL DEFAULT TRUE,      -- Is it critical that this script is executed successfully
+  enabled          BOOLEAN      NOT NULL DEFAULT TRUE,      -- Is this script enabled?
+
+  created_by       BIGINT       NOT NULL DEFAULT 0,
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_by       BIGINT       NOT NULL DEFAULT 0,
+  updated_at       TIMESTAMPTZ      NULL,
+  deleted_by       BIGINT       NOT NULL DEFAULT 0,
+  deleted_at       TIMESTAMPTZ      NULL,
+
+  PRIMARY KEY (id)
+);
+
+CREATE TABLE sys_automation_trigger (
+  id               BIGINT       NOT NULL,
+  rel_script       BIGINT       NOT NULL REFERENCES sys_automation_script (id), -- Script that is triggered
+
+  resource         VARCHAR(128) NOT NULL,              -- Resource triggering the event
+  event            VARCHAR(128) NOT NULL,              -- Event triggered
+  event_condition  TEXT         NOT NULL,              -- Trigger condition
+  enabled          BOOLEAN      NOT NULL DEFAULT TRUE, -- Trigger enabled?
+
+  weight           INTEGER      NOT NULL DEFAULT 0,
+
+  created_by       BIGINT       NOT NULL DEFAULT 0,
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_by       BIGINT       NOT NULL DEFAULT 0,
+  updated_at       TIMESTAMPTZ      NULL,
+  deleted_by       BIGINT       NOT NULL DEFAULT 0,
+  deleted_at       TIMESTAMPTZ      NULL,
+
+  PRIMARY KEY (id)
+);
+
+CREATE TABLE sys_reminder (
+  id               BIGINT       NOT NULL,
+  resource         VARCHAR(128) NOT NULL,           -- Resource, that this reminder is bound to
+  payload          JSONB        NOT NULL,           -- Payload for this reminder
+  snooze_count     INTEGER      NOT NULL DEFAULT 0, -- Number of times this reminder was snoozed
+
+  assigned_to      BIGINT       NOT NULL DEFAULT 0, -- Assignee for this reminder
+  assigned_by      BIGINT       NOT NULL DEFAULT 0, -- User that assigned this reminder
+  assigned_at      TIMESTAMPTZ  NOT NULL,           -- When the reminder was assigned
+
+  dismissed_by     BIGINT       NOT NULL DEFAULT 0, -- User that dismissed this reminder
+  dismissed_at     TIMESTAMPTZ      NULL,           -- Time the reminder was dismissed
+
+  remind_at        TIMESTAMPTZ      NULL,           -- Time the user should be reminded
+
+  created_by       BIGINT       NOT NULL DEFAULT 0,
+  created_at       TIMESTAMPTZ  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_by       BIGINT       NOT NULL DEFAULT 0,
+  updated_at       TIMESTAMPTZ      NULL,
+  deleted_by       BIGINT       NOT NULL DEFAULT 0,
+  deleted_at       TIMESTAMPTZ      NULL,
+
+  PRIMARY KEY (id)
+);
@@ -0,0 +1,25 @@
+ALTER TABLE sys_automation_script
+  ADD COLUMN max_attempts  INTEGER NOT NULL DEFAULT 0, -- Max attempts of queued execution, 0 for default
+  ADD COLUMN retry_backoff INTEGER NOT NULL DEFAULT 0; -- Delay before first retry (milliseconds), 0 for default
+
+CREATE TABLE sys_automation_script_queue (
+  id                     BIGINT       NOT NULL,
+  rel_script             BIGINT       NOT NULL,
+  resource               VARCHAR(128) NOT NULL DEFAULT '',
+  event                  VARCHAR(128) NOT NULL DEFAULT '',
+  payload                JSONB        NOT NULL, -- Resource the script is executed with
+  rel_user               BIGINT       NOT NULL DEFAULT 0, -- User that invoked the script
+  user_roles             TEXT         NOT NULL DEFAULT '', -- Roles of the invoker (space separated)
+
+  attempts               INTEGER      NOT NULL DEFAULT 0,
+  last_error             TEXT         NOT NULL DEFAULT '',
+
+  created_at             TIMESTAMPTZ  NOT NULL,
+  next_attempt_at        TIMESTAMPTZ  NOT NULL,
+  failed_at              TIMESTAMPTZ      NULL, -- Moved to dead-letter list
+
+  PRIMARY KEY (id)
+);
+
+CREATE INDEX sys_automation_script_queue_next_attempt_at ON sys_automation_script_queue (failed_at, next_attempt_at);
+CREATE INDEX sys_automation_script_queue_script ON sys_automation_script_queue (rel_script);
@@ -0,0 +1,2 @@
+ALTER TABLE sys_automation_trigger
+  ADD COLUMN expression TEXT NOT NULL DEFAULT ''; -- Additional condition (ql expression) checked before the script is run
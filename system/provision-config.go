@@ -6,12 +6,12 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
-	"github.com/titpetric/factory"
 	"go.uber.org/zap"
 	"gopkg.in/yaml.v2"
 
 	"github.com/cortezaproject/corteza-server/pkg/auth"
 	"github.com/cortezaproject/corteza-server/pkg/cli"
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
 	impAux "github.com/cortezaproject/corteza-server/pkg/importer"
 	"github.com/cortezaproject/corteza-server/pkg/permissions"
 	"github.com/cortezaproject/corteza-server/pkg/settings"
@@ -60,7 +60,7 @@ func isProvisioned(ctx context.Context) (bool, error) {
 }
 
 func makeDefaultApplications(ctx context.Context, cmd *cobra.Command, c *cli.Config) error {
-	db, err := factory.Database.Get(system)
+	db, err := dbx.Get(system)
 	if err != nil {
 		return err
 	}
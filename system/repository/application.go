@@ -133,13 +133,13 @@ func (r *application) Create(mod *types.Application) (*types.Application, error)
 	mod.ID = factory.Sonyflake.NextID()
 	mod.CreatedAt = time.Now()
 
-	return mod, r.db().Insert(r.table(), mod)
+	return mod, rh.Insert(r.db(), r.table(), mod)
 }
 
 func (r *application) Update(mod *types.Application) (*types.Application, error) {
 	rh.SetCurrentTimeRounded(&mod.UpdatedAt)
 
-	return mod, r.db().Replace(r.table(), mod)
+	return mod, rh.Upsert(r.db(), r.table(), mod, "id")
 }
 
 func (r *application) DeleteByID(id uint64) error {
@@ -156,8 +156,8 @@ func (r application) Metrics() (rval *types.ApplicationMetrics, err error) {
 		counters = squirrel.
 			Select(
 				"COUNT(*) as total",
-				"SUM(IF(deleted_at IS NULL, 0, 1)) as deleted",
-				"SUM(IF(deleted_at IS NULL, 1, 0)) as valid",
+				"SUM(CASE WHEN deleted_at IS NULL THEN 0 ELSE 1 END) as deleted",
+				"SUM(CASE WHEN deleted_at IS NULL THEN 1 ELSE 0 END) as valid",
 			).
 			From(r.table() + " AS u")
 	)
@@ -95,13 +95,13 @@ func (r *credentials) fetchSet(sql string, args ...interface{}) (cc types.Creden
 func (r *credentials) Create(c *types.Credentials) (*types.Credentials, error) {
 	c.ID = factory.Sonyflake.NextID()
 	c.CreatedAt = time.Now()
-	return c, r.db().Insert(r.tblname, c)
+	return c, rh.Insert(r.db(), r.tblname, c)
 }
 
 func (r *credentials) Update(c *types.Credentials) (*types.Credentials, error) {
 	updatedAt := time.Now()
 	c.UpdatedAt = &updatedAt
-	return c, r.db().Replace(r.tblname, c)
+	return c, rh.Upsert(r.db(), r.tblname, c, "id")
 }
 
 func (r *credentials) DeleteByID(id uint64) error {
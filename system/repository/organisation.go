@@ -77,13 +77,13 @@ func (r *organisation) Create(mod *types.Organisation) (*types.Organisation, err
 	mod.ID = factory.Sonyflake.NextID()
 	mod.CreatedAt = time.Now()
 
-	return mod, r.db().Insert(r.organisations, mod)
+	return mod, rh.Insert(r.db(), r.organisations, mod)
 }
 
 func (r *organisation) Update(mod *types.Organisation) (*types.Organisation, error) {
 	rh.SetCurrentTimeRounded(&mod.UpdatedAt)
 
-	return mod, r.db().Replace(r.organisations, mod)
+	return mod, rh.Upsert(r.db(), r.organisations, mod, "id")
 }
 
 func (r *organisation) ArchiveByID(id uint64) error {
@@ -154,12 +154,12 @@ func (r reminder) Create(mod *types.Reminder) (rm *types.Reminder, err error) {
 	mod.ID = factory.Sonyflake.NextID()
 	mod.CreatedAt = time.Now()
 
-	return mod, r.db().Insert(r.table(), mod)
+	return mod, rh.Insert(r.db(), r.table(), mod)
 }
 
 func (r reminder) Update(mod *types.Reminder) (*types.Reminder, error) {
 	rh.SetCurrentTimeRounded(&mod.UpdatedAt)
-	return mod, r.db().Replace(r.table(), mod)
+	return mod, rh.Upsert(r.db(), r.table(), mod, "id")
 }
 
 func (r reminder) Delete(ID uint64) error {
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/titpetric/factory"
+
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
 )
 
 type (
@@ -15,7 +17,7 @@ type (
 
 // DB produces a contextual DB handle
 func DB(ctx context.Context) *factory.DB {
-	return factory.Database.MustGet("system").With(ctx)
+	return dbx.MustGet("system").With(ctx)
 }
 
 // With updates repository and database contexts
@@ -177,13 +177,13 @@ func (r *role) Create(mod *types.Role) (*types.Role, error) {
 	mod.ID = factory.Sonyflake.NextID()
 	mod.CreatedAt = time.Now()
 
-	return mod, r.db().Insert(r.table(), mod)
+	return mod, rh.Insert(r.db(), r.table(), mod)
 }
 
 func (r *role) Update(mod *types.Role) (*types.Role, error) {
 	rh.SetCurrentTimeRounded(&mod.UpdatedAt)
 
-	return mod, r.db().Replace(r.table(), mod)
+	return mod, rh.Upsert(r.db(), r.table(), mod, "id")
 }
 
 func (r *role) ArchiveByID(id uint64) error {
@@ -227,7 +227,7 @@ func (r *role) MemberAddByID(roleID, userID uint64) error {
 		RoleID: roleID,
 		UserID: userID,
 	}
-	return r.db().Replace(r.tableMember(), mod)
+	return rh.Upsert(r.db(), r.tableMember(), mod, "rel_role", "rel_user")
 }
 
 func (r *role) MemberRemoveByID(roleID, userID uint64) error {
@@ -244,9 +244,9 @@ func (r role) Metrics() (rval *types.RoleMetrics, err error) {
 		counters = squirrel.
 			Select(
 				"COUNT(*) as total",
-				"SUM(IF(deleted_at IS NULL, 0, 1)) as deleted",
-				"SUM(IF(archived_at IS NULL, 0, 1)) as archived",
-				"SUM(IF(deleted_at IS NULL AND archived_at IS NULL, 1, 0)) as valid",
+				"SUM(CASE WHEN deleted_at IS NULL THEN 0 ELSE 1 END) as deleted",
+				"SUM(CASE WHEN archived_at IS NULL THEN 0 ELSE 1 END) as archived",
+				"SUM(CASE WHEN deleted_at IS NULL AND archived_at IS NULL THEN 1 ELSE 0 END) as valid",
 			).
 			From(r.table() + " AS u")
 	)
@@ -9,6 +9,7 @@ import (
 	"github.com/titpetric/factory"
 
 	"github.com/cortezaproject/corteza-server/pkg/permissions"
+	"github.com/cortezaproject/corteza-server/pkg/ql"
 	"github.com/cortezaproject/corteza-server/pkg/rh"
 	"github.com/cortezaproject/corteza-server/system/types"
 )
@@ -128,10 +129,10 @@ func (r user) Find(filter types.UserFilter) (set types.UserSet, f types.UserFilt
 
 	query := r.query()
 
-	// Returns user filter (flt) wrapped in IF() function with cnd as condition (when cnd != nil)
+	// Returns user filter (flt) wrapped in CASE expression with cnd as condition (when cnd != nil)
 	whereMasked := func(cnd *permissions.ResourceFilter, flt squirrel.Sqlizer) squirrel.Sqlizer {
 		if cnd != nil {
-			return rh.SquirrelFunction("IF", cnd, flt, squirrel.Expr("false"))
+			return rh.SquirrelConcatExpr("CASE WHEN ", cnd, " THEN ", flt, " ELSE false END")
 		} else {
 			return flt
 		}
@@ -185,18 +186,43 @@ func (r user) Find(filter types.UserFilter) (set types.UserSet, f types.UserFilt
 		query = query.Where(f.IsReadable)
 	}
 
-	var orderBy []string
-	if orderBy, err = rh.ParseOrder(f.Sort, r.columns()...); err != nil {
+	var (
+		sc ql.Columns
+		ks rh.Keyset
+
+		hasPrev, hasNext bool
+	)
+
+	if sc, err = rh.ParseOrderColumns(f.Sort, r.columns()...); err != nil {
 		return
-	} else {
-		query = query.OrderBy(orderBy...)
 	}
 
-	if f.Count, err = rh.Count(r.db(), query); err != nil || f.Count == 0 {
+	ks = rh.KeysetFromColumns(sc, "u.id")
+	f.PrevPage, f.NextPage = nil, nil
+
+	if !f.SkipCount {
+		if f.Count, err = rh.Count(r.db(), query); err != nil || f.Count == 0 {
+			return
+		}
+	}
+
+	if hasPrev, hasNext, err = rh.FetchCursorPaged(r.db(), query, ks, f.PageFilter, &set); err != nil || len(set) == 0 {
 		return
 	}
 
-	return set, f, rh.FetchPaged(r.db(), query, f.Page, f.PerPage, &set)
+	if hasPrev {
+		if f.PrevPage, err = rh.KeysetCursor(r.db(), query, ks, set[0].ID, true); err != nil {
+			return
+		}
+	}
+
+	if hasNext {
+		if f.NextPage, err = rh.KeysetCursor(r.db(), query, ks, set[len(set)-1].ID, false); err != nil {
+			return
+		}
+	}
+
+	return
 }
 
 func (r user) Total() (count uint) {
@@ -207,12 +233,12 @@ func (r user) Total() (count uint) {
 func (r *user) Create(mod *types.User) (*types.User, error) {
 	mod.ID = factory.Sonyflake.NextID()
 	mod.CreatedAt = time.Now()
-	return mod, r.db().Insert(r.table(), mod)
+	return mod, rh.Insert(r.db(), r.table(), mod)
 }
 
 func (r *user) Update(mod *types.User) (*types.User, error) {
 	rh.SetCurrentTimeRounded(&mod.UpdatedAt)
-	return mod, r.db().Replace(r.table(), mod)
+	return mod, rh.Upsert(r.db(), r.table(), mod, "id")
 }
 
 func (r *user) BindAvatar(user *types.User, avatar io.Reader) (*types.User, error) {
@@ -246,9 +272,9 @@ func (r user) Metrics() (rval *types.UserMetrics, err error) {
 		counters = squirrel.
 			Select(
 				"COUNT(*) as total",
-				"SUM(IF(deleted_at IS NULL, 0, 1)) as deleted",
-				"SUM(IF(suspended_at IS NULL, 0, 1)) as suspended",
-				"SUM(IF(deleted_at IS NULL AND suspended_at IS NULL, 1, 0)) as valid",
+				"SUM(CASE WHEN deleted_at IS NULL THEN 0 ELSE 1 END) as deleted",
+				"SUM(CASE WHEN suspended_at IS NULL THEN 0 ELSE 1 END) as suspended",
+				"SUM(CASE WHEN deleted_at IS NULL AND suspended_at IS NULL THEN 1 ELSE 0 END) as valid",
 			).
 			From(r.table() + " AS u")
 	)
@@ -43,6 +43,16 @@ type (
 		Async    bool                `json:"async"`
 	}
 
+	automationScriptRunSetPayload struct {
+		Filter automation.RunFilter `json:"filter"`
+		Set    automation.RunSet    `json:"set"`
+	}
+
+	automationScriptQueuePayload struct {
+		Filter automation.ExecutionFilter `json:"filter"`
+		Set    automation.ExecutionSet    `json:"set"`
+	}
+
 	AutomationScript struct {
 		scripts automationScriptService
 		runner  automationScriptRunner
@@ -55,6 +65,12 @@ type (
 		Create(context.Context, *automation.Script) error
 		Update(context.Context, *automation.Script) error
 		Delete(context.Context, uint64) error
+
+		FindRuns(context.Context, automation.RunFilter) (automation.RunSet, automation.RunFilter, error)
+
+		FindExecutions(context.Context, automation.ExecutionFilter) (automation.ExecutionSet, automation.ExecutionFilter, error)
+		RequeueExecution(context.Context, uint64, uint64) error
+		DeleteExecution(context.Context, uint64, uint64) error
 	}
 
 	automationScriptRunner interface {
@@ -92,14 +108,16 @@ func (ctrl AutomationScript) List(ctx context.Context, r *request.AutomationScri
 func (ctrl AutomationScript) Create(ctx context.Context, r *request.AutomationScriptCreate) (interface{}, error) {
 	var (
 		script = &automation.Script{
-			Name:      r.Name,
-			SourceRef: r.SourceRef,
-			Source:    r.Source,
-			Async:     r.Async,
-			RunAs:     r.RunAs,
-			Timeout:   r.Timeout,
-			Critical:  r.Critical,
-			Enabled:   r.Enabled,
+			Name:         r.Name,
+			SourceRef:    r.SourceRef,
+			Source:       r.Source,
+			Async:        r.Async,
+			RunAs:        r.RunAs,
+			Timeout:      r.Timeout,
+			Critical:     r.Critical,
+			MaxAttempts:  r.MaxAttempts,
+			RetryBackoff: r.RetryBackoff,
+			Enabled:      r.Enabled,
 		}
 	)
 
@@ -115,15 +133,17 @@ func (ctrl AutomationScript) Read(ctx context.Context, r *request.AutomationScri
 
 func (ctrl AutomationScript) Update(ctx context.Context, r *request.AutomationScriptUpdate) (interface{}, error) {
 	mod := &automation.Script{
-		ID:        r.ScriptID,
-		Name:      r.Name,
-		SourceRef: r.SourceRef,
-		Source:    r.Source,
-		Async:     r.Async,
-		RunAs:     r.RunAs,
-		Timeout:   r.Timeout,
-		Critical:  r.Critical,
-		Enabled:   r.Enabled,
+		ID:           r.ScriptID,
+		Name:         r.Name,
+		SourceRef:    r.SourceRef,
+		Source:       r.Source,
+		Async:        r.Async,
+		RunAs:        r.RunAs,
+		Timeout:      r.Timeout,
+		Critical:     r.Critical,
+		MaxAttempts:  r.MaxAttempts,
+		RetryBackoff: r.RetryBackoff,
+		Enabled:      r.Enabled,
 	}
 
 	mod.AddTrigger(automation.STMS_UPDATE, r.Triggers...)
@@ -147,6 +167,44 @@ func (ctrl AutomationScript) Test(ctx context.Context, r *request.AutomationScri
 	return r.Payload, err
 }
 
+func (ctrl AutomationScript) Runs(ctx context.Context, r *request.AutomationScriptRuns) (interface{}, error) {
+	set, filter, err := ctrl.scripts.FindRuns(ctx, automation.RunFilter{
+		ScriptID: r.ScriptID,
+		Outcome:  automation.RunOutcome(r.Outcome),
+
+		PageFilter: rh.Paging(r.Page, r.PerPage),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &automationScriptRunSetPayload{Filter: filter, Set: set}, nil
+}
+
+func (ctrl AutomationScript) Queue(ctx context.Context, r *request.AutomationScriptQueue) (interface{}, error) {
+	set, filter, err := ctrl.scripts.FindExecutions(ctx, automation.ExecutionFilter{
+		ScriptID:   r.ScriptID,
+		DeadLetter: r.DeadLetter,
+
+		PageFilter: rh.Paging(r.Page, r.PerPage),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &automationScriptQueuePayload{Filter: filter, Set: set}, nil
+}
+
+func (ctrl AutomationScript) Requeue(ctx context.Context, r *request.AutomationScriptRequeue) (interface{}, error) {
+	return resputil.OK(), ctrl.scripts.RequeueExecution(ctx, r.ScriptID, r.ExecutionID)
+}
+
+func (ctrl AutomationScript) Dequeue(ctx context.Context, r *request.AutomationScriptDequeue) (interface{}, error) {
+	return resputil.OK(), ctrl.scripts.DeleteExecution(ctx, r.ScriptID, r.ExecutionID)
+}
+
 func (ctrl AutomationScript) makePayload(ctx context.Context, s *automation.Script, err error) (*automationScriptPayload, error) {
 	if err != nil || s == nil {
 		return nil, err
@@ -35,16 +35,24 @@ type AutomationScriptAPI interface {
 	Update(context.Context, *request.AutomationScriptUpdate) (interface{}, error)
 	Delete(context.Context, *request.AutomationScriptDelete) (interface{}, error)
 	Test(context.Context, *request.AutomationScriptTest) (interface{}, error)
+	Runs(context.Context, *request.AutomationScriptRuns) (interface{}, error)
+	Queue(context.Context, *request.AutomationScriptQueue) (interface{}, error)
+	Requeue(context.Context, *request.AutomationScriptRequeue) (interface{}, error)
+	Dequeue(context.Context, *request.AutomationScriptDequeue) (interface{}, error)
 }
 
 // HTTP API interface
 type AutomationScript struct {
-	List   func(http.ResponseWriter, *http.Request)
-	Create func(http.ResponseWriter, *http.Request)
-	Read   func(http.ResponseWriter, *http.Request)
-	Update func(http.ResponseWriter, *http.Request)
-	Delete func(http.ResponseWriter, *http.Request)
-	Test   func(http.ResponseWriter, *http.Request)
+	List    func(http.ResponseWriter, *http.Request)
+	Create  func(http.ResponseWriter, *http.Request)
+	Read    func(http.ResponseWriter, *http.Request)
+	Update  func(http.ResponseWriter, *http.Request)
+	Delete  func(http.ResponseWriter, *http.Request)
+	Test    func(http.ResponseWriter, *http.Request)
+	Runs    func(http.ResponseWriter, *http.Request)
+	Queue   func(http.ResponseWriter, *http.Request)
+	Requeue func(http.ResponseWriter, *http.Request)
+	Dequeue func(http.ResponseWriter, *http.Request)
 }
 
 func NewAutomationScript(h AutomationScriptAPI) *AutomationScript {
@@ -169,6 +177,86 @@ func NewAutomationScript(h AutomationScriptAPI) *AutomationScript {
 				resputil.JSON(w, value)
 			}
 		},
+		Runs: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewAutomationScriptRuns()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("AutomationScript.Runs", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.Runs(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("AutomationScript.Runs", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("AutomationScript.Runs", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
+		Queue: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewAutomationScriptQueue()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("AutomationScript.Queue", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.Queue(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("AutomationScript.Queue", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("AutomationScript.Queue", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
+		Requeue: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewAutomationScriptRequeue()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("AutomationScript.Requeue", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.Requeue(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("AutomationScript.Requeue", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("AutomationScript.Requeue", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
+		Dequeue: func(w http.ResponseWriter, r *http.Request) {
+			defer r.Body.Close()
+			params := request.NewAutomationScriptDequeue()
+			if err := params.Fill(r); err != nil {
+				logger.LogParamError("AutomationScript.Dequeue", r, err)
+				resputil.JSON(w, err)
+				return
+			}
+
+			value, err := h.Dequeue(r.Context(), params)
+			if err != nil {
+				logger.LogControllerError("AutomationScript.Dequeue", r, err, params.Auditable())
+				resputil.JSON(w, err)
+				return
+			}
+			logger.LogControllerCall("AutomationScript.Dequeue", r, params.Auditable())
+			if !serveHTTP(value, w, r) {
+				resputil.JSON(w, value)
+			}
+		},
 	}
 }
 
@@ -181,5 +269,9 @@ func (h AutomationScript) MountRoutes(r chi.Router, middlewares ...func(http.Han
 		r.Post("/automation/script/{scriptID}", h.Update)
 		r.Delete("/automation/script/{scriptID}", h.Delete)
 		r.Post("/automation/script/test", h.Test)
+		r.Get("/automation/script/{scriptID}/runs", h.Runs)
+		r.Get("/automation/script/{scriptID}/queue", h.Queue)
+		r.Post("/automation/script/{scriptID}/queue/{executionID}/requeue", h.Requeue)
+		r.Delete("/automation/script/{scriptID}/queue/{executionID}", h.Dequeue)
 	})
 }
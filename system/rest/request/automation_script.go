@@ -107,15 +107,17 @@ var _ RequestFiller = NewAutomationScriptList()
 
 // AutomationScript create request parameters
 type AutomationScriptCreate struct {
-	Name      string
-	SourceRef string
-	Source    string
-	RunAs     uint64 `json:",string"`
-	Timeout   uint
-	Critical  bool
-	Async     bool
-	Enabled   bool
-	Triggers  automation.TriggerSet
+	Name         string
+	SourceRef    string
+	Source       string
+	RunAs        uint64 `json:",string"`
+	Timeout      uint
+	Critical     bool
+	MaxAttempts  uint
+	RetryBackoff uint
+	Async        bool
+	Enabled      bool
+	Triggers     automation.TriggerSet
 }
 
 func NewAutomationScriptCreate() *AutomationScriptCreate {
@@ -131,6 +133,8 @@ func (r AutomationScriptCreate) Auditable() map[string]interface{} {
 	out["runAs"] = r.RunAs
 	out["timeout"] = r.Timeout
 	out["critical"] = r.Critical
+	out["maxAttempts"] = r.MaxAttempts
+	out["retryBackoff"] = r.RetryBackoff
 	out["async"] = r.Async
 	out["enabled"] = r.Enabled
 	out["triggers"] = r.Triggers
@@ -183,6 +187,12 @@ func (r *AutomationScriptCreate) Fill(req *http.Request) (err error) {
 	if val, ok := post["critical"]; ok {
 		r.Critical = parseBool(val)
 	}
+	if val, ok := post["maxAttempts"]; ok {
+		r.MaxAttempts = parseUint(val)
+	}
+	if val, ok := post["retryBackoff"]; ok {
+		r.RetryBackoff = parseUint(val)
+	}
 	if val, ok := post["async"]; ok {
 		r.Async = parseBool(val)
 	}
@@ -248,16 +258,18 @@ var _ RequestFiller = NewAutomationScriptRead()
 
 // AutomationScript update request parameters
 type AutomationScriptUpdate struct {
-	ScriptID  uint64 `json:",string"`
-	Name      string
-	SourceRef string
-	Source    string
-	RunAs     uint64 `json:",string"`
-	Timeout   uint
-	Critical  bool
-	Async     bool
-	Enabled   bool
-	Triggers  automation.TriggerSet
+	ScriptID     uint64 `json:",string"`
+	Name         string
+	SourceRef    string
+	Source       string
+	RunAs        uint64 `json:",string"`
+	Timeout      uint
+	Critical     bool
+	MaxAttempts  uint
+	RetryBackoff uint
+	Async        bool
+	Enabled      bool
+	Triggers     automation.TriggerSet
 }
 
 func NewAutomationScriptUpdate() *AutomationScriptUpdate {
@@ -274,6 +286,8 @@ func (r AutomationScriptUpdate) Auditable() map[string]interface{} {
 	out["runAs"] = r.RunAs
 	out["timeout"] = r.Timeout
 	out["critical"] = r.Critical
+	out["maxAttempts"] = r.MaxAttempts
+	out["retryBackoff"] = r.RetryBackoff
 	out["async"] = r.Async
 	out["enabled"] = r.Enabled
 	out["triggers"] = r.Triggers
@@ -327,6 +341,12 @@ func (r *AutomationScriptUpdate) Fill(req *http.Request) (err error) {
 	if val, ok := post["critical"]; ok {
 		r.Critical = parseBool(val)
 	}
+	if val, ok := post["maxAttempts"]; ok {
+		r.MaxAttempts = parseUint(val)
+	}
+	if val, ok := post["retryBackoff"]; ok {
+		r.RetryBackoff = parseUint(val)
+	}
 	if val, ok := post["async"]; ok {
 		r.Async = parseBool(val)
 	}
@@ -447,3 +467,243 @@ func (r *AutomationScriptTest) Fill(req *http.Request) (err error) {
 }
 
 var _ RequestFiller = NewAutomationScriptTest()
+
+// AutomationScript runs request parameters
+type AutomationScriptRuns struct {
+	Outcome  string
+	Page     uint
+	PerPage  uint
+	ScriptID uint64 `json:",string"`
+}
+
+func NewAutomationScriptRuns() *AutomationScriptRuns {
+	return &AutomationScriptRuns{}
+}
+
+func (r AutomationScriptRuns) Auditable() map[string]interface{} {
+	var out = map[string]interface{}{}
+
+	out["outcome"] = r.Outcome
+	out["page"] = r.Page
+	out["perPage"] = r.PerPage
+	out["scriptID"] = r.ScriptID
+
+	return out
+}
+
+func (r *AutomationScriptRuns) Fill(req *http.Request) (err error) {
+	if strings.ToLower(req.Header.Get("content-type")) == "application/json" {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return errors.Wrap(err, "error parsing http request body")
+		}
+	}
+
+	if err = req.ParseForm(); err != nil {
+		return err
+	}
+
+	get := map[string]string{}
+	post := map[string]string{}
+	urlQuery := req.URL.Query()
+	for name, param := range urlQuery {
+		get[name] = string(param[0])
+	}
+	postVars := req.Form
+	for name, param := range postVars {
+		post[name] = string(param[0])
+	}
+
+	if val, ok := get["outcome"]; ok {
+		r.Outcome = val
+	}
+	if val, ok := get["page"]; ok {
+		r.Page = parseUint(val)
+	}
+	if val, ok := get["perPage"]; ok {
+		r.PerPage = parseUint(val)
+	}
+	r.ScriptID = parseUInt64(chi.URLParam(req, "scriptID"))
+
+	return err
+}
+
+var _ RequestFiller = NewAutomationScriptRuns()
+
+// AutomationScript queue request parameters
+type AutomationScriptQueue struct {
+	DeadLetter bool
+	Page       uint
+	PerPage    uint
+	ScriptID   uint64 `json:",string"`
+}
+
+func NewAutomationScriptQueue() *AutomationScriptQueue {
+	return &AutomationScriptQueue{}
+}
+
+func (r AutomationScriptQueue) Auditable() map[string]interface{} {
+	var out = map[string]interface{}{}
+
+	out["deadLetter"] = r.DeadLetter
+	out["page"] = r.Page
+	out["perPage"] = r.PerPage
+	out["scriptID"] = r.ScriptID
+
+	return out
+}
+
+func (r *AutomationScriptQueue) Fill(req *http.Request) (err error) {
+	if strings.ToLower(req.Header.Get("content-type")) == "application/json" {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return errors.Wrap(err, "error parsing http request body")
+		}
+	}
+
+	if err = req.ParseForm(); err != nil {
+		return err
+	}
+
+	get := map[string]string{}
+	post := map[string]string{}
+	urlQuery := req.URL.Query()
+	for name, param := range urlQuery {
+		get[name] = string(param[0])
+	}
+	postVars := req.Form
+	for name, param := range postVars {
+		post[name] = string(param[0])
+	}
+
+	if val, ok := get["deadLetter"]; ok {
+		r.DeadLetter = parseBool(val)
+	}
+	if val, ok := get["page"]; ok {
+		r.Page = parseUint(val)
+	}
+	if val, ok := get["perPage"]; ok {
+		r.PerPage = parseUint(val)
+	}
+	r.ScriptID = parseUInt64(chi.URLParam(req, "scriptID"))
+
+	return err
+}
+
+var _ RequestFiller = NewAutomationScriptQueue()
+
+// AutomationScript requeue request parameters
+type AutomationScriptRequeue struct {
+	ScriptID    uint64 `json:",string"`
+	ExecutionID uint64 `json:",string"`
+}
+
+func NewAutomationScriptRequeue() *AutomationScriptRequeue {
+	return &AutomationScriptRequeue{}
+}
+
+func (r AutomationScriptRequeue) Auditable() map[string]interface{} {
+	var out = map[string]interface{}{}
+
+	out["scriptID"] = r.ScriptID
+	out["executionID"] = r.ExecutionID
+
+	return out
+}
+
+func (r *AutomationScriptRequeue) Fill(req *http.Request) (err error) {
+	if strings.ToLower(req.Header.Get("content-type")) == "application/json" {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return errors.Wrap(err, "error parsing http request body")
+		}
+	}
+
+	if err = req.ParseForm(); err != nil {
+		return err
+	}
+
+	get := map[string]string{}
+	post := map[string]string{}
+	urlQuery := req.URL.Query()
+	for name, param := range urlQuery {
+		get[name] = string(param[0])
+	}
+	postVars := req.Form
+	for name, param := range postVars {
+		post[name] = string(param[0])
+	}
+
+	r.ScriptID = parseUInt64(chi.URLParam(req, "scriptID"))
+	r.ExecutionID = parseUInt64(chi.URLParam(req, "executionID"))
+
+	return err
+}
+
+var _ RequestFiller = NewAutomationScriptRequeue()
+
+// AutomationScript dequeue request parameters
+type AutomationScriptDequeue struct {
+	ScriptID    uint64 `json:",string"`
+	ExecutionID uint64 `json:",string"`
+}
+
+func NewAutomationScriptDequeue() *AutomationScriptDequeue {
+	return &AutomationScriptDequeue{}
+}
+
+func (r AutomationScriptDequeue) Auditable() map[string]interface{} {
+	var out = map[string]interface{}{}
+
+	out["scriptID"] = r.ScriptID
+	out["executionID"] = r.ExecutionID
+
+	return out
+}
+
+func (r *AutomationScriptDequeue) Fill(req *http.Request) (err error) {
+	if strings.ToLower(req.Header.Get("content-type")) == "application/json" {
+		err = json.NewDecoder(req.Body).Decode(r)
+
+		switch {
+		case err == io.EOF:
+			err = nil
+		case err != nil:
+			return errors.Wrap(err, "error parsing http request body")
+		}
+	}
+
+	if err = req.ParseForm(); err != nil {
+		return err
+	}
+
+	get := map[string]string{}
+	post := map[string]string{}
+	urlQuery := req.URL.Query()
+	for name, param := range urlQuery {
+		get[name] = string(param[0])
+	}
+	postVars := req.Form
+	for name, param := range postVars {
+		post[name] = string(param[0])
+	}
+
+	r.ScriptID = parseUInt64(chi.URLParam(req, "scriptID"))
+	r.ExecutionID = parseUInt64(chi.URLParam(req, "executionID"))
+
+	return err
+}
+
+var _ RequestFiller = NewAutomationScriptDequeue()
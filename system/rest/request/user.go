@@ -48,6 +48,8 @@ type UserList struct {
 	Sort         string
 	Page         uint
 	PerPage      uint
+	PageCursor   string
+	SkipCount    bool
 }
 
 func NewUserList() *UserList {
@@ -71,6 +73,8 @@ func (r UserList) Auditable() map[string]interface{} {
 	out["sort"] = r.Sort
 	out["page"] = r.Page
 	out["perPage"] = r.PerPage
+	out["pageCursor"] = r.PageCursor
+	out["skipCount"] = r.SkipCount
 
 	return out
 }
@@ -150,6 +154,12 @@ func (r *UserList) Fill(req *http.Request) (err error) {
 	if val, ok := get["perPage"]; ok {
 		r.PerPage = parseUint(val)
 	}
+	if val, ok := get["pageCursor"]; ok {
+		r.PageCursor = val
+	}
+	if val, ok := get["skipCount"]; ok {
+		r.SkipCount = parseBool(val)
+	}
 
 	return err
 }
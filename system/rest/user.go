@@ -35,6 +35,8 @@ func (User) New() *User {
 }
 
 func (ctrl User) List(ctx context.Context, r *request.UserList) (interface{}, error) {
+	var err error
+
 	f := types.UserFilter{
 		UserID:    payload.ParseUInt64s(r.UserID),
 		RoleID:    payload.ParseUInt64s(r.RoleID),
@@ -59,6 +61,11 @@ func (ctrl User) List(ctx context.Context, r *request.UserList) (interface{}, er
 		f.Deleted = rh.FilterStateInclusive
 	}
 
+	f.SkipCount = r.SkipCount
+	if f.PageCursor, err = rh.ParsePagingCursor(r.PageCursor); err != nil {
+		return nil, err
+	}
+
 	set, filter, err := ctrl.user.With(ctx).Find(f)
 	return ctrl.makeFilterPayload(ctx, set, filter, err)
 }
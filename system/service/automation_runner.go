@@ -2,13 +2,16 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/titpetric/factory"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	intAuth "github.com/cortezaproject/corteza-server/pkg/auth"
@@ -38,16 +41,26 @@ type (
 
 	automationScriptsFinder interface {
 		Watch(ctx context.Context)
+		WatchQueue(ctx context.Context, x automation.QueueExecutor)
 		FindRunnableScripts(resource, event string, cc ...automation.TriggerConditionChecker) automation.ScriptSet
+		LogRun(ctx context.Context, run *automation.Run) error
+		Enqueue(ctx context.Context, db *factory.DB, e *automation.Execution) error
 	}
 
 	AutomationRunnerOpt struct {
 		ApiBaseURLSystem    string
 		ApiBaseURLMessaging string
 		ApiBaseURLCompose   string
+
+		// Timeout of scripts without their own timeout
+		Timeout time.Duration
 	}
 )
 
+const (
+	AutomationResourceMail = "system:mail"
+)
+
 func AutomationRunner(opt AutomationRunnerOpt, f automationScriptsFinder, r corredor.ScriptRunnerClient) automationRunner {
 	var svc = automationRunner{
 		opt: opt,
@@ -69,6 +82,7 @@ func (svc automationRunner) log(ctx context.Context, fields ...zapcore.Field) *z
 
 func (svc automationRunner) Watch(ctx context.Context) {
 	svc.scriptFinder.Watch(ctx)
+	svc.scriptFinder.WatchQueue(ctx, svc)
 }
 
 func (svc automationRunner) OnReceiveMailMessage(ctx context.Context, mail *types.MailMessage) error {
@@ -85,7 +99,7 @@ func (svc automationRunner) findMailScripts(headers types.MailMessageHeader) aut
 	}
 
 	ss, _ := svc.scriptFinder.
-		FindRunnableScripts("system:mail", "onReceive", mailTrigger.MakeChecker(headers, uev)).
+		FindRunnableScripts(AutomationResourceMail, "onReceive", mailTrigger.MakeChecker(headers, uev)).
 		Filter(func(script *automation.Script) (bool, error) {
 			// Filter out user-agent scripts && scripts w/o defined runner.
 			return !script.RunInUA && script.RunAsDefined(), nil
@@ -114,78 +128,167 @@ func (svc automationRunner) RecordScriptTester(ctx context.Context, source strin
 	return repository.ErrNotImplemented
 }
 
-// Runs record script
+// Runs mail script
 //
 // We set-up script-running environment: security (definer / invoker), async, critical
-// and copying values from the run to the given Record
+//
+// Async scripts are queued and executed in the background (see ExecuteQueued)
 //
 func (svc automationRunner) makeMailScriptRunner(ctx context.Context, mail *types.MailMessage) func(script *automation.Script) error {
-	// Static request params (record gets updated
-	var req = &corredor.RunMailMessageRequest{
-		MailMessage: proto.NewMailMessage(mail),
-	}
-
 	svc.log(ctx).Debug("preparing mail script runner", zap.Any("mail", mail))
 
 	return func(script *automation.Script) error {
-		if svc.runner == nil {
-			return errors.New("can not run corredor script: not connected")
+		if svc.runner != nil && script.ID > 0 && script.Async {
+			return svc.enqueueMailScript(ctx, script, mail)
+		}
+
+		failed, err := svc.runMailScript(ctx, mail, script)
+		if failed && !script.Critical {
+			// This was not a critical call and we do not care about
+			// errors from script running service.
+			return nil
+		}
+
+		return err
+	}
+}
+
+// enqueueMailScript adds script execution with the received mail message
+// and invoker's identity to the queue
+func (svc automationRunner) enqueueMailScript(ctx context.Context, script *automation.Script, mail *types.MailMessage) (err error) {
+	var (
+		e = &automation.Execution{
+			ScriptID: script.ID,
+			Resource: AutomationResourceMail,
+			Event:    "onReceive",
 		}
+	)
 
-		// This could be executed in a goroutine (by *after triggers,
-		// so we need to rewire the sentry panic recovery
-		defer sentry.Recover()
+	e.SetIdentity(intAuth.GetIdentityFromContext(ctx))
 
-		ctx, cancelFn := context.WithTimeout(ctx, time.Second*5)
-		defer cancelFn()
+	e.Payload, err = json.Marshal(mail)
+	if err == nil {
+		err = svc.scriptFinder.Enqueue(ctx, nil, e)
+	}
 
-		// Add invoker's or defined credentials/jwt
-		req.Config = map[string]string{
-			"api.jwt": svc.getJWT(ctx, script),
+	if err != nil {
+		svc.log(ctx).Error("could not queue script execution", zap.Uint64("scriptID", script.ID), zap.Error(err))
 
-			// Let the script know where the API is
-			"api.baseURL.system":    svc.opt.ApiBaseURLSystem,
-			"api.baseURL.compose":   svc.opt.ApiBaseURLCompose,
-			"api.baseURL.messaging": svc.opt.ApiBaseURLMessaging,
+		if !script.Critical {
+			return nil
 		}
+	}
+
+	return err
+}
+
+// ExecuteQueued executes queued mail script with invoker's identity
+//
+// Transient corredor errors are retryable
+func (svc automationRunner) ExecuteQueued(ctx context.Context, script *automation.Script, e *automation.Execution) error {
+	var mail = &types.MailMessage{}
 
-		// Add script info
-		req.Script = corredor.FromScript(script)
-
-		_, err := svc.runner.MailMessage(ctx, req, grpc.WaitForReady(script.Critical))
-
-		if err != nil {
-			s, ok := status.FromError(err)
-			if !ok {
-				svc.log(ctx).Error("unexpected error type", zap.Error(err))
-				return err
-			}
-
-			switch s.Code() {
-			case codes.FailedPrecondition:
-				// Sent on syntax errors:
-				err = errors.New(s.Message())
-			case codes.Aborted:
-				err = errors.New(s.Message())
-			case codes.InvalidArgument:
-				err = errors.New("invalid argument")
-			case codes.Internal:
-				err = errors.New("internal corredor error")
-			default:
-			}
-
-			svc.log(ctx).Info("script executed with errors", zap.Error(err))
-
-			if !script.Critical {
-				// This was not a critical call and we do not care about
-				// errors from script running service.
-				return nil
-			}
-
-			return err
+	if e.Resource != AutomationResourceMail {
+		return errors.Errorf("unsupported resource %q", e.Resource)
+	}
+
+	if err := json.Unmarshal(e.Payload, mail); err != nil {
+		return errors.Wrap(err, "could not decode queued execution payload")
+	}
+
+	ctx = intAuth.SetIdentityToContext(ctx, e.Identity())
+
+	_, err := svc.runMailScript(ctx, mail, script)
+	if corredor.IsTransient(err) {
+		return automation.Retryable(err)
+	}
+
+	return err
+}
+
+// runMailScript runs mail script on corredor
+//
+// Every run of a stored script is logged (see automation.Run); failed is set
+// when script could not be run by corredor
+func (svc automationRunner) runMailScript(ctx context.Context, mail *types.MailMessage, script *automation.Script) (failed bool, err error) {
+	var (
+		run     = script.Start(AutomationResourceMail, "onReceive")
+		outcome = automation.RunOutcomeSuccess
+		md      metadata.MD
+
+		req = &corredor.RunMailMessageRequest{
+			MailMessage: proto.NewMailMessage(mail),
 		}
+	)
+
+	run.UserID = intAuth.GetIdentityFromContext(ctx).Identity()
+
+	defer func() {
+		// Errors of non-critical scripts are logged even when they are not returned
+		run.Finish(outcome, err)
+		run.Output = corredor.Output(md)
+		svc.logRun(ctx, run)
+	}()
+
+	if svc.runner == nil {
+		return false, errors.New("can not run corredor script: not connected")
+	}
+
+	// This could be executed in a goroutine (by *after triggers,
+	// so we need to rewire the sentry panic recovery
+	defer sentry.Recover()
+
+	ctx, cancelFn := context.WithTimeout(ctx, script.RunTimeout(svc.opt.Timeout))
+	defer cancelFn()
+
+	// Add invoker's or defined credentials/jwt
+	req.Config = map[string]string{
+		"api.jwt": svc.getJWT(ctx, script),
+
+		// Let the script know where the API is
+		"api.baseURL.system":    svc.opt.ApiBaseURLSystem,
+		"api.baseURL.compose":   svc.opt.ApiBaseURLCompose,
+		"api.baseURL.messaging": svc.opt.ApiBaseURLMessaging,
+	}
+
+	// Add script info
+	req.Script = corredor.FromScript(script)
+
+	_, err = svc.runner.MailMessage(ctx, req, grpc.WaitForReady(script.Critical), grpc.Trailer(&md))
+
+	if err != nil {
+		s, ok := status.FromError(err)
+		if !ok {
+			svc.log(ctx).Error("unexpected error type", zap.Error(err))
+			return false, err
+		}
+
+		switch s.Code() {
+		case codes.FailedPrecondition:
+			// Sent on syntax errors:
+			err = errors.New(s.Message())
+		case codes.Aborted:
+			err = errors.New(s.Message())
+		case codes.InvalidArgument:
+			err = errors.New("invalid argument")
+		case codes.Internal:
+			err = errors.New("internal corredor error")
+		default:
+		}
+
+		svc.log(ctx).Info("script executed with errors", zap.Error(err))
+
+		return true, err
+	}
+
+	return false, nil
+}
 
-		return nil
+// logRun stores run into the script run log
+func (svc automationRunner) logRun(ctx context.Context, run *automation.Run) {
+	// Run is logged even when (request) context is already done
+	if err := svc.scriptFinder.LogRun(context.Background(), run); err != nil {
+		svc.log(ctx).Error("could not log script run", zap.Uint64("scriptID", run.ScriptID), zap.Error(err))
 	}
 }
 
@@ -25,6 +25,13 @@ type (
 		CreateScript(context.Context, *automation.Script) error
 		UpdateScript(context.Context, *automation.Script) error
 		DeleteScript(context.Context, *automation.Script) error
+
+		FindRuns(context.Context, automation.RunFilter) (automation.RunSet, automation.RunFilter, error)
+
+		FindExecutionByID(context.Context, uint64) (*automation.Execution, error)
+		FindExecutions(context.Context, automation.ExecutionFilter) (automation.ExecutionSet, automation.ExecutionFilter, error)
+		RequeueExecution(context.Context, *automation.Execution) error
+		DeleteExecution(context.Context, *automation.Execution) error
 	}
 
 	automationScriptAccessController interface {
@@ -115,6 +122,8 @@ func (svc automationScript) Update(ctx context.Context, mod *automation.Script)
 	s.RunAs = mod.RunAs
 	s.Timeout = mod.Timeout
 	s.Critical = mod.Critical
+	s.MaxAttempts = mod.MaxAttempts
+	s.RetryBackoff = mod.RetryBackoff
 	s.Enabled = mod.Enabled
 
 	// Never run in a UA
@@ -143,6 +152,72 @@ func (svc automationScript) Delete(ctx context.Context, scriptID uint64) (err er
 	}
 }
 
+// FindRuns returns logged runs of the script
+//
+// Only users that can update the script can see its runs
+func (svc automationScript) FindRuns(ctx context.Context, f automation.RunFilter) (automation.RunSet, automation.RunFilter, error) {
+	if err := svc.checkExecutionAccess(ctx, f.ScriptID); err != nil {
+		return nil, f, err
+	}
+
+	return svc.scriptManager.FindRuns(ctx, f)
+}
+
+// FindExecutions returns queued or dead-lettered executions of the script
+//
+// Only users that can update the script can see its executions
+func (svc automationScript) FindExecutions(ctx context.Context, f automation.ExecutionFilter) (automation.ExecutionSet, automation.ExecutionFilter, error) {
+	if err := svc.checkExecutionAccess(ctx, f.ScriptID); err != nil {
+		return nil, f, err
+	}
+
+	return svc.scriptManager.FindExecutions(ctx, f)
+}
+
+// RequeueExecution moves dead-lettered execution of the script back to the queue
+func (svc automationScript) RequeueExecution(ctx context.Context, scriptID, executionID uint64) error {
+	if e, err := svc.loadExecution(ctx, scriptID, executionID); err != nil {
+		return err
+	} else {
+		return svc.scriptManager.RequeueExecution(ctx, e)
+	}
+}
+
+// DeleteExecution removes execution of the script from the queue or dead-letter list
+func (svc automationScript) DeleteExecution(ctx context.Context, scriptID, executionID uint64) error {
+	if e, err := svc.loadExecution(ctx, scriptID, executionID); err != nil {
+		return err
+	} else {
+		return svc.scriptManager.DeleteExecution(ctx, e)
+	}
+}
+
+func (svc automationScript) loadExecution(ctx context.Context, scriptID, executionID uint64) (*automation.Execution, error) {
+	if err := svc.checkExecutionAccess(ctx, scriptID); err != nil {
+		return nil, err
+	}
+
+	if e, err := svc.scriptManager.FindExecutionByID(ctx, executionID); err != nil {
+		return nil, err
+	} else if e.ScriptID != scriptID {
+		return nil, ErrInvalidID.withStack()
+	} else {
+		return e, nil
+	}
+}
+
+func (svc automationScript) checkExecutionAccess(ctx context.Context, scriptID uint64) error {
+	if s, err := svc.loadCombo(ctx, scriptID); err != nil {
+		return err
+	} else if s == nil {
+		return ErrInvalidID.withStack()
+	} else if !svc.ac.CanUpdateAutomationScript(ctx, s) {
+		return ErrNoUpdatePermissions.withStack()
+	}
+
+	return nil
+}
+
 func (svc automationScript) loadCombo(ctx context.Context, scriptID uint64) (s *automation.Script, err error) {
 
 	if scriptID > 0 {
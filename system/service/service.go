@@ -131,9 +131,14 @@ func Init(ctx context.Context, log *zap.Logger, c Config) (err error) {
 		if DefaultInternalAutomationManager == nil {
 			// handles script & trigger management & keeping runnables cripts in internal cache
 			DefaultInternalAutomationManager = automation.Service(automation.AutomationServiceConfig{
-				Logger:        DefaultLogger,
-				DbTablePrefix: "sys",
-				DB:            repository.DB(ctx),
+				Logger:            DefaultLogger,
+				DbTablePrefix:     "sys",
+				RunLogRetention:   c.Corredor.RunLogRetention,
+				RunLogOutputLimit: c.Corredor.RunLogOutputLimit,
+				QueueInterval:     c.Corredor.QueueInterval,
+				RetryMaxAttempts:  uint(c.Corredor.RetryMaxAttempts),
+				RetryBackoff:      c.Corredor.RetryBackoff,
+				DB:                repository.DB(ctx),
 				TokenMaker: func(ctx context.Context, userID uint64) (jwt string, err error) {
 					var u *types.User
 
@@ -171,6 +176,7 @@ func Init(ctx context.Context, log *zap.Logger, c Config) (err error) {
 				ApiBaseURLSystem:    c.Corredor.ApiBaseURLSystem,
 				ApiBaseURLMessaging: c.Corredor.ApiBaseURLMessaging,
 				ApiBaseURLCompose:   c.Corredor.ApiBaseURLCompose,
+				Timeout:             c.Corredor.DefaultTimeout,
 			},
 			DefaultInternalAutomationManager,
 			scriptRunnerClient,
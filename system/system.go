@@ -6,11 +6,11 @@ import (
 
 	_ "github.com/joho/godotenv/autoload"
 	"github.com/spf13/cobra"
-	"github.com/titpetric/factory"
 	"go.uber.org/zap"
 
 	"github.com/cortezaproject/corteza-server/pkg/auth"
 	"github.com/cortezaproject/corteza-server/pkg/cli"
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
 	"github.com/cortezaproject/corteza-server/system/auth/external"
 	"github.com/cortezaproject/corteza-server/system/commands"
 	migrate "github.com/cortezaproject/corteza-server/system/db"
@@ -152,7 +152,7 @@ func Configure() *cli.Config {
 					return nil
 				}
 
-				var db, err = factory.Database.Get(system)
+				var db, err = dbx.Get(system)
 				if err != nil {
 					return err
 				}
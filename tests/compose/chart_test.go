@@ -199,3 +199,38 @@ func TestChartDelete(t *testing.T) {
 	m, err := h.repoChart().FindByID(ns.ID, m.ID)
 	h.a.Error(err, "compose.repository.ChartNotFound")
 }
+
+func TestChartReport(t *testing.T) {
+	h := newHelper(t)
+
+	h.allow(types.ChartPermissionResource.AppendWildcard(), "read")
+	m := h.repoMakeRecordModuleWithFields("report module", &types.ModuleField{Name: "amount", Kind: "Number"})
+	h.repoMakeRecord(m, &types.RecordValue{Name: "amount", Value: "10"})
+	h.repoMakeRecord(m, &types.RecordValue{Name: "amount", Value: "20"})
+
+	c, err := h.repoChart().Create(&types.Chart{
+		Name:        "report",
+		NamespaceID: m.NamespaceID,
+		Config: types.ChartConfig{Reports: []*types.ChartConfigReport{{
+			ModuleID: m.ID,
+			Metrics: []map[string]interface{}{
+				{"field": "amount", "aggregate": "SUM"},
+				{"field": "amount", "aggregate": "MEDIAN"},
+			},
+			Dimensions: []map[string]interface{}{{"field": "createdAt", "modifier": "MONTH"}},
+		}}},
+	})
+	h.a.NoError(err)
+
+	h.apiInit().
+		Get(fmt.Sprintf("/namespace/%d/chart/%d/report", m.NamespaceID, c.ID)).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Len(`$.response`, 1)).
+		Assert(jsonpath.Len(`$.response[0]`, 1)).
+		Assert(jsonpath.Equal(`$.response[0][0].count`, float64(2))).
+		Assert(jsonpath.Equal(`$.response[0][0].metric_0`, float64(30))).
+		Assert(jsonpath.Equal(`$.response[0][0].metric_1`, float64(15))).
+		End()
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/cortezaproject/corteza-server/pkg/api"
 	"github.com/cortezaproject/corteza-server/pkg/auth"
 	"github.com/cortezaproject/corteza-server/pkg/cli"
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
 	"github.com/cortezaproject/corteza-server/pkg/logger"
 	"github.com/cortezaproject/corteza-server/pkg/permissions"
 	"github.com/cortezaproject/corteza-server/pkg/rand"
@@ -47,7 +48,7 @@ var (
 )
 
 func db() *factory.DB {
-	return factory.Database.MustGet("compose").With(context.Background())
+	return dbx.MustGet("compose").With(context.Background())
 }
 
 // random string, 10 chars long by default
@@ -81,7 +82,7 @@ func InitConfig() {
 
 	if err = cfg.RootCommandDBSetup.Run(ctx, nil, cfg); err != nil {
 		panic(err)
-	} else if err := migrate.Migrate(factory.Database.MustGet("compose"), log); err != nil {
+	} else if err := migrate.Migrate(dbx.MustGet("compose"), log); err != nil {
 		panic(err)
 	}
 
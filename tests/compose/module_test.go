@@ -28,7 +28,7 @@ func (h helper) repoSaveModule(mod *types.Module) *types.Module {
 		Create(mod)
 	h.a.NoError(err)
 
-	err = h.repoModule().UpdateFields(m.ID, m.Fields, false)
+	err = h.repoModule().UpdateFields(m.ID, m.Fields)
 	h.a.NoError(err)
 
 	return m
@@ -224,14 +224,19 @@ func TestModuleFieldsUpdate(t *testing.T) {
 	h.a.Equal(ff[1].Kind, "DateTime")
 }
 
-func TestModuleFieldsPreventUpdate_ifRecordExists(t *testing.T) {
+func TestModuleFieldsUpdate_migratesRecordValues(t *testing.T) {
 	h := newHelper(t)
 	h.allow(types.NamespacePermissionResource.AppendWildcard(), "read")
 	ns := h.repoMakeNamespace("some-namespace")
 	m := h.repoMakeModule(ns, "some-module", &types.ModuleField{Kind: "String", Name: "existing"})
-	h.repoMakeRecord(m, &types.RecordValue{Name: "existing", Value: "value"})
+	r := h.repoMakeRecord(m, &types.RecordValue{Name: "existing", Value: " 42 "})
 	h.allow(types.ModulePermissionResource.AppendWildcard(), "update")
 
+	c, err := h.repoChart().Create(&types.Chart{Name: "c", Handle: "c", NamespaceID: ns.ID, Config: types.ChartConfig{
+		Reports: []*types.ChartConfigReport{{ModuleID: m.ID, Filter: "existing > 10", Metrics: []map[string]interface{}{{"field": "existing"}}}},
+	}})
+	h.a.NoError(err)
+
 	f := m.Fields[0]
 	fjs := fmt.Sprintf(`{ "name": "%s", "fields": [{ "fieldID": "%d", "name": "existing_edited", "kind": "Number" }, { "name": "new", "kind": "DateTime" }] }`, m.Name, f.ID)
 	h.apiInit().
@@ -244,15 +249,58 @@ func TestModuleFieldsPreventUpdate_ifRecordExists(t *testing.T) {
 
 	ff, err := h.repoModule().FindFields(m.ID)
 	h.a.NoError(err)
-	h.a.NotNil(ff)
 	h.a.Len(ff, 2)
+	h.a.Equal("existing_edited", ff[0].Name)
+	h.a.Equal("Number", ff[0].Kind)
 
-	h.a.Nil(ff[0].UpdatedAt)
-	h.a.Equal(ff[0].Name, "existing")
-	h.a.Equal(ff[0].Kind, "String")
-	h.a.Nil(ff[1].UpdatedAt)
-	h.a.Equal(ff[1].Name, "new")
-	h.a.Equal(ff[1].Kind, "DateTime")
+	rvs, err := h.repoRecord().LoadValues([]string{"existing", "existing_edited"}, []uint64{r.ID})
+	h.a.NoError(err)
+	h.a.Len(rvs, 1)
+	h.a.Equal("existing_edited", rvs[0].Name)
+	h.a.Equal("42", rvs[0].Value)
+
+	c, err = h.repoChart().FindByID(ns.ID, c.ID)
+	h.a.NoError(err)
+	h.a.Equal("existing_edited > 10", c.Config.Reports[0].Filter)
+	h.a.Equal("existing_edited", c.Config.Reports[0].Metrics[0]["field"])
+}
+
+func TestModuleFieldsUpdate_conversionFailed(t *testing.T) {
+	h := newHelper(t)
+	h.allow(types.NamespacePermissionResource.AppendWildcard(), "read")
+	ns := h.repoMakeNamespace("some-namespace")
+	m := h.repoMakeModule(ns, "some-module", &types.ModuleField{Kind: "String", Name: "existing"})
+	r := h.repoMakeRecord(m, &types.RecordValue{Name: "existing", Value: "value"})
+	h.repoMakeRecord(m, &types.RecordValue{Name: "existing", Value: "42"})
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "update")
+
+	f := m.Fields[0]
+	fjs := fmt.Sprintf(`{ "name": "%s", "fields": [{ "fieldID": "%d", "name": "existing", "kind": "Number" }] }`, m.Name, f.ID)
+
+	h.apiInit().
+		Post(fmt.Sprintf("/namespace/%d/module/%d/dry-run", ns.ID, m.ID)).
+		JSON(fjs).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Len(`$.response`, 1)).
+		Assert(jsonpath.Equal(`$.response[0].values`, float64(2))).
+		Assert(jsonpath.Len(`$.response[0].failed`, 1)).
+		Assert(jsonpath.Equal(`$.response[0].failed[0].recordID`, fmt.Sprintf("%d", r.ID))).
+		Assert(jsonpath.Equal(`$.response[0].failed[0].kind`, "invalidNumber")).
+		End()
+
+	h.apiInit().
+		Post(fmt.Sprintf("/namespace/%d/module/%d", ns.ID, m.ID)).
+		JSON(fjs).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertError("compose.service.ModuleFieldConversionFailed")).
+		End()
+
+	ff, err := h.repoModule().FindFields(m.ID)
+	h.a.NoError(err)
+	h.a.Equal("String", ff[0].Kind)
 }
 
 func TestModuleDeleteForbidden(t *testing.T) {
@@ -3,18 +3,26 @@ package compose
 import (
 	"bytes"
 	"context"
+	"encoding/csv"
 	"fmt"
 	"io/ioutil"
 	"mime/multipart"
 	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/360EntSecGroup-Skylar/excelize/v2"
 	"github.com/steinfletcher/apitest"
 	jsonpath "github.com/steinfletcher/apitest-jsonpath"
 
+	"github.com/cortezaproject/corteza-server/compose/decoder"
 	"github.com/cortezaproject/corteza-server/compose/repository"
+	"github.com/cortezaproject/corteza-server/compose/service"
 	"github.com/cortezaproject/corteza-server/compose/types"
+	"github.com/cortezaproject/corteza-server/pkg/rh"
 	"github.com/cortezaproject/corteza-server/tests/helpers"
 )
 
@@ -24,6 +32,13 @@ type (
 			SessionID string `json:"sessionID"`
 		} `json:"response"`
 	}
+
+	rExportJob struct {
+		Response struct {
+			JobID       string `json:"jobID"`
+			DownloadUrl string `json:"downloadUrl"`
+		} `json:"response"`
+	}
 )
 
 func (h helper) repoRecord() repository.RecordRepository {
@@ -112,6 +127,71 @@ func TestRecordList(t *testing.T) {
 		End()
 }
 
+func TestRecordListCursorPaging(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields("record cursor paging module")
+
+	for _, name := range []string{"c", "a", "b"} {
+		h.repoMakeRecord(module, &types.RecordValue{Name: "name", Value: name})
+	}
+
+	set, f, err := h.repoRecord().Find(module, types.RecordFilter{
+		Sort:       "name",
+		PageFilter: rh.PageFilter{PerPage: 2, SkipCount: true},
+	})
+	h.a.NoError(err)
+	h.a.Len(set, 2)
+	h.a.Zero(f.Count)
+	h.a.Nil(f.PrevPage)
+	h.a.NotNil(f.NextPage)
+
+	h.apiInit().
+		Get(fmt.Sprintf("/namespace/%d/module/%d/record/", module.NamespaceID, module.ID)).
+		Query("sort", "name").
+		Query("perPage", "2").
+		Query("pageCursor", f.NextPage.String()).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Len(`$.response.set`, 1)).
+		Assert(jsonpath.Equal(`$.response.set[0].values[0].value`, "c")).
+		Assert(jsonpath.Present(`$.response.filter.prevPage`)).
+		Assert(jsonpath.NotPresent(`$.response.filter.nextPage`)).
+		End()
+}
+
+func TestRecordListFilterByReference(t *testing.T) {
+	h := newHelper(t)
+
+	accounts := h.repoMakeRecordModuleWithFields("record ref target module")
+	contacts := h.repoMakeModule(
+		&types.Namespace{ID: accounts.NamespaceID},
+		"record ref source module",
+		&types.ModuleField{
+			Name:    "account",
+			Kind:    "Record",
+			Options: types.ModuleFieldOptions{"moduleID": fmt.Sprintf("%d", accounts.ID)},
+		},
+	)
+
+	de := h.repoMakeRecord(accounts, &types.RecordValue{Name: "name", Value: "Germany"})
+	si := h.repoMakeRecord(accounts, &types.RecordValue{Name: "name", Value: "Slovenia"})
+
+	h.repoMakeRecord(contacts, &types.RecordValue{Name: "account", Value: fmt.Sprintf("%d", de.ID), Ref: de.ID})
+	h.repoMakeRecord(contacts, &types.RecordValue{Name: "account", Value: fmt.Sprintf("%d", si.ID), Ref: si.ID})
+
+	h.apiInit().
+		Get(fmt.Sprintf("/namespace/%d/module/%d/record/", contacts.NamespaceID, contacts.ID)).
+		Query("filter", "account.name = 'Germany'").
+		Query("sort", "account.name DESC").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Len(`$.response.set`, 1)).
+		End()
+}
+
 func TestRecordCreateForbidden(t *testing.T) {
 	h := newHelper(t)
 
@@ -210,6 +290,283 @@ func TestRecordDelete(t *testing.T) {
 	h.a.Error(err, "compose.repository.RecordNotFound")
 }
 
+func TestRecordBulk(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields("record bulk module")
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "record.create")
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "record.update")
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "record.delete")
+	h.allow(types.ModuleFieldPermissionResource.AppendWildcard(), "record.value.update")
+
+	updated := h.repoMakeRecord(module)
+	deleted := h.repoMakeRecord(module)
+
+	h.apiInit().
+		Post(fmt.Sprintf("/namespace/%d/module/%d/record/bulk", module.NamespaceID, module.ID)).
+		JSON(fmt.Sprintf(`{"records":[`+
+			`{"operation":"create","values":[{"name":"name","value":"new"}]},`+
+			`{"operation":"update","recordID":"%d","values":[{"name":"name","value":"changed"}]},`+
+			`{"operation":"delete","recordID":"%d"}`+
+			`]}`, updated.ID, deleted.ID)).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Equal(`$.response.failed`, false)).
+		Assert(jsonpath.Len(`$.response.set`, 3)).
+		Assert(jsonpath.Present(`$.response.set[0].recordID`)).
+		End()
+
+	rvs, err := h.repoRecord().LoadValues([]string{"name"}, []uint64{updated.ID})
+	h.a.NoError(err)
+	h.a.Len(rvs, 1)
+	h.a.Equal("changed", rvs[0].Value)
+
+	_, err = h.repoRecord().FindByID(module.NamespaceID, deleted.ID)
+	h.a.Error(err, "compose.repository.RecordNotFound")
+}
+
+func TestRecordBulkRollback(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields("record bulk module")
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "record.create")
+	h.allow(types.ModuleFieldPermissionResource.AppendWildcard(), "record.value.update")
+
+	h.apiInit().
+		Post(fmt.Sprintf("/namespace/%d/module/%d/record/bulk", module.NamespaceID, module.ID)).
+		JSON(`{"records":[{"operation":"create","values":[{"name":"name","value":"new"}]},{"operation":"delete","recordID":"1"}]}`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Equal(`$.response.failed`, true)).
+		Assert(jsonpath.Equal(`$.response.set[0].rolledBack`, true)).
+		Assert(jsonpath.Present(`$.response.set[1].error`)).
+		End()
+
+	set, _, err := h.repoRecord().Find(module, types.RecordFilter{})
+	h.a.NoError(err)
+	h.a.Len(set, 0)
+}
+
+func TestRecordBulkSkip(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields(
+		"record bulk module",
+		&types.ModuleField{Name: "name", Options: types.ModuleFieldOptions{"unique": true}},
+		&types.ModuleField{Name: "email"},
+	)
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "record.create")
+	h.allow(types.ModuleFieldPermissionResource.AppendWildcard(), "record.value.update")
+
+	// Second create fails on unique value in the transaction (module lock),
+	// other operations are stored
+	h.apiInit().
+		Post(fmt.Sprintf("/namespace/%d/module/%d/record/bulk", module.NamespaceID, module.ID)).
+		JSON(`{"onError":"SKIP","records":[` +
+			`{"operation":"create","values":[{"name":"name","value":"first"}]},` +
+			`{"operation":"create","values":[{"name":"name","value":"first"},{"name":"email","value":"duplicate@example.tld"}]},` +
+			`{"operation":"create","values":[{"name":"name","value":"second"}]}` +
+			`]}`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Equal(`$.response.failed`, true)).
+		Assert(jsonpath.Present(`$.response.set[1].error`)).
+		Assert(jsonpath.Present(`$.response.set[2].recordID`)).
+		End()
+
+	set, _, err := h.repoRecord().Find(module, types.RecordFilter{})
+	h.a.NoError(err)
+	h.a.Len(set, 2)
+
+	rvs, err := h.repoRecord().LoadValues([]string{"name", "email"}, set.IDs())
+	h.a.NoError(err)
+	h.a.Len(rvs, 2)
+
+	for _, rv := range rvs {
+		h.a.Equal("name", rv.Name)
+	}
+}
+
+func TestRecordUpsert(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields("record upsert module")
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "record.create")
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "record.update")
+	h.allow(types.ModuleFieldPermissionResource.AppendWildcard(), "record.value.update")
+
+	existing := h.repoMakeRecord(module, &types.RecordValue{Name: "name", Value: "upsert-key"})
+
+	h.apiInit().
+		Post(fmt.Sprintf("/namespace/%d/module/%d/record/upsert", module.NamespaceID, module.ID)).
+		JSON(`{"keys":["name"],"values":[{"name":"name","value":"upsert-key"}]}`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Equal(`$.response.recordID`, fmt.Sprintf("%d", existing.ID))).
+		End()
+
+	h.apiInit().
+		Post(fmt.Sprintf("/namespace/%d/module/%d/record/upsert", module.NamespaceID, module.ID)).
+		JSON(`{"keys":["name"],"values":[{"name":"name","value":"other-key"}]}`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		End()
+
+	set, _, err := h.repoRecord().Find(module, types.RecordFilter{})
+	h.a.NoError(err)
+	h.a.Len(set, 2)
+}
+
+func (h helper) repoMakeDeletedRecord(module *types.Module, rvs ...*types.RecordValue) *types.Record {
+	record := h.repoMakeRecord(module, rvs...)
+
+	now := time.Now()
+	record.DeletedAt = &now
+
+	h.a.NoError(h.repoRecord().Delete(record))
+	h.a.NoError(h.repoRecord().DeleteValues(record))
+
+	return record
+}
+
+func TestRecordListDeletedForbidden(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields("record trash module")
+	h.repoMakeDeletedRecord(module)
+
+	h.apiInit().
+		Get(fmt.Sprintf("/namespace/%d/module/%d/record/", module.NamespaceID, module.ID)).
+		Query("deleted", "2").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertError("compose.service.NoUndeletePermissions")).
+		End()
+}
+
+func TestRecordListDeleted(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields("record trash module")
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "record.undelete")
+
+	h.repoMakeRecord(module)
+	deleted := h.repoMakeDeletedRecord(module)
+
+	h.apiInit().
+		Get(fmt.Sprintf("/namespace/%d/module/%d/record/", module.NamespaceID, module.ID)).
+		Query("deleted", "2").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Len(`$.response.set`, 1)).
+		Assert(jsonpath.Equal(`$.response.set[0].recordID`, fmt.Sprintf("%d", deleted.ID))).
+		End()
+}
+
+func TestRecordUndeleteForbidden(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields("record trash module")
+	record := h.repoMakeDeletedRecord(module)
+
+	h.apiInit().
+		Post(fmt.Sprintf("/namespace/%d/module/%d/record/%d/undelete", module.NamespaceID, module.ID, record.ID)).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertError("compose.service.NoUndeletePermissions")).
+		End()
+}
+
+func TestRecordUndelete(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields("record trash module")
+	record := h.repoMakeDeletedRecord(module, &types.RecordValue{Name: "name", Value: "restored"})
+
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "record.undelete")
+
+	h.apiInit().
+		Post(fmt.Sprintf("/namespace/%d/module/%d/record/%d/undelete", module.NamespaceID, module.ID, record.ID)).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		End()
+
+	r, err := h.repoRecord().FindByID(module.NamespaceID, record.ID)
+	h.a.NoError(err)
+	h.a.Nil(r.DeletedAt)
+
+	rvs, err := h.repoRecord().LoadValues([]string{"name"}, []uint64{record.ID})
+	h.a.NoError(err)
+	h.a.Len(rvs, 1)
+	h.a.Nil(rvs[0].DeletedAt)
+}
+
+func TestRecordRevisions(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields("record revisions module")
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "record.create")
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "record.update")
+	h.allow(types.ModuleFieldPermissionResource.AppendWildcard(), "record.value.read")
+	h.allow(types.ModuleFieldPermissionResource.AppendWildcard(), "record.value.update")
+
+	record, err := service.DefaultRecord.With(h.secCtx()).Create(&types.Record{
+		NamespaceID: module.NamespaceID,
+		ModuleID:    module.ID,
+		Values:      types.RecordValueSet{{Name: "name", Value: "first"}},
+	})
+	h.a.NoError(err)
+
+	h.apiInit().
+		Post(fmt.Sprintf("/namespace/%d/module/%d/record/%d", module.NamespaceID, module.ID, record.ID)).
+		JSON(`{"values":[{"name":"name","value":"second"}]}`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		End()
+
+	h.apiInit().
+		Get(fmt.Sprintf("/namespace/%d/module/%d/record/%d/revisions", module.NamespaceID, module.ID, record.ID)).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Len(`$.response.set`, 2)).
+		Assert(jsonpath.Equal(`$.response.set[0].revision`, float64(2))).
+		Assert(jsonpath.Equal(`$.response.set[0].operation`, "update")).
+		Assert(jsonpath.Equal(`$.response.set[0].changes[0].old[0]`, "first")).
+		Assert(jsonpath.Equal(`$.response.set[0].changes[0].new[0]`, "second")).
+		End()
+
+	h.apiInit().
+		Post(fmt.Sprintf("/namespace/%d/module/%d/record/%d/revisions/1/restore", module.NamespaceID, module.ID, record.ID)).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Equal(`$.response.values[0].value`, "first")).
+		End()
+}
+
+func TestRecordRestoreRevisionNotFound(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields("record revisions module")
+	record := h.repoMakeRecord(module)
+
+	h.apiInit().
+		Post(fmt.Sprintf("/namespace/%d/module/%d/record/%d/revisions/1/restore", module.NamespaceID, module.ID, record.ID)).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertError("compose.repository.RecordRevisionNotFound")).
+		End()
+}
+
 func TestRecordExport(t *testing.T) {
 	h := newHelper(t)
 
@@ -232,6 +589,186 @@ func TestRecordExport(t *testing.T) {
 	h.a.Equal("name\nd0\nd1\nd2\nd3\nd4\nd5\nd6\nd7\nd8\nd9\n", string(b))
 }
 
+func TestRecordExportMultiValue(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields("record export module")
+	h.repoMakeRecord(module,
+		&types.RecordValue{Name: "name", Value: "r1"},
+		&types.RecordValue{Name: "options", Value: "a", Place: 0},
+		&types.RecordValue{Name: "options", Value: "b", Place: 1},
+		&types.RecordValue{Name: "options", Value: "c", Place: 2},
+	)
+	h.repoMakeRecord(module,
+		&types.RecordValue{Name: "name", Value: "r2"},
+		&types.RecordValue{Name: "options", Value: "d"},
+	)
+
+	export := func(multiValue string) string {
+		r := h.apiInit().
+			Get(fmt.Sprintf("/namespace/%d/module/%d/record/export.csv", module.NamespaceID, module.ID)).
+			Query("fields", "name,options").
+			Query("multiValue", multiValue).
+			Expect(t).
+			Status(http.StatusOK).
+			End()
+
+		b, err := ioutil.ReadAll(r.Response.Body)
+		h.a.NoError(err)
+		return string(b)
+	}
+
+	h.a.Equal("name,options\nr1,\"a, b, c\"\nr2,d\n", export("join"))
+	h.a.Equal("name,options[1],options[2],options[3]\nr1,a,b,c\nr2,d,,\n", export("columns"))
+	h.a.Equal("name,options\nr1,\"[\"\"a\"\",\"\"b\"\",\"\"c\"\"]\"\nr2,\"[\"\"d\"\"]\"\n", export("json"))
+}
+
+func TestRecordExportLabels(t *testing.T) {
+	h := newHelper(t)
+
+	accounts := h.repoMakeRecordModuleWithFields("record export accounts module")
+	contacts := h.repoMakeModule(&types.Namespace{ID: accounts.NamespaceID}, "record export contacts module",
+		&types.ModuleField{Name: "name"},
+		&types.ModuleField{Name: "account", Kind: "Record", Options: types.ModuleFieldOptions{
+			"moduleID":   strconv.FormatUint(accounts.ID, 10),
+			"labelField": "name",
+		}},
+	)
+
+	h.allow(types.ModuleFieldPermissionResource.AppendWildcard(), "record.value.read")
+
+	account := h.repoMakeRecord(accounts, &types.RecordValue{Name: "name", Value: "ACME"})
+	h.repoMakeRecord(contacts,
+		&types.RecordValue{Name: "name", Value: "Jane"},
+		&types.RecordValue{Name: "account", Value: strconv.FormatUint(account.ID, 10), Ref: account.ID},
+	)
+
+	r := h.apiInit().
+		Get(fmt.Sprintf("/namespace/%d/module/%d/record/export.csv", contacts.NamespaceID, contacts.ID)).
+		Query("fields", "name,account").
+		Query("labels", "true").
+		Expect(t).
+		Status(http.StatusOK).
+		End()
+
+	b, err := ioutil.ReadAll(r.Response.Body)
+	h.a.NoError(err)
+	h.a.Equal("name,account\nJane,ACME\n", string(b))
+}
+
+func TestRecordExportUnknownField(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields("record export module")
+
+	h.apiInit().
+		Get(fmt.Sprintf("/namespace/%d/module/%d/record/export.csv", module.NamespaceID, module.ID)).
+		Query("fields", "name,nope").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertError(`unknown field "nope"`)).
+		End()
+}
+
+func TestRecordExportJob(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields("record export job module")
+
+	var (
+		url  = fmt.Sprintf("/namespace/%d/module/%d/record/export/", module.NamespaceID, module.ID)
+		rsp  = &rExportJob{}
+		api  = h.apiInit()
+		repo = repository.RecordExportJob(context.Background(), db())
+	)
+
+	api.Post(url).
+		JSON(`{"format":"csv","fields":["name"]}`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Equal("$.response.format", "csv")).
+		Assert(jsonpath.Present("$.response.filename")).
+		Assert(jsonpath.NotPresent("$.response.downloadUrl")).
+		End().
+		JSON(rsp)
+
+	jobID, err := strconv.ParseUint(rsp.Response.JobID, 10, 64)
+	h.a.NoError(err)
+
+	// Export is run by the worker; finish it here
+	job, err := repo.FindByID(jobID)
+	h.a.NoError(err)
+
+	var (
+		now = time.Now()
+		exp = now.Add(time.Hour)
+	)
+
+	job.Url = service.DefaultStore.Original(job.ID, job.Format)
+	job.StartedAt = &now
+	job.FinishedAt = &now
+	job.ExpiresAt = &exp
+	job.Exported = 1
+	h.a.NoError(service.DefaultStore.Save(job.Url, strings.NewReader("name\nd0\n")))
+	_, err = repo.Update(job)
+	h.a.NoError(err)
+
+	api.Get(url + rsp.Response.JobID).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Present("$.response.finishedAt")).
+		Assert(jsonpath.Present("$.response.downloadUrl")).
+		End().
+		JSON(rsp)
+
+	r := h.apiInit().
+		Get(rsp.Response.DownloadUrl).
+		Expect(t).
+		Status(http.StatusOK).
+		End()
+
+	b, err := ioutil.ReadAll(r.Response.Body)
+	h.a.NoError(err)
+	h.a.Equal("name\nd0\n", string(b))
+
+	h.apiInit().
+		Get(url+rsp.Response.JobID+"/download").
+		Query("sign", strings.Repeat("0", 40)).
+		Query("userID", strconv.FormatUint(h.cUser.ID, 10)).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertError("missing or invalid signature")).
+		End()
+
+	api.Delete(url + rsp.Response.JobID).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		End()
+
+	api.Get(url + rsp.Response.JobID).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertError("compose.service.RecordExportJobNotFound")).
+		End()
+}
+
+func TestRecordExportJobUnsupportedFormat(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields("record export job module")
+
+	h.apiInit().
+		Post(fmt.Sprintf("/namespace/%d/module/%d/record/export/", module.NamespaceID, module.ID)).
+		JSON(`{"format":"xml"}`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertError("compose.service.RecordExportFormatNotSupported")).
+		End()
+}
+
 func (h helper) apiInitRecordImport(api *apitest.APITest, url, f string, file []byte) *apitest.Response {
 	body := &bytes.Buffer{}
 	writer := multipart.NewWriter(body)
@@ -290,6 +827,32 @@ func TestRecordImportInit(t *testing.T) {
 	}
 }
 
+func TestRecordImportInitSpreadsheet(t *testing.T) {
+	h := newHelper(t)
+
+	f := excelize.NewFile()
+	f.SetSheetName("Sheet1", "Contacts")
+	f.NewSheet("Other")
+	h.a.NoError(f.SetCellValue("Contacts", "A1", "Contacts export"))
+	h.a.NoError(f.SetCellValue("Contacts", "A2", "name"))
+	h.a.NoError(f.SetCellValue("Contacts", "B2", "email"))
+	h.a.NoError(f.SetCellValue("Contacts", "A3", "v1"))
+	h.a.NoError(f.SetCellValue("Contacts", "B3", "v2"))
+
+	buf, err := f.WriteToBuffer()
+	h.a.NoError(err)
+
+	module := h.repoMakeRecordModuleWithFields("record import init module")
+	url := fmt.Sprintf("/namespace/%d/module/%d/record/import", module.NamespaceID, module.ID)
+	h.apiInitRecordImport(h.apiInit(), url, "f1.xlsx", buf.Bytes()).
+		Assert(jsonpath.Equal("$.response.format", "xlsx")).
+		Assert(jsonpath.Equal("$.response.sheets", []interface{}{"Contacts", "Other"})).
+		Assert(jsonpath.Present(`$.response.fields.name==""`)).
+		Assert(jsonpath.Present(`$.response.fields.email==""`)).
+		Assert(jsonpath.Present("$.response.progress.entryCount==1")).
+		End()
+}
+
 func TestRecordImportInit_invalidFileFormat(t *testing.T) {
 	h := newHelper(t)
 
@@ -386,3 +949,366 @@ func TestRecordImportImportProgress_sessionNotFound(t *testing.T) {
 		Assert(helpers.AssertError("compose.service.RecordImportSessionNotFound")).
 		End()
 }
+
+func TestRecordImportCancel(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields("record import cancel module")
+	url := fmt.Sprintf("/namespace/%d/module/%d/record/import", module.NamespaceID, module.ID)
+	rsp := &rImportSession{}
+	api := h.apiInit()
+
+	h.apiInitRecordImport(api, url, "f1.csv", []byte("name,email\nv1,v2\n")).End().JSON(rsp)
+
+	api.Delete(fmt.Sprintf("%s/%s", url, rsp.Response.SessionID)).
+		Expect(h.t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Present("$.response.progress.canceledAt")).
+		Assert(jsonpath.Present("$.response.progress.finishedAt")).
+		End()
+
+	h.apiRunRecordImport(api, fmt.Sprintf("%s/%s", url, rsp.Response.SessionID), `{"fields":{"name":"name"},"onError":"skip"}`).
+		Assert(helpers.AssertError("compose.service.RecordImportSessionAlreadyStarted")).
+		End()
+}
+
+func TestRecordImportResume(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields(
+		"record import resume module",
+		&types.ModuleField{Name: "name", Kind: "String", Required: true},
+		&types.ModuleField{Name: "email", Kind: "String"},
+	)
+
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "record.create")
+	h.allow(types.ModuleFieldPermissionResource.AppendWildcard(), "record.value.read")
+	h.allow(types.ModuleFieldPermissionResource.AppendWildcard(), "record.value.update")
+
+	var (
+		content = "name,email\nfirst,a\n,b\nlast,c\n"
+		url     = fmt.Sprintf("/namespace/%d/module/%d/record/import", module.NamespaceID, module.ID)
+		rsp     = &rImportSession{}
+		api     = h.apiInit()
+		repo    = repository.RecordImportSession(context.Background(), db())
+	)
+
+	h.apiInitRecordImport(api, url, "f1.csv", []byte(content)).End().JSON(rsp)
+	h.apiRunRecordImport(api, fmt.Sprintf("%s/%s", url, rsp.Response.SessionID), `{"fields":{"name":"name","email":"email"},"onError":"skip"}`).
+		Assert(helpers.AssertNoErrors).
+		End()
+
+	sessionID, err := strconv.ParseUint(rsp.Response.SessionID, 10, 64)
+	h.a.NoError(err)
+
+	// Import was interrupted after the first entry
+	ses, err := repo.FindByID(sessionID)
+	h.a.NoError(err)
+	ses.Completed = 1
+	_, err = repo.Update(ses)
+	h.a.NoError(err)
+
+	dec := decoder.NewFlatReader(csv.NewReader(strings.NewReader(content)), strings.NewReader(content))
+	h.a.NoError(service.DefaultRecord.With(h.secCtx()).Import(ses, dec))
+
+	ses, err = repo.FindByID(sessionID)
+	h.a.NoError(err)
+	h.a.NotNil(ses.FinishedAt)
+	h.a.Equal(uint64(2), ses.Completed)
+	h.a.Equal(uint64(1), ses.Failed)
+
+	rr, _, err := h.repoRecord().Find(module, types.RecordFilter{ModuleID: module.ID, NamespaceID: module.NamespaceID})
+	h.a.NoError(err)
+	h.a.Len(rr, 1)
+
+	api.Get(fmt.Sprintf("%s/%s/errors", url, rsp.Response.SessionID)).
+		Expect(h.t).
+		Status(http.StatusOK).
+		Body("entry,field,kind,message\n2,name,required,value is required\n").
+		End()
+}
+
+func TestRecordComputedFields(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields(
+		"record computed fields module",
+		&types.ModuleField{Name: "qty", Kind: "Number"},
+		&types.ModuleField{Name: "price", Kind: "Number"},
+		&types.ModuleField{Name: "total", Kind: "Computed", Options: types.ModuleFieldOptions{"expression": "qty * price", "resultKind": "Number"}},
+	)
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "record.create")
+	h.allow(types.ModuleFieldPermissionResource.AppendWildcard(), "record.value.read")
+	h.allow(types.ModuleFieldPermissionResource.AppendWildcard(), "record.value.update")
+
+	for _, qty := range []string{"3", "10"} {
+		h.apiInit().
+			Post(fmt.Sprintf("/namespace/%d/module/%d/record/", module.NamespaceID, module.ID)).
+			JSON(fmt.Sprintf(`{"values":[{"name":"qty","value":"%s"},{"name":"price","value":"2.5"},{"name":"total","value":"1"}]}`, qty)).
+			Expect(t).
+			Status(http.StatusOK).
+			Assert(helpers.AssertNoErrors).
+			End()
+	}
+
+	h.apiInit().
+		Get(fmt.Sprintf("/namespace/%d/module/%d/record/", module.NamespaceID, module.ID)).
+		Query("filter", "total > 10").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Len(`$.response.set`, 1)).
+		Assert(jsonpath.Equal(`$.response.set[0].values[2].name`, "total")).
+		Assert(jsonpath.Equal(`$.response.set[0].values[2].value`, "25")).
+		End()
+}
+
+func TestRecordRollupFields(t *testing.T) {
+	h := newHelper(t)
+
+	accounts := h.repoMakeRecordModuleWithFields(
+		"record rollup accounts module",
+		&types.ModuleField{Name: "name", Kind: "String"},
+	)
+
+	opportunities := h.repoMakeModule(
+		&types.Namespace{ID: accounts.NamespaceID},
+		"record rollup opportunities module",
+		&types.ModuleField{Name: "account", Kind: "Record", Options: types.ModuleFieldOptions{"moduleID": strconv.FormatUint(accounts.ID, 10)}},
+		&types.ModuleField{Name: "amount", Kind: "Number"},
+		&types.ModuleField{Name: "stage", Kind: "String"},
+	)
+
+	accounts.Fields = append(accounts.Fields,
+		&types.ModuleField{Name: "open", Kind: "Rollup", Options: types.ModuleFieldOptions{
+			"moduleID":  strconv.FormatUint(opportunities.ID, 10),
+			"field":     "account",
+			"aggregate": "COUNT",
+			"filter":    "stage = 'open'",
+		}},
+		&types.ModuleField{Name: "won", Kind: "Rollup", Options: types.ModuleFieldOptions{
+			"moduleID":   strconv.FormatUint(opportunities.ID, 10),
+			"field":      "account",
+			"aggregate":  "SUM",
+			"valueField": "amount",
+			"filter":     "stage = 'won'",
+		}},
+	)
+	h.a.NoError(h.repoModule().UpdateFields(accounts.ID, accounts.Fields))
+
+	account := h.repoMakeRecord(accounts, &types.RecordValue{Name: "name", Value: "Acme"})
+
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "record.create")
+	h.allow(types.ModuleFieldPermissionResource.AppendWildcard(), "record.value.read")
+	h.allow(types.ModuleFieldPermissionResource.AppendWildcard(), "record.value.update")
+
+	for _, o := range [][2]string{{"100", "open"}, {"200", "won"}, {"300", "won"}} {
+		h.apiInit().
+			Post(fmt.Sprintf("/namespace/%d/module/%d/record/", opportunities.NamespaceID, opportunities.ID)).
+			JSON(fmt.Sprintf(`{"values":[{"name":"account","value":"%d"},{"name":"amount","value":"%s"},{"name":"stage","value":"%s"}]}`, account.ID, o[0], o[1])).
+			Expect(t).
+			Status(http.StatusOK).
+			Assert(helpers.AssertNoErrors).
+			End()
+	}
+
+	rvs, err := h.repoRecord().LoadValues([]string{"open", "won"}, []uint64{account.ID})
+	h.a.NoError(err)
+	h.a.Equal("1", rvs.FilterByName("open")[0].Value)
+	h.a.Equal("500", rvs.FilterByName("won")[0].Value)
+}
+
+func TestRecordRollupConcurrentWrites(t *testing.T) {
+	h := newHelper(t)
+
+	accounts := h.repoMakeRecordModuleWithFields("record rollup concurrent accounts module")
+
+	opportunities := h.repoMakeModule(
+		&types.Namespace{ID: accounts.NamespaceID},
+		"record rollup concurrent opportunities module",
+		&types.ModuleField{Name: "account", Kind: "Record", Options: types.ModuleFieldOptions{"moduleID": strconv.FormatUint(accounts.ID, 10)}},
+	)
+
+	accounts.Fields = append(accounts.Fields,
+		&types.ModuleField{Name: "count", Kind: "Rollup", Options: types.ModuleFieldOptions{
+			"moduleID":  strconv.FormatUint(opportunities.ID, 10),
+			"field":     "account",
+			"aggregate": "COUNT",
+		}},
+	)
+	h.a.NoError(h.repoModule().UpdateFields(accounts.ID, accounts.Fields))
+
+	account := h.repoMakeRecord(accounts)
+
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "record.create")
+	h.allow(types.ModuleFieldPermissionResource.AppendWildcard(), "record.value.read")
+	h.allow(types.ModuleFieldPermissionResource.AppendWildcard(), "record.value.update")
+
+	const writes = 10
+
+	var wg sync.WaitGroup
+	for i := 0; i < writes; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			h.apiInit().
+				Post(fmt.Sprintf("/namespace/%d/module/%d/record/", opportunities.NamespaceID, opportunities.ID)).
+				JSON(fmt.Sprintf(`{"values":[{"name":"account","value":"%d"}]}`, account.ID)).
+				Expect(t).
+				Status(http.StatusOK).
+				Assert(helpers.AssertNoErrors).
+				End()
+		}()
+	}
+
+	wg.Wait()
+
+	rvs, err := h.repoRecord().LoadValues([]string{"count"}, []uint64{account.ID})
+	h.a.NoError(err)
+	h.a.Len(rvs, 1)
+	h.a.Equal(strconv.Itoa(writes), rvs[0].Value)
+}
+
+func (h helper) repoMakeOwnedRecord(module *types.Module, ownerID uint64) *types.Record {
+	record, err := h.
+		repoRecord().
+		Create(&types.Record{
+			ModuleID:    module.ID,
+			NamespaceID: module.NamespaceID,
+			OwnedBy:     ownerID,
+			CreatedAt:   time.Now(),
+		})
+	h.a.NoError(err)
+
+	return record
+}
+
+func TestRecordListOwnAndShared(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields("record ownership module")
+	h.deny(types.ModulePermissionResource.AppendID(module.ID), "record.read")
+	h.allow(types.ModulePermissionResource.AppendID(module.ID), "record.read.own")
+
+	own := h.repoMakeOwnedRecord(module, h.cUser.ID)
+	shared := h.repoMakeOwnedRecord(module, h.cUser.ID+1)
+	other := h.repoMakeOwnedRecord(module, h.cUser.ID+1)
+
+	h.allow(types.RecordPermissionResource.AppendID(shared.ID), "read")
+
+	h.apiInit().
+		Get(fmt.Sprintf("/namespace/%d/module/%d/record/", module.NamespaceID, module.ID)).
+		Query("sort", "id").
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Equal(`$.response.filter.count`, float64(2))).
+		Assert(jsonpath.Len(`$.response.set`, 2)).
+		Assert(jsonpath.Equal(`$.response.set[0].recordID`, fmt.Sprintf("%d", own.ID))).
+		Assert(jsonpath.Equal(`$.response.set[1].recordID`, fmt.Sprintf("%d", shared.ID))).
+		End()
+
+	h.apiInit().
+		Get(fmt.Sprintf("/namespace/%d/module/%d/record/%d", module.NamespaceID, module.ID, other.ID)).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertError("compose.service.NoReadPermissions")).
+		End()
+}
+
+func TestRecordUpdateOwn(t *testing.T) {
+	h := newHelper(t)
+
+	module := h.repoMakeRecordModuleWithFields("record ownership module")
+	h.allow(types.ModulePermissionResource.AppendID(module.ID), "record.update.own")
+	h.allow(types.ModuleFieldPermissionResource.AppendWildcard(), "record.value.update")
+
+	own := h.repoMakeOwnedRecord(module, h.cUser.ID)
+	other := h.repoMakeOwnedRecord(module, h.cUser.ID+1)
+
+	h.apiInit().
+		Post(fmt.Sprintf("/namespace/%d/module/%d/record/%d", module.NamespaceID, module.ID, own.ID)).
+		JSON(`{"values":[{"name":"name","value":"changed"}]}`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		End()
+
+	h.apiInit().
+		Post(fmt.Sprintf("/namespace/%d/module/%d/record/%d", module.NamespaceID, module.ID, other.ID)).
+		JSON(`{"values":[{"name":"name","value":"changed"}]}`).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertError("compose.service.NoUpdatePermissions")).
+		End()
+}
+
+func TestRecordDeleteReferenced(t *testing.T) {
+	h := newHelper(t)
+
+	accounts := h.repoMakeRecordModuleWithFields("record references accounts module")
+	ref := func(onDelete string) *types.ModuleField {
+		return &types.ModuleField{Name: "account", Kind: "Record", Options: types.ModuleFieldOptions{
+			"moduleID": strconv.FormatUint(accounts.ID, 10),
+			"onDelete": onDelete,
+		}}
+	}
+
+	ns := &types.Namespace{ID: accounts.NamespaceID}
+	contacts := h.repoMakeModule(ns, "record references contacts module", ref(types.RecordRefOnDeleteSetNull))
+	invoices := h.repoMakeModule(ns, "record references invoices module", ref(types.RecordRefOnDeleteCascade))
+	contracts := h.repoMakeModule(ns, "record references contracts module", ref(types.RecordRefOnDeleteRestrict))
+
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "read")
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "record.read")
+	h.allow(types.ModulePermissionResource.AppendWildcard(), "record.delete")
+	h.allow(types.ModuleFieldPermissionResource.AppendWildcard(), "record.value.read")
+
+	var (
+		account  = h.repoMakeRecord(accounts)
+		refValue = &types.RecordValue{Name: "account", Value: strconv.FormatUint(account.ID, 10), Ref: account.ID}
+		contact  = h.repoMakeRecord(contacts, refValue)
+		invoice  = h.repoMakeRecord(invoices, refValue)
+		contract = h.repoMakeRecord(contracts, refValue)
+
+		deleteAccount = func() *apitest.Response {
+			return h.apiInit().
+				Delete(fmt.Sprintf("/namespace/%d/module/%d/record/%d", accounts.NamespaceID, accounts.ID, account.ID)).
+				Expect(t).
+				Status(http.StatusOK)
+		}
+	)
+
+	h.apiInit().
+		Get(fmt.Sprintf("/namespace/%d/module/%d/record/%d/references", accounts.NamespaceID, accounts.ID, account.ID)).
+		Expect(t).
+		Status(http.StatusOK).
+		Assert(helpers.AssertNoErrors).
+		Assert(jsonpath.Len(`$.response`, 3)).
+		End()
+
+	deleteAccount().
+		Assert(helpers.AssertError("compose.service.RecordReferenced")).
+		End()
+
+	_, err := h.repoRecord().FindByID(accounts.NamespaceID, account.ID)
+	h.a.NoError(err)
+
+	h.a.NoError(h.repoRecord().DeleteValues(contract))
+
+	deleteAccount().
+		Assert(helpers.AssertNoErrors).
+		End()
+
+	_, err = h.repoRecord().FindByID(accounts.NamespaceID, invoice.ID)
+	h.a.Error(err, "compose.repository.RecordNotFound")
+
+	_, err = h.repoRecord().FindByID(accounts.NamespaceID, contact.ID)
+	h.a.NoError(err)
+
+	rvs, err := h.repoRecord().LoadValues([]string{"account"}, []uint64{contact.ID})
+	h.a.NoError(err)
+	h.a.Len(rvs, 0)
+}
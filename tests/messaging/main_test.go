@@ -22,6 +22,7 @@ import (
 	"github.com/cortezaproject/corteza-server/pkg/api"
 	"github.com/cortezaproject/corteza-server/pkg/auth"
 	"github.com/cortezaproject/corteza-server/pkg/cli"
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
 	"github.com/cortezaproject/corteza-server/pkg/logger"
 	"github.com/cortezaproject/corteza-server/pkg/permissions"
 	"github.com/cortezaproject/corteza-server/pkg/rand"
@@ -47,7 +48,7 @@ var (
 )
 
 func db() *factory.DB {
-	return factory.Database.MustGet("messaging").With(context.Background())
+	return dbx.MustGet("messaging").With(context.Background())
 }
 
 func InitConfig() {
@@ -71,7 +72,7 @@ func InitConfig() {
 
 	if err = cfg.RootCommandDBSetup.Run(ctx, nil, cfg); err != nil {
 		panic(err)
-	} else if err := migrate.Migrate(factory.Database.MustGet("messaging"), log); err != nil {
+	} else if err := migrate.Migrate(dbx.MustGet("messaging"), log); err != nil {
 		panic(err)
 	}
 
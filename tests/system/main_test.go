@@ -16,6 +16,7 @@ import (
 	"github.com/cortezaproject/corteza-server/pkg/api"
 	"github.com/cortezaproject/corteza-server/pkg/auth"
 	"github.com/cortezaproject/corteza-server/pkg/cli"
+	dbx "github.com/cortezaproject/corteza-server/pkg/db"
 	"github.com/cortezaproject/corteza-server/pkg/logger"
 	"github.com/cortezaproject/corteza-server/pkg/permissions"
 	"github.com/cortezaproject/corteza-server/pkg/rand"
@@ -54,7 +55,7 @@ func rs(a ...int) string {
 }
 
 func db() *factory.DB {
-	return factory.Database.MustGet("system").With(context.Background())
+	return dbx.MustGet("system").With(context.Background())
 }
 
 func InitConfig() {
@@ -78,7 +79,7 @@ func InitConfig() {
 
 	if err = cfg.RootCommandDBSetup.Run(ctx, nil, cfg); err != nil {
 		panic(err)
-	} else if err := migrate.Migrate(factory.Database.MustGet("system"), log); err != nil {
+	} else if err := migrate.Migrate(dbx.MustGet("system"), log); err != nil {
 		panic(err)
 	}
 